@@ -0,0 +1,78 @@
+package main
+
+import (
+	"log"
+
+	"github.com/alfafaa/alfafaa-blog/internal/cache"
+	"github.com/alfafaa/alfafaa-blog/internal/config"
+	"github.com/alfafaa/alfafaa-blog/internal/database"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// runCommand runs a subcommand and reports whether args named one
+func runCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+
+	switch args[0] {
+	case "import":
+		runImport(args[1:])
+	case "export":
+		runExport(args[1:])
+	default:
+		return false
+	}
+	return true
+}
+
+// openDatabase loads the configuration and connects to the migrated database
+func openDatabase() (*config.Config, *gorm.DB) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	db, err := database.Connect(&cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	if err := database.AutoMigrate(db); err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	return cfg, db
+}
+
+// openReadCache creates the application read cache, kept in Redis when one
+// is configured and in memory otherwise. It returns nil when caching is off.
+func openReadCache(cfg config.CacheConfig) *cache.ReadCache {
+	if cfg.TTL <= 0 {
+		return nil
+	}
+	if cfg.RedisURL == "" {
+		return cache.NewReadCache(cache.NewMemoryCache(cfg.Size), cfg.TTL)
+	}
+
+	options, err := redis.ParseURL(cfg.RedisURL)
+	if err != nil {
+		log.Fatalf("Invalid REDIS_URL: %v", err)
+	}
+	return cache.NewReadCache(cache.NewRedisCache(redis.NewClient(options), "alfafaa:"), cfg.TTL)
+}
+
+// findUser finds a user by email or username
+func findUser(userRepo repositories.UserRepository, emailOrUsername string) *models.User {
+	user, err := userRepo.FindByEmail(emailOrUsername)
+	if err != nil {
+		user, err = userRepo.FindByUsername(emailOrUsername)
+	}
+	if err != nil {
+		log.Fatalf("User %q not found", emailOrUsername)
+	}
+	return user
+}
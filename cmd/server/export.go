@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/alfafaa/alfafaa-blog/internal/database"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+)
+
+// runExport implements the "export" subcommand:
+//
+//	server export [-author <email|username>] [-o articles.zip]
+//
+// It writes every article, or one author's articles, to a zip archive of
+// Markdown files with the uploaded media they reference.
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	author := fs.String("author", "", "Email or username of the author to export; all articles are exported if empty")
+	output := fs.String("o", "", "Output file (default: a dated file name in the current directory)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: server export [-author <email|username>] [-o articles.zip]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cfg, db := openDatabase()
+	defer database.Close(db)
+
+	userRepo := repositories.NewUserRepository(db)
+	exportService := services.NewExportService(repositories.NewArticleRepository(db), userRepo, cfg.Upload)
+
+	export := exportService.ExportAllArticles()
+	if *author != "" {
+		user := findUser(userRepo, *author)
+		var err error
+		export, err = exportService.ExportAuthorArticles(user.ID.String(), user.ID.String(), true)
+		if err != nil {
+			log.Fatalf("Export failed: %v", err)
+		}
+	}
+
+	filename := *output
+	if filename == "" {
+		filename = export.Filename
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		log.Fatalf("Failed to create %s: %v", filename, err)
+	}
+
+	if err := export.Write(file); err != nil {
+		file.Close()
+		os.Remove(filename)
+		log.Fatalf("Export failed: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		log.Fatalf("Failed to write %s: %v", filename, err)
+	}
+
+	log.Printf("Exported articles to %s", filename)
+}
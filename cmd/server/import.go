@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/alfafaa/alfafaa-blog/internal/database"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+)
+
+// runImport implements the "import" subcommand:
+//
+//	server import -as admin@example.com [-dry-run] export.xml
+//
+// It imports a WordPress WXR export or a zip archive of Markdown files and
+// prints the import report as JSON.
+func runImport(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	asUser := fs.String("as", "", "Email or username of the user running the import; unknown authors are attributed to them")
+	dryRun := fs.Bool("dry-run", false, "Report what would be imported without writing anything")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: server import -as <email|username> [-dry-run] <export.xml|archive.zip>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if *asUser == "" || fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	filename := fs.Arg(0)
+
+	file, err := os.Open(filename)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", filename, err)
+	}
+
+	cfg, db := openDatabase()
+	defer database.Close(db)
+
+	userRepo := repositories.NewUserRepository(db)
+	user := findUser(userRepo, *asUser)
+
+	importService := services.NewImportService(db,
+		repositories.NewArticleRepository(db),
+		repositories.NewCategoryRepository(db),
+		repositories.NewTagRepository(db),
+		userRepo,
+		repositories.NewMediaRepository(db),
+		repositories.NewImportRecordRepository(db),
+		cfg.Upload,
+		// A cache shared through Redis must forget what the import changes
+		services.WithImportReadCache(openReadCache(cfg.Cache)),
+	)
+
+	report, err := importService.Import(filepath.Base(filename), file, info.Size(), user.ID.String(), *dryRun)
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+
+	if report.Failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -10,6 +11,7 @@ import (
 	"github.com/alfafaa/alfafaa-blog/internal/config"
 	"github.com/alfafaa/alfafaa-blog/internal/database"
 	"github.com/alfafaa/alfafaa-blog/internal/handlers"
+	"github.com/alfafaa/alfafaa-blog/internal/jobs"
 	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
 	"github.com/alfafaa/alfafaa-blog/internal/repositories"
 	"github.com/alfafaa/alfafaa-blog/internal/services"
@@ -41,6 +43,11 @@ import (
 // @description Type "Bearer" followed by a space and JWT token.
 
 func main() {
+	// Subcommands (import, export)
+	if runCommand(os.Args[1:]) {
+		return
+	}
+
 	// Parse command line flags
 	migrateFlag := flag.Bool("migrate", false, "Run database migrations")
 	seedFlag := flag.Bool("seed", false, "Seed the database with initial data")
@@ -104,30 +111,131 @@ func main() {
 	mediaRepo := repositories.NewMediaRepository(db)
 	commentRepo := repositories.NewCommentRepository(db)
 	engagementRepo := repositories.NewEngagementRepository(db)
+	seriesRepo := repositories.NewSeriesRepository(db)
+	slugHistoryRepo := repositories.NewSlugHistoryRepository(db)
+	articleLockRepo := repositories.NewArticleLockRepository(db)
+	articleRevisionRepo := repositories.NewArticleRevisionRepository(db)
+	articlePreviewRepo := repositories.NewArticlePreviewRepository(db)
+	importRecordRepo := repositories.NewImportRecordRepository(db)
+	trashRepo := repositories.NewTrashRepository(db)
+	highlightRepo := repositories.NewHighlightRepository(db)
+	relatedRepo := repositories.NewRelatedArticleRepository(db)
+	duplicateRepo := repositories.NewDuplicateRepository(db)
+	readingRepo := repositories.NewReadingRepository(db)
+	statsRepo := repositories.NewStatsRepository(db)
+	trendingRepo := repositories.NewTrendingRepository(db)
+
+	// Cached reads are dropped by the services that change them
+	readCache := openReadCache(cfg.Cache)
 
 	// Initialize services
 	authService := services.NewAuthService(userRepo, cfg.JWT)
-	userService := services.NewUserService(userRepo, articleRepo, engagementRepo)
-	categoryService := services.NewCategoryService(categoryRepo, articleRepo)
-	tagService := services.NewTagService(tagRepo, articleRepo)
+	userService := services.NewUserService(userRepo, articleRepo,
+		services.WithUserEngagementRepo(engagementRepo),
+		services.WithUserReadCache(readCache),
+	)
+	categoryService := services.NewCategoryService(db, categoryRepo, articleRepo,
+		services.WithCategorySlugHistoryRepo(slugHistoryRepo),
+		services.WithCategoryReadCache(readCache),
+	)
+	tagService := services.NewTagService(db, tagRepo, articleRepo,
+		services.WithTagSlugHistoryRepo(slugHistoryRepo),
+		services.WithTagReadCache(readCache),
+	)
+	duplicateService := services.NewDuplicateService(duplicateRepo, articleRepo)
+	statsService := services.NewStatsService(statsRepo, articleRepo, cfg.Stats, cfg.JWT.Secret, cfg.CORS.AllowedOrigins)
 	articleService := services.NewArticleService(db, articleRepo, categoryRepo, tagRepo,
 		services.WithEngagementRepo(engagementRepo),
 		services.WithUserRepo(userRepo),
+		services.WithSeriesRepo(seriesRepo),
+		services.WithSlugHistoryRepo(slugHistoryRepo),
+		services.WithArticleLockRepo(articleLockRepo),
+		services.WithRevisionRepo(articleRevisionRepo),
+		services.WithPreviewLinks(articlePreviewRepo, cfg.JWT.Secret),
+		services.WithRelatedRepo(relatedRepo),
+		services.WithDuplicateChecker(duplicateService),
+		services.WithViewRecorder(statsService),
+		services.WithReadCache(readCache),
 	)
 	mediaService := services.NewMediaService(mediaRepo, cfg.Upload)
 	searchService := services.NewSearchService(articleRepo, categoryRepo, tagRepo)
 	engagementService := services.NewEngagementService(engagementRepo, articleRepo, commentRepo, userRepo)
+	seriesService := services.NewSeriesService(db, seriesRepo, articleRepo, userRepo)
+	exportService := services.NewExportService(articleRepo, userRepo, cfg.Upload)
+	importService := services.NewImportService(db, articleRepo, categoryRepo, tagRepo, userRepo, mediaRepo, importRecordRepo, cfg.Upload,
+		services.WithImportReadCache(readCache),
+	)
+	trashService := services.NewTrashService(db, trashRepo, articleRepo, tagRepo, cfg.Trash.Retention,
+		services.WithTrashReadCache(readCache),
+	)
+	highlightService := services.NewHighlightService(highlightRepo, articleRepo, engagementRepo, userRepo)
+	relatedService := services.NewRelatedService(relatedRepo)
+	readingService := services.NewReadingService(readingRepo, articleRepo, userRepo)
+	trendingService := services.NewTrendingService(trendingRepo, cfg.Trending)
 
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(authService)
-	userHandler := handlers.NewUserHandler(userService)
-	userActionHandler := handlers.NewUserActionHandler(userService)
-	categoryHandler := handlers.NewCategoryHandler(categoryService)
-	tagHandler := handlers.NewTagHandler(tagService)
+	userHandler := handlers.NewUserHandler(userService, engagementService)
+	userActionHandler := handlers.NewUserActionHandler(userService, engagementService)
+	categoryHandler := handlers.NewCategoryHandler(categoryService, engagementService)
+	tagHandler := handlers.NewTagHandler(tagService, engagementService)
 	articleHandler := handlers.NewArticleHandler(articleService, engagementService)
 	mediaHandler := handlers.NewMediaHandler(mediaService, cfg.Upload)
-	searchHandler := handlers.NewSearchHandler(searchService)
+	searchHandler := handlers.NewSearchHandler(searchService, engagementService)
 	engagementHandler := handlers.NewEngagementHandler(engagementService)
+	seriesHandler := handlers.NewSeriesHandler(seriesService)
+	importHandler := handlers.NewImportHandler(importService)
+	exportHandler := handlers.NewExportHandler(exportService)
+	trashHandler := handlers.NewTrashHandler(trashService)
+	highlightHandler := handlers.NewHighlightHandler(highlightService)
+	duplicateHandler := handlers.NewDuplicateHandler(duplicateService)
+	readingHandler := handlers.NewReadingHandler(readingService)
+	statsHandler := handlers.NewStatsHandler(statsService)
+
+	// Start background jobs
+	scheduler := jobs.NewScheduler()
+	if cfg.Trash.Retention > 0 {
+		scheduler.Add(jobs.Job{
+			Name:     "trash-purge",
+			Interval: cfg.Trash.PurgeInterval,
+			Run: func(ctx context.Context) error {
+				purged, err := trashService.PurgeExpired(ctx)
+				if purged > 0 {
+					utils.Info("Purged expired trash", zap.Int("count", purged))
+				}
+				return err
+			},
+		})
+	}
+	scheduler.Add(jobs.Job{
+		Name:     "related-articles",
+		Interval: cfg.Related.RebuildInterval,
+		Run: func(ctx context.Context) error {
+			ranked, err := relatedService.RebuildRelated(ctx)
+			if err == nil {
+				utils.Info("Ranked related articles", zap.Int("articles", ranked))
+			}
+			return err
+		},
+	})
+	scheduler.Add(jobs.Job{
+		Name:     "article-stats",
+		Interval: cfg.Stats.RollupInterval,
+		Run: func(ctx context.Context) error {
+			_, err := statsService.RollupDailyStats(ctx)
+			return err
+		},
+	})
+	scheduler.Add(jobs.Job{
+		Name:     "trending",
+		Interval: cfg.Trending.RefreshInterval,
+		Run: func(ctx context.Context) error {
+			_, err := trendingService.RefreshTrending(ctx)
+			return err
+		},
+	})
+	scheduler.Start()
+	defer scheduler.Stop()
 
 	// Create Gin router (use gin.New() to avoid default middleware)
 	router := gin.New()
@@ -177,6 +285,11 @@ func main() {
 	}
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// Public reads answer conditional requests and may be kept by shared caches
+	httpCache := middlewares.HTTPCacheMiddleware(cfg.HTTPCache.SharedMaxAge)
+	// Articles are served in the translation that best matches Accept-Language
+	localizedCache := middlewares.HTTPCacheMiddleware(cfg.HTTPCache.SharedMaxAge, "Accept-Language")
+
 	// API v1 routes
 	v1 := router.Group("/api/v1")
 	{
@@ -206,7 +319,8 @@ func main() {
 			users.PUT("/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), userHandler.UpdateUser)
 			users.PUT("/:id/admin", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAdmin(), userHandler.AdminUpdateUser)
 			users.DELETE("/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAdmin(), userHandler.DeleteUser)
-			users.GET("/:id/articles", userHandler.GetUserArticles)
+			users.GET("/:id/articles", middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), userHandler.GetUserArticles)
+			users.GET("/:id/articles/export", middlewares.AuthMiddleware(cfg.JWT.Secret), exportHandler.ExportUserArticles)
 			// Social graph routes
 			users.POST("/:id/follow", middlewares.AuthMiddleware(cfg.JWT.Secret), userActionHandler.FollowUser)
 			users.POST("/:id/unfollow", middlewares.AuthMiddleware(cfg.JWT.Secret), userActionHandler.UnfollowUser)
@@ -217,46 +331,98 @@ func main() {
 			users.GET("/interests", middlewares.AuthMiddleware(cfg.JWT.Secret), userActionHandler.GetInterests)
 			// Bookmarked articles
 			users.GET("/bookmarks", middlewares.AuthMiddleware(cfg.JWT.Secret), engagementHandler.GetBookmarkedArticles)
+			// Highlights and notes
+			users.GET("/highlights", middlewares.AuthMiddleware(cfg.JWT.Secret), highlightHandler.GetMyHighlights)
+			users.DELETE("/highlights/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), highlightHandler.DeleteHighlight)
+			// Reading history
+			users.GET("/me/history", middlewares.AuthMiddleware(cfg.JWT.Secret), readingHandler.GetHistory)
+			users.DELETE("/me/history", middlewares.AuthMiddleware(cfg.JWT.Secret), readingHandler.ClearHistory)
+			users.DELETE("/me/history/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), readingHandler.DeleteHistoryEntry)
+
+			// Author stats
+			users.GET("/me/stats", middlewares.AuthMiddleware(cfg.JWT.Secret), statsHandler.GetMyStats)
 		}
 
 		// Article routes
 		articles := v1.Group("/articles")
 		{
 			// Public routes (with optional auth for view tracking)
-			articles.GET("", middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), articleHandler.GetArticles)
-			articles.GET("/trending", articleHandler.GetTrendingArticles)
-			articles.GET("/recent", articleHandler.GetRecentArticles)
-			articles.GET("/staff-picks", userActionHandler.GetStaffPicks)
+			articles.GET("", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), articleHandler.GetArticles)
+			articles.GET("/trending", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), articleHandler.GetTrendingArticles)
+			articles.GET("/recent", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), articleHandler.GetRecentArticles)
+			articles.GET("/staff-picks", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), userActionHandler.GetStaffPicks)
+			articles.GET("/revisions", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), articleHandler.GetPendingRevisions)
+			articles.GET("/duplicates", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), duplicateHandler.GetDuplicateReport)
+			articles.GET("/export", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAdmin(), exportHandler.ExportArticles)
 			articles.GET("/feed", middlewares.AuthMiddleware(cfg.JWT.Secret), userActionHandler.GetPersonalizedFeed)
-			articles.GET("/:slug", middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), articleHandler.GetArticle)
-			articles.GET("/:slug/related", articleHandler.GetRelatedArticles)
+			articles.GET("/:slug", localizedCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), articleHandler.GetArticle)
+			articles.GET("/:slug/related", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), articleHandler.GetRelatedArticles)
 
-			// Engagement routes (likes, bookmarks, comments)
+			// Engagement routes (likes, bookmarks, comments, highlights)
 			articles.POST("/:slug/like", middlewares.AuthMiddleware(cfg.JWT.Secret), engagementHandler.LikeArticle)
 			articles.DELETE("/:slug/like", middlewares.AuthMiddleware(cfg.JWT.Secret), engagementHandler.UnlikeArticle)
 			articles.GET("/:slug/like", middlewares.AuthMiddleware(cfg.JWT.Secret), engagementHandler.GetLikeStatus)
 			articles.POST("/:slug/bookmark", middlewares.AuthMiddleware(cfg.JWT.Secret), engagementHandler.BookmarkArticle)
 			articles.DELETE("/:slug/bookmark", middlewares.AuthMiddleware(cfg.JWT.Secret), engagementHandler.UnbookmarkArticle)
-			articles.GET("/:slug/comments", engagementHandler.GetComments)
+			articles.GET("/:slug/comments", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), engagementHandler.GetComments)
 			articles.POST("/:slug/comments", middlewares.AuthMiddleware(cfg.JWT.Secret), engagementHandler.CreateComment)
 			articles.PUT("/:slug/comments/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), engagementHandler.UpdateComment)
 			articles.DELETE("/:slug/comments/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), engagementHandler.DeleteComment)
+			articles.GET("/:slug/highlights", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), highlightHandler.GetPopularHighlights)
+			articles.POST("/:slug/highlights", middlewares.AuthMiddleware(cfg.JWT.Secret), highlightHandler.CreateHighlight)
+			articles.POST("/:slug/progress", middlewares.AuthMiddleware(cfg.JWT.Secret), readingHandler.RecordProgress)
+
+			// Article stats (author or editor)
+			articles.GET("/:slug/stats", middlewares.AuthMiddleware(cfg.JWT.Secret), statsHandler.GetArticleStats)
 
 			// Protected routes (use :slug param name to match Gin's requirement for
 			// consistent wildcard names; the value is still a UUID for these routes)
 			articles.POST("", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.CreateArticle)
 			articles.PUT("/:slug", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.UpdateArticle)
 			articles.DELETE("/:slug", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.DeleteArticle)
+			articles.PATCH("/:slug/comment-settings", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.UpdateCommentSettings)
 			articles.PATCH("/:slug/publish", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), articleHandler.PublishArticle)
 			articles.PATCH("/:slug/unpublish", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), articleHandler.UnpublishArticle)
+			articles.POST("/bulk", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), articleHandler.BulkUpdateArticles)
+			articles.PATCH("/duplicates/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), duplicateHandler.ReviewDuplicate)
+			articles.PUT("/:slug/staff-pick", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), articleHandler.PickArticle)
+			articles.DELETE("/:slug/staff-pick", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), articleHandler.UnpickArticle)
+
+			// Advisory edit locks (id param)
+			articles.GET("/:slug/lock", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.GetEditLock)
+			articles.POST("/:slug/lock", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.AcquireEditLock)
+			articles.DELETE("/:slug/lock", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.ReleaseEditLock)
+
+			// Pending revisions of published articles (id param)
+			articles.GET("/:slug/revision", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.GetPendingRevision)
+			articles.DELETE("/:slug/revision", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.DiscardRevision)
+			articles.PATCH("/:slug/revision/publish", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), articleHandler.PublishRevision)
+
+			// Signed preview links for unpublished articles (id param)
+			articles.POST("/:slug/previews", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.CreatePreviewLink)
+			articles.GET("/:slug/previews", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.GetPreviewLinks)
+			articles.DELETE("/:slug/previews/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), articleHandler.RevokePreviewLink)
+		}
+
+		// Public preview links (no login; token is signed and revocable)
+		v1.GET("/preview/:token", articleHandler.GetPreview)
+
+		// Series routes (multi-part articles)
+		series := v1.Group("/series")
+		{
+			series.GET("", httpCache, seriesHandler.GetSeriesList)
+			series.GET("/:slug", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), seriesHandler.GetSeries)
+			series.POST("", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), seriesHandler.CreateSeries)
+			series.PUT("/:slug", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), seriesHandler.UpdateSeries)
+			series.DELETE("/:slug", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAuthor(), seriesHandler.DeleteSeries)
 		}
 
 		// Category routes
 		categories := v1.Group("/categories")
 		{
-			categories.GET("", categoryHandler.GetCategories)
-			categories.GET("/:slug", categoryHandler.GetCategory)
-			categories.GET("/:slug/articles", categoryHandler.GetCategoryArticles)
+			categories.GET("", httpCache, categoryHandler.GetCategories)
+			categories.GET("/:slug", httpCache, categoryHandler.GetCategory)
+			categories.GET("/:slug/articles", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), categoryHandler.GetCategoryArticles)
 			categories.POST("", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), categoryHandler.CreateCategory)
 			categories.PUT("/:slug", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), categoryHandler.UpdateCategory)
 			categories.DELETE("/:slug", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), categoryHandler.DeleteCategory)
@@ -265,10 +431,10 @@ func main() {
 		// Tag routes
 		tags := v1.Group("/tags")
 		{
-			tags.GET("", tagHandler.GetTags)
-			tags.GET("/popular", tagHandler.GetPopularTags)
-			tags.GET("/:slug", tagHandler.GetTag)
-			tags.GET("/:slug/articles", tagHandler.GetTagArticles)
+			tags.GET("", httpCache, tagHandler.GetTags)
+			tags.GET("/popular", httpCache, tagHandler.GetPopularTags)
+			tags.GET("/:slug", httpCache, tagHandler.GetTag)
+			tags.GET("/:slug/articles", httpCache, middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), tagHandler.GetTagArticles)
 			tags.POST("", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), tagHandler.CreateTag)
 			tags.PUT("/:slug", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), tagHandler.UpdateTag)
 			tags.DELETE("/:slug", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireEditor(), tagHandler.DeleteTag)
@@ -283,6 +449,20 @@ func main() {
 			media.DELETE("/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), mediaHandler.DeleteMedia)
 		}
 
+		// Import routes (admin only)
+		imports := v1.Group("/imports")
+		{
+			imports.POST("", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAdmin(), importHandler.Import)
+		}
+
+		// Trash routes (admin only)
+		trash := v1.Group("/trash")
+		{
+			trash.GET("/:type", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAdmin(), trashHandler.GetTrash)
+			trash.POST("/:type/:id/restore", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAdmin(), trashHandler.RestoreItem)
+			trash.DELETE("/:type/:id", middlewares.AuthMiddleware(cfg.JWT.Secret), middlewares.RequireAdmin(), trashHandler.PurgeItem)
+		}
+
 		// Notification routes
 		notifications := v1.Group("/notifications")
 		{
@@ -293,7 +473,7 @@ func main() {
 		}
 
 		// Search route (with search rate limiting)
-		v1.GET("/search", middlewares.SearchRateLimiter(), searchHandler.Search)
+		v1.GET("/search", middlewares.SearchRateLimiter(), middlewares.OptionalAuthMiddleware(cfg.JWT.Secret), searchHandler.Search)
 	}
 
 	// Ensure upload directory exists
@@ -0,0 +1,114 @@
+// Package cache keeps the results of reads that are expensive to build, such
+// as trending articles or the category tree, so they aren't queried again on
+// every request. Cached reads are JSON documents under namespaced keys. When
+// an article, category or tag changes, the namespaces it makes stale are
+// dropped, and entries also expire on their own after a TTL.
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"go.uber.org/zap"
+)
+
+// Cache stores values under string keys for a limited time
+type Cache interface {
+	// Get returns the value stored under key, and whether there was one
+	Get(key string) ([]byte, bool, error)
+	// Set stores a value under key for ttl
+	Set(key string, value []byte, ttl time.Duration) error
+	// DeletePrefix drops every value whose key starts with prefix
+	DeletePrefix(prefix string) error
+}
+
+// Key namespaces of cached reads
+const (
+	ArticleReads  = "articles:"
+	CategoryReads = "categories:"
+	TagReads      = "tags:"
+)
+
+// Event is a change to content that makes cached reads stale
+type Event string
+
+// Content changes
+const (
+	ArticleChanged  Event = "article"
+	CategoryChanged Event = "category"
+	TagChanged      Event = "tag"
+)
+
+// staleReads lists the namespaces each change makes stale. Articles count
+// towards their categories and tags, and article lists name their categories
+// and tags.
+var staleReads = map[Event][]string{
+	ArticleChanged:  {ArticleReads, CategoryReads, TagReads},
+	CategoryChanged: {CategoryReads, ArticleReads},
+	TagChanged:      {TagReads, ArticleReads},
+}
+
+// ReadCache caches service reads in a Cache. A nil *ReadCache caches
+// nothing, so services work the same without one.
+type ReadCache struct {
+	store Cache
+	ttl   time.Duration
+}
+
+// NewReadCache creates a read cache whose entries expire after ttl
+func NewReadCache(store Cache, ttl time.Duration) *ReadCache {
+	return &ReadCache{store: store, ttl: ttl}
+}
+
+// Invalidate drops the cached reads the given changes make stale. A failure
+// is logged; the entries then expire after their TTL.
+func (r *ReadCache) Invalidate(events ...Event) {
+	if r == nil {
+		return
+	}
+	dropped := map[string]bool{}
+	for _, event := range events {
+		for _, prefix := range staleReads[event] {
+			if dropped[prefix] {
+				continue
+			}
+			dropped[prefix] = true
+			if err := r.store.DeletePrefix(prefix); err != nil {
+				utils.Warn("Failed to invalidate cached reads", zap.String("prefix", prefix), zap.Error(err))
+			}
+		}
+	}
+}
+
+// Remember returns the value cached under key, or loads it and caches it.
+// Errors from the cache are logged and fall back to load; errors from load
+// are returned and nothing is cached.
+func Remember[T any](r *ReadCache, key string, load func() (T, error)) (T, error) {
+	if r == nil {
+		return load()
+	}
+
+	data, ok, err := r.store.Get(key)
+	if err != nil {
+		utils.Warn("Failed to read cache", zap.String("key", key), zap.Error(err))
+	}
+	if ok {
+		var value T
+		if err := json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	if data, err := json.Marshal(value); err == nil {
+		if err := r.store.Set(key, data, r.ttl); err != nil {
+			utils.Warn("Failed to write cache", zap.String("key", key), zap.Error(err))
+		}
+	}
+	return value, nil
+}
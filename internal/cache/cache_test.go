@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type cachedTag struct {
+	Name string `json:"name"`
+}
+
+func TestRemember_LoadsOnce(t *testing.T) {
+	r := NewReadCache(NewMemoryCache(10), time.Minute)
+	loads := 0
+	load := func() ([]cachedTag, error) {
+		loads++
+		return []cachedTag{{Name: "go"}}, nil
+	}
+
+	first, err := Remember(r, TagReads+"popular:10", load)
+	assert.NoError(t, err)
+	second, err := Remember(r, TagReads+"popular:10", load)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, loads)
+	assert.Equal(t, first, second)
+}
+
+func TestRemember_DoesNotCacheErrors(t *testing.T) {
+	r := NewReadCache(NewMemoryCache(10), time.Minute)
+	loads := 0
+	load := func() (int, error) {
+		loads++
+		return 0, errors.New("database down")
+	}
+
+	_, err := Remember(r, ArticleReads+"recent", load)
+	assert.Error(t, err)
+	_, err = Remember(r, ArticleReads+"recent", load)
+	assert.Error(t, err)
+	assert.Equal(t, 2, loads)
+}
+
+func TestRemember_NilReadCache(t *testing.T) {
+	var r *ReadCache
+	loads := 0
+
+	for i := 0; i < 2; i++ {
+		value, err := Remember(r, "key", func() (string, error) {
+			loads++
+			return "fresh", nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "fresh", value)
+	}
+	assert.Equal(t, 2, loads)
+
+	r.Invalidate(ArticleChanged) // No-op
+}
+
+func TestInvalidate_DropsStaleNamespaces(t *testing.T) {
+	store := NewMemoryCache(10)
+	r := NewReadCache(store, time.Minute)
+	for _, key := range []string{ArticleReads + "recent", CategoryReads + "tree", TagReads + "popular"} {
+		store.Set(key, []byte(`1`), time.Minute)
+	}
+
+	// A category change leaves tags alone
+	r.Invalidate(CategoryChanged)
+	_, ok, _ := store.Get(ArticleReads + "recent")
+	assert.False(t, ok)
+	_, ok, _ = store.Get(CategoryReads + "tree")
+	assert.False(t, ok)
+	_, ok, _ = store.Get(TagReads + "popular")
+	assert.True(t, ok)
+
+	// An article change drops all three
+	r.Invalidate(ArticleChanged)
+	assert.Equal(t, 0, store.Len())
+}
@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memoryEntry is a value in the memory cache
+type memoryEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// MemoryCache is a Cache in process memory. It holds at most size entries
+// and evicts the least recently used one to make room for another.
+type MemoryCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // Most recently used first
+	entries map[string]*list.Element
+	now     func() time.Time
+}
+
+// NewMemoryCache creates a memory cache that holds at most size entries
+func NewMemoryCache(size int) *MemoryCache {
+	if size < 1 {
+		size = 1
+	}
+	return &MemoryCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value stored under key, unless it has expired
+func (m *MemoryCache) Get(key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*memoryEntry)
+	if !m.now().Before(entry.expiresAt) {
+		m.remove(element)
+		return nil, false, nil
+	}
+	m.order.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+// Set stores a value under key for ttl, evicting the least recently used
+// entry when the cache is full
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expiresAt := m.now().Add(ttl)
+	if element, ok := m.entries[key]; ok {
+		entry := element.Value.(*memoryEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		m.order.MoveToFront(element)
+		return nil
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryEntry{key: key, value: value, expiresAt: expiresAt})
+	for m.order.Len() > m.size {
+		m.remove(m.order.Back())
+	}
+	return nil
+}
+
+// DeletePrefix drops every entry whose key starts with prefix
+func (m *MemoryCache) DeletePrefix(prefix string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, element := range m.entries {
+		if strings.HasPrefix(key, prefix) {
+			m.remove(element)
+		}
+	}
+	return nil
+}
+
+// Len returns the number of entries, including expired ones not yet dropped
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+// remove drops an entry; the caller holds the lock
+func (m *MemoryCache) remove(element *list.Element) {
+	m.order.Remove(element)
+	delete(m.entries, element.Value.(*memoryEntry).key)
+}
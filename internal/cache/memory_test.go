@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryCache_GetSet(t *testing.T) {
+	c := NewMemoryCache(10)
+
+	_, ok, err := c.Get("missing")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, c.Set("key", []byte("value"), time.Minute))
+	value, ok, err := c.Get("key")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestMemoryCache_Expires(t *testing.T) {
+	c := NewMemoryCache(10)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	c.Set("key", []byte("value"), time.Minute)
+
+	now = now.Add(59 * time.Second)
+	_, ok, _ := c.Get("key")
+	assert.True(t, ok)
+
+	now = now.Add(time.Second)
+	_, ok, _ = c.Get("key")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestMemoryCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewMemoryCache(2)
+
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), time.Minute)
+	c.Get("a") // b is now the least recently used
+	c.Set("c", []byte("3"), time.Minute)
+
+	_, ok, _ := c.Get("b")
+	assert.False(t, ok)
+	_, ok, _ = c.Get("a")
+	assert.True(t, ok)
+	_, ok, _ = c.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestMemoryCache_DeletePrefix(t *testing.T) {
+	c := NewMemoryCache(10)
+
+	c.Set("articles:recent", []byte("1"), time.Minute)
+	c.Set("articles:trending", []byte("2"), time.Minute)
+	c.Set("tags:popular", []byte("3"), time.Minute)
+
+	assert.NoError(t, c.DeletePrefix("articles:"))
+
+	_, ok, _ := c.Get("articles:recent")
+	assert.False(t, ok)
+	_, ok, _ = c.Get("articles:trending")
+	assert.False(t, ok)
+	_, ok, _ = c.Get("tags:popular")
+	assert.True(t, ok)
+}
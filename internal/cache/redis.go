@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisScanCount is how many keys each SCAN step asks Redis for
+const redisScanCount = 500
+
+// RedisCache is a Cache in Redis, shared by every instance of the API.
+// Its keys are namespaced, so the Redis database can hold other data.
+type RedisCache struct {
+	client    *redis.Client
+	namespace string
+	timeout   time.Duration
+}
+
+// NewRedisCache creates a Redis cache whose keys all start with namespace
+func NewRedisCache(client *redis.Client, namespace string) *RedisCache {
+	return &RedisCache{client: client, namespace: namespace, timeout: 2 * time.Second}
+}
+
+// Get returns the value stored under key
+func (r *RedisCache) Get(key string) ([]byte, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	value, err := r.client.Get(ctx, r.namespace+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set stores a value under key for ttl
+func (r *RedisCache) Set(key string, value []byte, ttl time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	return r.client.Set(ctx, r.namespace+key, value, ttl).Err()
+}
+
+// DeletePrefix drops every key that starts with prefix. It walks the keys
+// with SCAN, so Redis isn't blocked on large databases.
+func (r *RedisCache) DeletePrefix(prefix string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	var cursor uint64
+	for {
+		keys, next, err := r.client.Scan(ctx, cursor, r.namespace+prefix+"*", redisScanCount).Result()
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			if err := r.client.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRedisCache(t *testing.T) (*RedisCache, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return NewRedisCache(client, "test:"), server
+}
+
+func TestRedisCache_GetSet(t *testing.T) {
+	c, server := newTestRedisCache(t)
+
+	_, ok, err := c.Get("missing")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, c.Set("key", []byte("value"), time.Minute))
+	value, ok, err := c.Get("key")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+
+	// Keys are namespaced and expire
+	assert.True(t, server.Exists("test:key"))
+	server.FastForward(time.Minute)
+	_, ok, _ = c.Get("key")
+	assert.False(t, ok)
+}
+
+func TestRedisCache_DeletePrefix(t *testing.T) {
+	c, server := newTestRedisCache(t)
+	server.Set("other:articles:recent", "kept")
+
+	c.Set("articles:recent", []byte("1"), time.Minute)
+	c.Set("articles:trending:7d", []byte("2"), time.Minute)
+	c.Set("tags:popular", []byte("3"), time.Minute)
+
+	assert.NoError(t, c.DeletePrefix("articles:"))
+
+	_, ok, _ := c.Get("articles:recent")
+	assert.False(t, ok)
+	_, ok, _ = c.Get("articles:trending:7d")
+	assert.False(t, ok)
+	_, ok, _ = c.Get("tags:popular")
+	assert.True(t, ok)
+	assert.True(t, server.Exists("other:articles:recent"))
+}
+
+func TestRedisCache_Unavailable(t *testing.T) {
+	c, server := newTestRedisCache(t)
+	server.Close()
+
+	_, ok, err := c.Get("key")
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
@@ -20,6 +20,12 @@ type Config struct {
 	RateLimit   RateLimitConfig
 	Security    SecurityConfig
 	GoogleOAuth GoogleOAuthConfig
+	Trash       TrashConfig
+	Related     RelatedConfig
+	Stats       StatsConfig
+	Trending    TrendingConfig
+	HTTPCache   HTTPCacheConfig
+	Cache       CacheConfig
 }
 
 // GoogleOAuthConfig holds Google OAuth configuration
@@ -78,6 +84,46 @@ type SecurityConfig struct {
 	LogPath            string
 }
 
+// TrashConfig holds configuration for soft-deleted content
+type TrashConfig struct {
+	Retention     time.Duration // How long deleted items are kept before they are purged; 0 keeps them forever
+	PurgeInterval time.Duration
+}
+
+// RelatedConfig holds configuration for the related article ranking
+type RelatedConfig struct {
+	RebuildInterval time.Duration // How often the ranking is recomputed; 0 disables the job
+}
+
+// StatsConfig holds configuration for view counting and daily article statistics
+type StatsConfig struct {
+	ViewWindow     time.Duration // How long repeat views by the same visitor are not counted
+	RollupInterval time.Duration // How often daily statistics are recomputed; 0 disables the job
+	VisitRetention time.Duration // How long per-visitor records are kept
+}
+
+// TrendingConfig holds configuration for the trending score
+type TrendingConfig struct {
+	RefreshInterval time.Duration // How often scores are recomputed; 0 disables the job
+	Gravity         float64       // How fast engagement fades; higher favours the most recent activity
+	ViewWeight      float64
+	LikeWeight      float64
+	CommentWeight   float64
+	BookmarkWeight  float64
+}
+
+// HTTPCacheConfig holds configuration for HTTP caching of public reads
+type HTTPCacheConfig struct {
+	SharedMaxAge time.Duration // How long CDNs and proxies may serve an anonymous response without revalidating
+}
+
+// CacheConfig holds configuration for the application read cache
+type CacheConfig struct {
+	TTL      time.Duration // How long cached reads live; 0 disables the cache
+	Size     int           // How many entries the in-memory cache holds
+	RedisURL string        // Redis to share the cache between instances; empty keeps it in memory
+}
+
 // LoadConfig loads configuration from environment variables
 func LoadConfig() (*Config, error) {
 	// Check ENV_FILE to support multiple environments:
@@ -135,6 +181,34 @@ func LoadConfig() (*Config, error) {
 			ClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
 			RedirectURLs: parseSlice(getEnv("GOOGLE_REDIRECT_URLS", "http://localhost:5173,http://localhost:3000")),
 		},
+		Trash: TrashConfig{
+			Retention:     parseDuration(getEnv("TRASH_RETENTION", "720h")),
+			PurgeInterval: parseDuration(getEnv("TRASH_PURGE_INTERVAL", "1h")),
+		},
+		Related: RelatedConfig{
+			RebuildInterval: parseDuration(getEnv("RELATED_REBUILD_INTERVAL", "1h")),
+		},
+		Stats: StatsConfig{
+			ViewWindow:     parseDuration(getEnv("VIEW_DEDUPE_WINDOW", "30m")),
+			RollupInterval: parseDuration(getEnv("STATS_ROLLUP_INTERVAL", "15m")),
+			VisitRetention: parseDuration(getEnv("VISIT_RETENTION", "168h")),
+		},
+		Trending: TrendingConfig{
+			RefreshInterval: parseDuration(getEnv("TRENDING_REFRESH_INTERVAL", "15m")),
+			Gravity:         parseFloat(getEnv("TRENDING_GRAVITY", "1.8")),
+			ViewWeight:      parseFloat(getEnv("TRENDING_VIEW_WEIGHT", "1")),
+			LikeWeight:      parseFloat(getEnv("TRENDING_LIKE_WEIGHT", "5")),
+			CommentWeight:   parseFloat(getEnv("TRENDING_COMMENT_WEIGHT", "8")),
+			BookmarkWeight:  parseFloat(getEnv("TRENDING_BOOKMARK_WEIGHT", "10")),
+		},
+		HTTPCache: HTTPCacheConfig{
+			SharedMaxAge: parseDuration(getEnv("HTTP_CACHE_SHARED_MAX_AGE", "60s")),
+		},
+		Cache: CacheConfig{
+			TTL:      parseDuration(getEnv("CACHE_TTL", "5m")),
+			Size:     parseInt(getEnv("CACHE_SIZE", "1000")),
+			RedisURL: getEnv("REDIS_URL", ""),
+		},
 	}, nil
 }
 
@@ -182,6 +256,15 @@ func parseInt64(s string) int64 {
 	return i
 }
 
+// parseFloat parses a float string with a fallback
+func parseFloat(s string) float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 1
+	}
+	return f
+}
+
 // parseBool parses a boolean string with a fallback
 func parseBool(s string) bool {
 	b, err := strconv.ParseBool(s)
@@ -143,22 +143,31 @@ func migrateAllTablesRawSQL(db *gorm.DB) error {
 			slug VARCHAR(255) NOT NULL,
 			excerpt TEXT DEFAULT '',
 			content TEXT NOT NULL,
+			content_format VARCHAR(20) NOT NULL DEFAULT 'html',
+			content_html TEXT DEFAULT '',
+			table_of_contents TEXT DEFAULT '',
 			featured_image_url VARCHAR(500),
 			author_id UUID NOT NULL,
 			status VARCHAR(20) NOT NULL DEFAULT 'draft',
 			published_at TIMESTAMPTZ,
 			view_count INT DEFAULT 0,
+			read_count INT DEFAULT 0,
 			reading_time_minutes INT DEFAULT 1,
 			is_staff_pick BOOLEAN DEFAULT FALSE,
 			meta_title VARCHAR(70) DEFAULT '',
 			meta_description VARCHAR(160) DEFAULT '',
 			meta_keywords VARCHAR(255) DEFAULT '',
+			version INT NOT NULL DEFAULT 1,
+			locale VARCHAR(35) NOT NULL DEFAULT 'en',
+			translation_group_id UUID,
 			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
 			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
 			deleted_at TIMESTAMPTZ,
 			CONSTRAINT fk_articles_author FOREIGN KEY (author_id) REFERENCES users(id)
 		)`,
-		`CREATE UNIQUE INDEX IF NOT EXISTS idx_articles_slug ON articles(slug)`,
+		// Slugs only need to be unique among live articles, so trashed articles don't hold on to theirs
+		`DROP INDEX IF EXISTS idx_articles_slug`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_articles_live_slug ON articles(slug) WHERE deleted_at IS NULL`,
 		`CREATE INDEX IF NOT EXISTS idx_articles_title ON articles(title)`,
 		`CREATE INDEX IF NOT EXISTS idx_articles_author_id ON articles(author_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_articles_status ON articles(status)`,
@@ -172,8 +181,28 @@ func migrateAllTablesRawSQL(db *gorm.DB) error {
 			ALTER TABLE articles ADD COLUMN IF NOT EXISTS meta_title VARCHAR(70) DEFAULT '';
 			ALTER TABLE articles ADD COLUMN IF NOT EXISTS meta_description VARCHAR(160) DEFAULT '';
 			ALTER TABLE articles ADD COLUMN IF NOT EXISTS meta_keywords VARCHAR(255) DEFAULT '';
+			-- Existing rows predate Markdown support and hold HTML
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS content_format VARCHAR(20) NOT NULL DEFAULT 'html';
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS content_html TEXT DEFAULT '';
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS table_of_contents TEXT DEFAULT '';
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS locale VARCHAR(35) NOT NULL DEFAULT 'en';
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS translation_group_id UUID;
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS visibility VARCHAR(20) NOT NULL DEFAULT 'public';
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS comments_disabled BOOLEAN DEFAULT FALSE;
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS comments_locked BOOLEAN DEFAULT FALSE;
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS comments_close_after_days INT;
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS comment_audience VARCHAR(20) NOT NULL DEFAULT 'everyone';
+			ALTER TABLE articles ADD COLUMN IF NOT EXISTS read_count INT DEFAULT 0;
 		EXCEPTION WHEN others THEN NULL;
 		END $$`,
+		// Every article starts as the only member of its own translation group
+		`UPDATE articles SET translation_group_id = id WHERE translation_group_id IS NULL`,
+		`CREATE INDEX IF NOT EXISTS idx_articles_locale ON articles(locale)`,
+		`CREATE INDEX IF NOT EXISTS idx_articles_translation_group_id ON articles(translation_group_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_articles_visibility ON articles(visibility)`,
+		// One translation per locale within a group
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_articles_translation_locale ON articles(translation_group_id, locale) WHERE deleted_at IS NULL`,
 
 		// ==================== ARTICLE_CATEGORIES (join) ====================
 		`CREATE TABLE IF NOT EXISTS article_categories (
@@ -298,6 +327,262 @@ func migrateAllTablesRawSQL(db *gorm.DB) error {
 		`CREATE INDEX IF NOT EXISTS idx_notifications_type ON notifications(type)`,
 		`CREATE INDEX IF NOT EXISTS idx_notifications_article_id ON notifications(article_id)`,
 		`CREATE INDEX IF NOT EXISTS idx_notifications_read ON notifications(read)`,
+
+		// ==================== SERIES ====================
+		`CREATE TABLE IF NOT EXISTS series (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			title VARCHAR(255) NOT NULL,
+			slug VARCHAR(255) NOT NULL,
+			description TEXT DEFAULT '',
+			cover_image_url VARCHAR(500),
+			author_id UUID NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			deleted_at TIMESTAMPTZ,
+			CONSTRAINT fk_series_author FOREIGN KEY (author_id) REFERENCES users(id)
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_series_slug ON series(slug)`,
+		`CREATE INDEX IF NOT EXISTS idx_series_author_id ON series(author_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_series_deleted_at ON series(deleted_at)`,
+
+		// ==================== SERIES_ARTICLES (ordered join) ====================
+		`CREATE TABLE IF NOT EXISTS series_articles (
+			series_id UUID NOT NULL,
+			article_id UUID NOT NULL,
+			position INT NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (series_id, article_id),
+			CONSTRAINT fk_sa_series FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE,
+			CONSTRAINT fk_sa_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_series_articles_article_id ON series_articles(article_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_series_articles_position ON series_articles(series_id, position)`,
+
+		// ==================== SLUG_HISTORY ====================
+		// entity_id is polymorphic (article, category or tag), so it carries no foreign key
+		`CREATE TABLE IF NOT EXISTS slug_history (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			entity_type VARCHAR(20) NOT NULL,
+			entity_id UUID NOT NULL,
+			old_slug VARCHAR(255) NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_slug_history_entity_slug ON slug_history(entity_type, old_slug)`,
+		`CREATE INDEX IF NOT EXISTS idx_slug_history_entity_id ON slug_history(entity_id)`,
+
+		// ==================== ARTICLE_REVISIONS (pending edits) ====================
+		`CREATE TABLE IF NOT EXISTS article_revisions (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			article_id UUID NOT NULL,
+			editor_id UUID NOT NULL,
+			changes TEXT NOT NULL,
+			base_version INT NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'pending',
+			reviewed_by UUID,
+			reviewed_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			CONSTRAINT fk_ar_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+			CONSTRAINT fk_ar_editor FOREIGN KEY (editor_id) REFERENCES users(id),
+			CONSTRAINT fk_ar_reviewer FOREIGN KEY (reviewed_by) REFERENCES users(id)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_revisions_article_id ON article_revisions(article_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_revisions_status ON article_revisions(status)`,
+		// One working copy per article
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_article_revisions_pending ON article_revisions(article_id) WHERE status = 'pending'`,
+
+		// ==================== ARTICLE_EDIT_LOCKS (advisory) ====================
+		`CREATE TABLE IF NOT EXISTS article_edit_locks (
+			article_id UUID PRIMARY KEY,
+			user_id UUID NOT NULL,
+			expires_at TIMESTAMPTZ NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			CONSTRAINT fk_ael_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+			CONSTRAINT fk_ael_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+		)`,
+
+		// ==================== ARTICLE_PREVIEWS (signed draft links) ====================
+		`CREATE TABLE IF NOT EXISTS article_previews (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			article_id UUID NOT NULL,
+			created_by UUID NOT NULL,
+			expires_at TIMESTAMPTZ NOT NULL,
+			revoked_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			CONSTRAINT fk_ap_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+			CONSTRAINT fk_ap_creator FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_previews_article_id ON article_previews(article_id)`,
+
+		// ==================== STAFF_PICKS (curation details) ====================
+		`CREATE TABLE IF NOT EXISTS staff_picks (
+			article_id UUID PRIMARY KEY,
+			curator_id UUID,
+			note VARCHAR(500) DEFAULT '',
+			position INT,
+			starts_at TIMESTAMPTZ,
+			ends_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			CONSTRAINT fk_sp_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+			CONSTRAINT fk_sp_curator FOREIGN KEY (curator_id) REFERENCES users(id) ON DELETE SET NULL
+		)`,
+
+		// ==================== HIGHLIGHTS (reader highlights and notes) ====================
+		`CREATE TABLE IF NOT EXISTS highlights (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			user_id UUID NOT NULL,
+			article_id UUID NOT NULL,
+			quote TEXT NOT NULL,
+			prefix VARCHAR(100) DEFAULT '',
+			suffix VARCHAR(100) DEFAULT '',
+			note TEXT DEFAULT '',
+			is_public BOOLEAN DEFAULT FALSE,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			CONSTRAINT fk_highlights_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+			CONSTRAINT fk_highlights_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_highlights_user_id ON highlights(user_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_highlights_article_public ON highlights(article_id, is_public)`,
+
+		// ==================== RELATED_ARTICLES (precomputed suggestions) ====================
+		`CREATE TABLE IF NOT EXISTS related_articles (
+			article_id UUID NOT NULL,
+			related_id UUID NOT NULL,
+			rank INT NOT NULL,
+			score DOUBLE PRECISION NOT NULL,
+			computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (article_id, related_id),
+			CONSTRAINT fk_ra_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+			CONSTRAINT fk_ra_related FOREIGN KEY (related_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_related_articles_rank ON related_articles(article_id, rank)`,
+
+		// ==================== READING_PROGRESS (reading history) ====================
+		`CREATE TABLE IF NOT EXISTS reading_progress (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			user_id UUID NOT NULL,
+			article_id UUID NOT NULL,
+			progress INT NOT NULL DEFAULT 0,
+			max_progress INT NOT NULL DEFAULT 0,
+			time_spent_seconds INT NOT NULL DEFAULT 0,
+			completed_at TIMESTAMPTZ,
+			last_read_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			CONSTRAINT uq_reading_progress_user_article UNIQUE (user_id, article_id),
+			CONSTRAINT fk_rp_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+			CONSTRAINT fk_rp_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_reading_progress_last_read ON reading_progress(user_id, last_read_at)`,
+		`CREATE TABLE IF NOT EXISTS article_reads (
+			user_id UUID NOT NULL,
+			article_id UUID NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (user_id, article_id),
+			CONSTRAINT fk_ar_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+			CONSTRAINT fk_ar_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_reads_created ON article_reads(created_at)`,
+
+		// ==================== ARTICLE_STATS (deduplicated views and daily totals) ====================
+		`CREATE TABLE IF NOT EXISTS article_visits (
+			article_id UUID NOT NULL,
+			visitor_key VARCHAR(64) NOT NULL,
+			day DATE NOT NULL,
+			views INT NOT NULL DEFAULT 1,
+			last_viewed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (article_id, visitor_key, day),
+			CONSTRAINT fk_av_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_visits_day ON article_visits(day)`,
+		`CREATE TABLE IF NOT EXISTS article_daily_stats (
+			article_id UUID NOT NULL,
+			day DATE NOT NULL,
+			views INT NOT NULL DEFAULT 0,
+			unique_visitors INT NOT NULL DEFAULT 0,
+			reads INT NOT NULL DEFAULT 0,
+			likes INT NOT NULL DEFAULT 0,
+			comments INT NOT NULL DEFAULT 0,
+			bookmarks INT NOT NULL DEFAULT 0,
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (article_id, day),
+			CONSTRAINT fk_ads_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_daily_stats_day ON article_daily_stats(day)`,
+		// Add columns that may not exist yet (idempotent)
+		`DO $$ BEGIN
+			ALTER TABLE article_daily_stats ADD COLUMN IF NOT EXISTS bookmarks INT NOT NULL DEFAULT 0;
+		EXCEPTION WHEN others THEN NULL;
+		END $$`,
+		`CREATE TABLE IF NOT EXISTS article_referrers (
+			article_id UUID NOT NULL,
+			day DATE NOT NULL,
+			source VARCHAR(255) NOT NULL,
+			views INT NOT NULL DEFAULT 0,
+			PRIMARY KEY (article_id, day, source),
+			CONSTRAINT fk_ar_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_referrers_day ON article_referrers(day)`,
+
+		// ==================== TRENDING (precomputed scores per window) ====================
+		`CREATE TABLE IF NOT EXISTS article_trending (
+			article_id UUID NOT NULL,
+			period VARCHAR(8) NOT NULL,
+			score DOUBLE PRECISION NOT NULL,
+			computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (article_id, period),
+			CONSTRAINT fk_at_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_article_trending_period_score ON article_trending(period, score DESC)`,
+
+		// ==================== DUPLICATE DETECTION (fingerprints and flags) ====================
+		`CREATE TABLE IF NOT EXISTS article_fingerprints (
+			article_id UUID PRIMARY KEY,
+			signature BYTEA NOT NULL,
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			CONSTRAINT fk_af_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE TABLE IF NOT EXISTS fingerprint_bands (
+			article_id UUID NOT NULL,
+			band INT NOT NULL,
+			hash BIGINT NOT NULL,
+			PRIMARY KEY (article_id, band),
+			CONSTRAINT fk_fb_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_fingerprint_bands_hash ON fingerprint_bands(band, hash)`,
+		`CREATE TABLE IF NOT EXISTS duplicate_flags (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			article_id UUID NOT NULL,
+			matched_article_id UUID NOT NULL,
+			similarity DOUBLE PRECISION NOT NULL,
+			overlap DOUBLE PRECISION NOT NULL,
+			passages TEXT DEFAULT '[]',
+			status VARCHAR(20) DEFAULT 'pending',
+			reviewed_by UUID,
+			reviewed_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			CONSTRAINT uq_duplicate_pair UNIQUE (article_id, matched_article_id),
+			CONSTRAINT fk_df_article FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+			CONSTRAINT fk_df_matched FOREIGN KEY (matched_article_id) REFERENCES articles(id) ON DELETE CASCADE,
+			CONSTRAINT fk_df_reviewer FOREIGN KEY (reviewed_by) REFERENCES users(id) ON DELETE SET NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_duplicate_flags_status ON duplicate_flags(status)`,
+
+		// ==================== IMPORT_RECORDS (idempotent imports) ====================
+		// entity_id is polymorphic (article or media), so it carries no foreign key
+		`CREATE TABLE IF NOT EXISTS import_records (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			entity_type VARCHAR(20) NOT NULL,
+			source_key VARCHAR(512) NOT NULL,
+			entity_id UUID NOT NULL,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS idx_import_records_source ON import_records(entity_type, source_key)`,
+		`CREATE INDEX IF NOT EXISTS idx_import_records_entity_id ON import_records(entity_id)`,
 	}
 
 	for _, query := range queries {
@@ -4,87 +4,170 @@ import "time"
 
 // CreateArticleRequest represents an article creation request
 type CreateArticleRequest struct {
-	Title            string   `json:"title" binding:"required,min=5,max=255"`
-	Content          string   `json:"content" binding:"required,min=50"`
-	Excerpt          string   `json:"excerpt" binding:"omitempty,max=500"`
-	FeaturedImageURL *string  `json:"featured_image_url" binding:"omitempty,url"`
-	CategoryIDs      []string `json:"category_ids" binding:"required,min=1,dive,uuid"`
-	TagIDs           []string `json:"tag_ids" binding:"omitempty,dive,uuid"`
-	Status           string   `json:"status" binding:"omitempty,oneof=draft published"`
-	MetaTitle        string   `json:"meta_title" binding:"omitempty,max=70"`
-	MetaDescription  string   `json:"meta_description" binding:"omitempty,max=160"`
-	MetaKeywords     string   `json:"meta_keywords" binding:"omitempty,max=255"`
+	Title            string                  `json:"title" binding:"required,min=5,max=255"`
+	Content          string                  `json:"content" binding:"required,min=50"`
+	ContentFormat    string                  `json:"content_format" binding:"omitempty,oneof=markdown html"`
+	Excerpt          string                  `json:"excerpt" binding:"omitempty,max=500"`
+	FeaturedImageURL *string                 `json:"featured_image_url" binding:"omitempty,url"`
+	CategoryIDs      []string                `json:"category_ids" binding:"required,min=1,dive,uuid"`
+	TagIDs           []string                `json:"tag_ids" binding:"omitempty,dive,uuid"`
+	Status           string                  `json:"status" binding:"omitempty,oneof=draft published"`
+	Visibility       string                  `json:"visibility" binding:"omitempty,oneof=public unlisted followers members"` // Defaults to "public"
+	MetaTitle        string                  `json:"meta_title" binding:"omitempty,max=70"`
+	MetaDescription  string                  `json:"meta_description" binding:"omitempty,max=160"`
+	MetaKeywords     string                  `json:"meta_keywords" binding:"omitempty,max=255"`
+	Locale           string                  `json:"locale" binding:"omitempty,max=35"`       // BCP 47 tag; defaults to "en"
+	TranslationOf    string                  `json:"translation_of" binding:"omitempty,uuid"` // ID of an article this one translates
+	Comments         *CommentSettingsRequest `json:"comments"`
 }
 
 // UpdateArticleRequest represents an article update request
 type UpdateArticleRequest struct {
 	Title            *string  `json:"title" binding:"omitempty,min=5,max=255"`
 	Content          *string  `json:"content" binding:"omitempty,min=50"`
+	ContentFormat    *string  `json:"content_format" binding:"omitempty,oneof=markdown html"`
 	Excerpt          *string  `json:"excerpt" binding:"omitempty,max=500"`
 	FeaturedImageURL *string  `json:"featured_image_url" binding:"omitempty,url"`
 	CategoryIDs      []string `json:"category_ids" binding:"omitempty,min=1,dive,uuid"`
 	TagIDs           []string `json:"tag_ids" binding:"omitempty,dive,uuid"`
+	Visibility       *string  `json:"visibility" binding:"omitempty,oneof=public unlisted followers members"`
 	MetaTitle        *string  `json:"meta_title" binding:"omitempty,max=70"`
 	MetaDescription  *string  `json:"meta_description" binding:"omitempty,max=160"`
 	MetaKeywords     *string  `json:"meta_keywords" binding:"omitempty,max=255"`
+	Locale           *string  `json:"locale" binding:"omitempty,max=35"`
+	Version          *int     `json:"version" binding:"omitempty,min=1"` // Base version; If-Match takes precedence
 }
 
 // ArticleListQuery represents query parameters for listing articles
 type ArticleListQuery struct {
 	PaginationQuery
-	CategorySlug string `form:"category" binding:"omitempty"`
-	TagSlug      string `form:"tag" binding:"omitempty"`
-	AuthorID     string `form:"author_id" binding:"omitempty,uuid"`
-	Status       string `form:"status" binding:"omitempty,oneof=draft published archived"`
-	Search       string `form:"search" binding:"omitempty,max=100"`
-	FromDate     string `form:"from_date" binding:"omitempty"`
-	ToDate       string `form:"to_date" binding:"omitempty"`
+	CategorySlug string `form:"category" json:"category" binding:"omitempty"`
+	TagSlug      string `form:"tag" json:"tag" binding:"omitempty"`
+	AuthorID     string `form:"author_id" json:"author_id" binding:"omitempty,uuid"`
+	Status       string `form:"status" json:"status" binding:"omitempty,oneof=draft published archived"`
+	Search       string `form:"search" json:"search" binding:"omitempty,max=100"`
+	FromDate     string `form:"from_date" json:"from_date" binding:"omitempty"`
+	ToDate       string `form:"to_date" json:"to_date" binding:"omitempty"`
 }
 
-// ArticleResponse represents an article in API responses
-type ArticleResponse struct {
-	ID                 string               `json:"id"`
-	Title              string               `json:"title"`
-	Slug               string               `json:"slug"`
-	Excerpt            string               `json:"excerpt"`
-	FeaturedImageURL   *string              `json:"featured_image_url"`
-	Author             PublicUserResponse   `json:"author"`
-	Status             string               `json:"status"`
-	PublishedAt        *time.Time           `json:"published_at"`
-	ViewCount          int                  `json:"view_count"`
-	ReadingTimeMinutes int                  `json:"reading_time_minutes"`
-	Categories         []CategoryResponse   `json:"categories"`
-	Tags               []TagResponse        `json:"tags"`
-	CreatedAt          time.Time            `json:"created_at"`
-	UpdatedAt          time.Time            `json:"updated_at"`
+// FeedQuery represents query parameters for the personalized feed
+type FeedQuery struct {
+	PaginationQuery
+	ExcludeRead bool `form:"exclude_read" json:"exclude_read"` // Leave out articles the user has finished reading
 }
 
-// ArticleDetailResponse represents detailed article information
-type ArticleDetailResponse struct {
+// TrendingQuery represents query parameters for trending articles
+type TrendingQuery struct {
+	Limit    int    `form:"limit" json:"limit"`                                        // Defaults to 10, at most 50
+	Window   string `form:"window" json:"window" binding:"omitempty,oneof=24h 7d 30d"` // Defaults to 7d
+	Category string `form:"category" json:"category"`                                  // Category slug
+	Lang     string `form:"lang" json:"lang"`                                          // Only articles in this language
+}
+
+// ArticleResponse represents an article in API responses
+type ArticleResponse struct {
 	ID                 string             `json:"id"`
 	Title              string             `json:"title"`
 	Slug               string             `json:"slug"`
 	Excerpt            string             `json:"excerpt"`
-	Content            string             `json:"content"`
 	FeaturedImageURL   *string            `json:"featured_image_url"`
 	Author             PublicUserResponse `json:"author"`
 	Status             string             `json:"status"`
 	PublishedAt        *time.Time         `json:"published_at"`
 	ViewCount          int                `json:"view_count"`
 	ReadingTimeMinutes int                `json:"reading_time_minutes"`
-	LikesCount         int                `json:"likes_count"`
-	CommentsCount      int                `json:"comments_count"`
-	UserLiked          bool               `json:"user_liked"`
-	UserBookmarked     bool               `json:"user_bookmarked"`
-	MetaTitle          string             `json:"meta_title"`
-	MetaDescription    string             `json:"meta_description"`
-	MetaKeywords       string             `json:"meta_keywords"`
 	Categories         []CategoryResponse `json:"categories"`
 	Tags               []TagResponse      `json:"tags"`
 	CreatedAt          time.Time          `json:"created_at"`
 	UpdatedAt          time.Time          `json:"updated_at"`
 }
 
+// ArticleDetailResponse represents detailed article information
+type ArticleDetailResponse struct {
+	ID                 string                   `json:"id"`
+	Title              string                   `json:"title"`
+	Slug               string                   `json:"slug"`
+	Excerpt            string                   `json:"excerpt"`
+	Content            string                   `json:"content"`
+	ContentFormat      string                   `json:"content_format"`
+	ContentHTML        string                   `json:"content_html,omitempty"`
+	TableOfContents    []TOCEntry               `json:"table_of_contents,omitempty"`
+	FeaturedImageURL   *string                  `json:"featured_image_url"`
+	Author             PublicUserResponse       `json:"author"`
+	Status             string                   `json:"status"`
+	Visibility         string                   `json:"visibility"`
+	PublishedAt        *time.Time               `json:"published_at"`
+	ViewCount          int                      `json:"view_count"`
+	ReadCount          int                      `json:"read_count"` // Readers who finished the article
+	ReadingTimeMinutes int                      `json:"reading_time_minutes"`
+	LikesCount         int                      `json:"likes_count"`
+	CommentsCount      int                      `json:"comments_count"`
+	UserLiked          bool                     `json:"user_liked"`
+	UserBookmarked     bool                     `json:"user_bookmarked"`
+	MetaTitle          string                   `json:"meta_title"`
+	MetaDescription    string                   `json:"meta_description"`
+	MetaKeywords       string                   `json:"meta_keywords"`
+	Categories         []CategoryResponse       `json:"categories"`
+	Tags               []TagResponse            `json:"tags"`
+	Locale             string                   `json:"locale"`
+	Translations       []ArticleTranslation     `json:"translations"`
+	Series             *ArticleSeriesResponse   `json:"series,omitempty"`
+	PendingRevision    *ArticleRevisionResponse `json:"pending_revision,omitempty"`
+	Version            int                      `json:"version"`
+	IsPreview          bool                     `json:"is_preview,omitempty"`
+	IsTruncated        bool                     `json:"is_truncated,omitempty"` // Members-only content cut short for an anonymous reader
+	Comments           CommentSettingsResponse  `json:"comments"`
+	CreatedAt          time.Time                `json:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+}
+
+// CommentSettingsRequest represents changes to an article's comment settings
+type CommentSettingsRequest struct {
+	Enabled        *bool   `json:"enabled"`
+	Locked         *bool   `json:"locked"`                                                         // Existing comments stay visible but read-only
+	CloseAfterDays *int    `json:"close_after_days" binding:"omitempty,min=0,max=3650"`            // Days after publication; 0 never closes
+	Audience       *string `json:"audience" binding:"omitempty,oneof=everyone followers verified"` // Who may comment
+}
+
+// CommentSettingsResponse represents an article's comment settings and their effect
+type CommentSettingsResponse struct {
+	Enabled        bool       `json:"enabled"`
+	Locked         bool       `json:"locked"`
+	CloseAfterDays *int       `json:"close_after_days"`
+	ClosesAt       *time.Time `json:"closes_at"`
+	Audience       string     `json:"audience"`
+	State          string     `json:"state"` // open, disabled, locked or closed
+}
+
+// ArticleTranslation represents another language version of an article
+type ArticleTranslation struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Slug   string `json:"slug"`
+	Locale string `json:"locale"`
+}
+
+// TOCEntry represents a heading in an article's table of contents
+type TOCEntry struct {
+	Level  int    `json:"level"`
+	Text   string `json:"text"`
+	Anchor string `json:"anchor"`
+}
+
+// ApplyFormat shapes the content fields for the requested format:
+// "html" returns the rendered HTML as content, "markdown" returns only the source,
+// and an empty format returns both
+func (r *ArticleDetailResponse) ApplyFormat(format string) {
+	switch format {
+	case "html":
+		r.Content = r.ContentHTML
+		r.ContentFormat = "html"
+		r.ContentHTML = ""
+	case "markdown":
+		r.ContentHTML = ""
+	}
+}
+
 // ArticleListItemResponse represents an article item in a list
 type ArticleListItemResponse struct {
 	ID                 string             `json:"id"`
@@ -94,13 +177,129 @@ type ArticleListItemResponse struct {
 	FeaturedImageURL   *string            `json:"featured_image_url"`
 	Author             PublicUserResponse `json:"author"`
 	Status             string             `json:"status"`
+	Visibility         string             `json:"visibility"`
 	PublishedAt        *time.Time         `json:"published_at"`
 	ViewCount          int                `json:"view_count"`
+	ReadCount          int                `json:"read_count"`
 	ReadingTimeMinutes int                `json:"reading_time_minutes"`
+	LikesCount         int                `json:"likes_count"`
+	CommentsCount      int                `json:"comments_count"`
+	UserLiked          bool               `json:"user_liked"`
+	UserBookmarked     bool               `json:"user_bookmarked"`
+	Locale             string             `json:"locale"`
+	StaffPickNote      string             `json:"staff_pick_note,omitempty"` // Curator note, in staff pick listings
 	Categories         []CategoryResponse `json:"categories"`
 	Tags               []TagResponse      `json:"tags"`
 	CreatedAt          time.Time          `json:"created_at"`
 }
 
+// StaffPickRequest represents a request to feature an article as a staff pick
+type StaffPickRequest struct {
+	Note     string     `json:"note" binding:"omitempty,max=500"`
+	Position *int       `json:"position" binding:"omitempty,min=0"` // Lower positions are shown first
+	StartsAt *time.Time `json:"starts_at"`                          // Featured from now when empty
+	EndsAt   *time.Time `json:"ends_at"`                            // Featured indefinitely when empty
+}
+
+// StaffPickResponse represents an article's staff pick details
+type StaffPickResponse struct {
+	ArticleID   string     `json:"article_id"`
+	ArticleSlug string     `json:"article_slug"`
+	CuratorID   *string    `json:"curator_id"`
+	Note        string     `json:"note"`
+	Position    *int       `json:"position"`
+	StartsAt    *time.Time `json:"starts_at"`
+	EndsAt      *time.Time `json:"ends_at"`
+	IsActive    bool       `json:"is_active"` // Whether the pick is shown in staff picks right now
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
 // ArticleListResponse is an alias for ArticleListItemResponse (used in swagger docs)
 type ArticleListResponse = ArticleListItemResponse
+
+// EditLockResponse represents who is currently editing an article
+type EditLockResponse struct {
+	ArticleID string             `json:"article_id"`
+	User      PublicUserResponse `json:"user"`
+	IsMine    bool               `json:"is_mine"`
+	ExpiresAt time.Time          `json:"expires_at"`
+}
+
+// ArticleRevisionResponse represents pending edits to a published article
+type ArticleRevisionResponse struct {
+	ID           string               `json:"id"`
+	ArticleID    string               `json:"article_id"`
+	ArticleTitle string               `json:"article_title,omitempty"`
+	ArticleSlug  string               `json:"article_slug,omitempty"`
+	Editor       PublicUserResponse   `json:"editor"`
+	Changes      UpdateArticleRequest `json:"changes"`
+	BaseVersion  int                  `json:"base_version"`
+	Status       string               `json:"status"`
+	ReviewedAt   *time.Time           `json:"reviewed_at,omitempty"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+// CreatePreviewRequest represents a request for a draft preview link
+type CreatePreviewRequest struct {
+	ExpiresInHours int `json:"expires_in_hours" binding:"omitempty,min=1,max=720"` // Defaults to 72
+}
+
+// PreviewLinkResponse represents a signed preview link to an unpublished article
+type PreviewLinkResponse struct {
+	ID        string     `json:"id"`
+	Token     string     `json:"token"`
+	URL       string     `json:"url"`
+	IsActive  bool       `json:"is_active"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Bulk article actions
+const (
+	BulkActionPublish       = "publish"
+	BulkActionUnpublish     = "unpublish"
+	BulkActionDelete        = "delete"
+	BulkActionAddTags       = "add_tags"
+	BulkActionRemoveTags    = "remove_tags"
+	BulkActionSetTags       = "set_tags"
+	BulkActionSetCategories = "set_categories"
+	BulkActionStaffPick     = "staff_pick"
+	BulkActionUnstaffPick   = "unstaff_pick"
+)
+
+// Bulk item results
+const (
+	BulkResultOK      = "ok"
+	BulkResultSkipped = "skipped"
+	BulkResultError   = "error"
+)
+
+// BulkArticleRequest represents one action applied to many articles, chosen
+// either by ID or by a list filter
+type BulkArticleRequest struct {
+	Action      string            `json:"action" binding:"required,oneof=publish unpublish delete add_tags remove_tags set_tags set_categories staff_pick unstaff_pick"`
+	IDs         []string          `json:"ids" binding:"omitempty,max=1000,dive,uuid"`
+	Filter      *ArticleListQuery `json:"filter" binding:"omitempty"`
+	TagIDs      []string          `json:"tag_ids" binding:"omitempty,dive,uuid"`      // For the tag actions
+	CategoryIDs []string          `json:"category_ids" binding:"omitempty,dive,uuid"` // For set_categories
+}
+
+// BulkArticleReport represents the outcome of a bulk article action
+type BulkArticleReport struct {
+	Action    string                  `json:"action"`
+	Total     int                     `json:"total"`
+	Succeeded int                     `json:"succeeded"`
+	Skipped   int                     `json:"skipped"`
+	Failed    int                     `json:"failed"`
+	Results   []BulkArticleItemResult `json:"results"`
+}
+
+// BulkArticleItemResult represents the outcome of a bulk action on one article
+type BulkArticleItemResult struct {
+	ID      string `json:"id"`
+	Slug    string `json:"slug,omitempty"`
+	Status  string `json:"status"` // ok, skipped or error
+	Message string `json:"message,omitempty"`
+}
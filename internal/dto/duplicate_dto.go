@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+// DuplicateQuery represents query parameters for the duplicate content report
+type DuplicateQuery struct {
+	PaginationQuery
+	Status string `form:"status" binding:"omitempty,oneof=pending dismissed confirmed"`
+}
+
+// ReviewDuplicateRequest represents an editor's decision on a flagged article
+type ReviewDuplicateRequest struct {
+	Status string `json:"status" binding:"required,oneof=dismissed confirmed"`
+}
+
+// DuplicateArticleResponse represents one of the two articles of a duplicate flag
+type DuplicateArticleResponse struct {
+	ID        string             `json:"id"`
+	Slug      string             `json:"slug"`
+	Title     string             `json:"title"`
+	Status    string             `json:"status"`
+	Author    PublicUserResponse `json:"author"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+// DuplicateFlagResponse represents a flagged pair of articles in the editors' report
+type DuplicateFlagResponse struct {
+	ID                string                   `json:"id"`
+	Article           DuplicateArticleResponse `json:"article"`            // The newer article, flagged as the copy
+	MatchedArticle    DuplicateArticleResponse `json:"matched_article"`    // The earlier article it repeats
+	SimilarityPercent float64                  `json:"similarity_percent"` // Estimated similarity of the two texts as a whole
+	OverlapPercent    float64                  `json:"overlap_percent"`    // Share of the article's text found in the matched article
+	Passages          []string                 `json:"passages"`           // Shared passages, longest first
+	Status            string                   `json:"status"`
+	ReviewedBy        *PublicUserResponse      `json:"reviewed_by,omitempty"`
+	ReviewedAt        *time.Time               `json:"reviewed_at,omitempty"`
+	CreatedAt         time.Time                `json:"created_at"`
+	UpdatedAt         time.Time                `json:"updated_at"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+import "time"
+
+// CreateHighlightRequest represents the request body for highlighting a passage of an article.
+// Prefix and suffix are the text just before and after the quote, used to find it again after edits.
+type CreateHighlightRequest struct {
+	Quote    string `json:"quote" binding:"required,min=1,max=2000"`
+	Prefix   string `json:"prefix" binding:"omitempty,max=100"`
+	Suffix   string `json:"suffix" binding:"omitempty,max=100"`
+	Note     string `json:"note" binding:"omitempty,max=2000"`
+	IsPublic bool   `json:"is_public"`
+}
+
+// HighlightQuery represents query parameters for listing the current user's highlights
+type HighlightQuery struct {
+	PaginationQuery
+	ArticleSlug string `form:"article" binding:"omitempty"`
+}
+
+// HighlightArticleResponse represents a minimal article in highlight responses
+type HighlightArticleResponse struct {
+	ID    string `json:"id"`
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+}
+
+// HighlightResponse represents a highlight in API responses
+type HighlightResponse struct {
+	ID        string                    `json:"id"`
+	ArticleID string                    `json:"article_id"`
+	Article   *HighlightArticleResponse `json:"article,omitempty"`
+	Quote     string                    `json:"quote"`
+	Prefix    string                    `json:"prefix"`
+	Suffix    string                    `json:"suffix"`
+	Note      string                    `json:"note"`
+	IsPublic  bool                      `json:"is_public"`
+	CreatedAt time.Time                 `json:"created_at"`
+}
+
+// PopularHighlightResponse represents a passage highlighted publicly by several readers
+type PopularHighlightResponse struct {
+	Quote   string `json:"quote"`
+	Prefix  string `json:"prefix"`
+	Suffix  string `json:"suffix"`
+	Readers int64  `json:"readers"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+// Import actions reported for each post
+const (
+	ImportActionCreate = "create"
+	ImportActionSkip   = "skip"
+	ImportActionError  = "error"
+)
+
+// ImportReport summarizes an import run. In a dry run it describes what
+// would be imported without writing anything.
+type ImportReport struct {
+	DryRun        bool                  `json:"dry_run"`
+	Source        string                `json:"source"`
+	Created       int                   `json:"created"`
+	Skipped       int                   `json:"skipped"`
+	Failed        int                   `json:"failed"`
+	Articles      []ImportItemResult    `json:"articles"`
+	Authors       []ImportAuthorMapping `json:"authors"`
+	NewCategories []string              `json:"new_categories"`
+	NewTags       []string              `json:"new_tags"`
+	MediaImported int                   `json:"media_imported"`
+	Warnings      []string              `json:"warnings"`
+}
+
+// ImportItemResult describes what happened to a single post
+type ImportItemResult struct {
+	SourceID  string `json:"source_id"`
+	Title     string `json:"title"`
+	Slug      string `json:"slug"`
+	Status    string `json:"status"`
+	Action    string `json:"action"`
+	ArticleID string `json:"article_id,omitempty"`
+	Media     int    `json:"media"`
+	Message   string `json:"message,omitempty"`
+}
+
+// ImportAuthorMapping shows which user an author from the export was mapped to
+type ImportAuthorMapping struct {
+	Source   string `json:"source"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Matched  bool   `json:"matched"`
+}
@@ -5,6 +5,7 @@ type PaginationQuery struct {
 	Page    int    `form:"page" binding:"omitempty,min=1"`
 	PerPage int    `form:"per_page" binding:"omitempty,min=1,max=100"`
 	Sort    string `form:"sort" binding:"omitempty,oneof=newest oldest popular alphabetical"`
+	Cursor  string `form:"cursor" binding:"omitempty,max=512"` // next_cursor of the previous page; takes precedence over page
 }
 
 // GetPage returns the page number with a default value
@@ -38,3 +39,8 @@ func (p *PaginationQuery) GetSort() string {
 	}
 	return p.Sort
 }
+
+// IsKeyset reports whether the query continues from a cursor rather than a page number
+func (p *PaginationQuery) IsKeyset() bool {
+	return p.Cursor != ""
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// ReadingProgressRequest represents a progress report sent while a user reads an article.
+// TimeSpentSeconds is the reading time since the client's previous report.
+type ReadingProgressRequest struct {
+	Progress         int `json:"progress" binding:"min=0,max=100"`
+	TimeSpentSeconds int `json:"time_spent_seconds" binding:"min=0,max=3600"`
+}
+
+// ReadingHistoryQuery represents query parameters for the current user's reading history
+type ReadingHistoryQuery struct {
+	PaginationQuery
+	Status string `form:"status" binding:"omitempty,oneof=reading read"` // reading: not finished yet (continue reading)
+}
+
+// ReadingProgressResponse represents a reading history entry in API responses
+type ReadingProgressResponse struct {
+	ID               string                   `json:"id"`
+	Article          *ArticleListItemResponse `json:"article,omitempty"`
+	Progress         int                      `json:"progress"`     // Scroll position to resume from, 0-100
+	MaxProgress      int                      `json:"max_progress"` // Furthest position reached
+	TimeSpentSeconds int                      `json:"time_spent_seconds"`
+	IsRead           bool                     `json:"is_read"`
+	CompletedAt      *time.Time               `json:"completed_at,omitempty"`
+	LastReadAt       time.Time                `json:"last_read_at"`
+}
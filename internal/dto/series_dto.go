@@ -0,0 +1,68 @@
+package dto
+
+import "time"
+
+// CreateSeriesRequest represents a series creation request
+type CreateSeriesRequest struct {
+	Title         string   `json:"title" binding:"required,min=3,max=255"`
+	Description   string   `json:"description" binding:"omitempty,max=2000"`
+	CoverImageURL *string  `json:"cover_image_url" binding:"omitempty,url"`
+	ArticleIDs    []string `json:"article_ids" binding:"omitempty,dive,uuid"`
+}
+
+// UpdateSeriesRequest represents a series update request
+// ArticleIDs replaces the ordered list of parts when provided
+type UpdateSeriesRequest struct {
+	Title         *string  `json:"title" binding:"omitempty,min=3,max=255"`
+	Description   *string  `json:"description" binding:"omitempty,max=2000"`
+	CoverImageURL *string  `json:"cover_image_url" binding:"omitempty,url"`
+	ArticleIDs    []string `json:"article_ids" binding:"omitempty,dive,uuid"`
+}
+
+// SeriesListQuery represents query parameters for listing series
+type SeriesListQuery struct {
+	PaginationQuery
+	AuthorID string `form:"author_id" binding:"omitempty,uuid"`
+}
+
+// SeriesPartResponse represents an article's place within a series
+type SeriesPartResponse struct {
+	Position           int        `json:"position"`
+	ID                 string     `json:"id"`
+	Title              string     `json:"title"`
+	Slug               string     `json:"slug"`
+	Excerpt            string     `json:"excerpt"`
+	Status             string     `json:"status"`
+	PublishedAt        *time.Time `json:"published_at"`
+	ReadingTimeMinutes int        `json:"reading_time_minutes"`
+}
+
+// SeriesResponse represents a series in list responses
+type SeriesResponse struct {
+	ID            string             `json:"id"`
+	Title         string             `json:"title"`
+	Slug          string             `json:"slug"`
+	Description   string             `json:"description"`
+	CoverImageURL *string            `json:"cover_image_url"`
+	Author        PublicUserResponse `json:"author"`
+	PartsCount    int                `json:"parts_count"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+// SeriesDetailResponse represents a series with its ordered parts
+type SeriesDetailResponse struct {
+	SeriesResponse
+	Parts []SeriesPartResponse `json:"parts"`
+}
+
+// ArticleSeriesResponse describes where an article sits within its series
+type ArticleSeriesResponse struct {
+	ID         string              `json:"id"`
+	Title      string              `json:"title"`
+	Slug       string              `json:"slug"`
+	Position   int                 `json:"position"`
+	TotalParts int                 `json:"total_parts"`
+	Previous   *SeriesPartResponse `json:"previous,omitempty"`
+	Next       *SeriesPartResponse `json:"next,omitempty"`
+}
@@ -0,0 +1,74 @@
+package dto
+
+// StatsQuery represents query parameters for author and article statistics
+type StatsQuery struct {
+	Range       string `form:"range" binding:"omitempty,oneof=7d 30d 90d 1y"`        // Defaults to 30d, ending today
+	Granularity string `form:"granularity" binding:"omitempty,oneof=day week month"` // Defaults to day
+}
+
+// StatsTotals represents activity totals in API responses.
+// ReadRatio is the percentage of views that ended in a read.
+type StatsTotals struct {
+	Views          int     `json:"views"`
+	UniqueVisitors int     `json:"unique_visitors"`
+	Reads          int     `json:"reads"`
+	ReadRatio      float64 `json:"read_ratio"`
+	Likes          int     `json:"likes"`
+	Comments       int     `json:"comments"`
+	Bookmarks      int     `json:"bookmarks"`
+}
+
+// StatsPoint represents the totals for one day, week or month, starting on Date (YYYY-MM-DD, UTC)
+type StatsPoint struct {
+	Date string `json:"date"`
+	StatsTotals
+}
+
+// TopArticleResponse represents one of an author's most viewed articles in API responses
+type TopArticleResponse struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+	StatsTotals
+}
+
+// ReferrerResponse represents a site that sent readers, with the views it sent
+type ReferrerResponse struct {
+	Source string `json:"source"`
+	Views  int    `json:"views"`
+}
+
+// FollowerGrowthPoint represents the followers gained in a period and the total at its end
+type FollowerGrowthPoint struct {
+	Date         string `json:"date"`
+	NewFollowers int    `json:"new_followers"`
+	Followers    int64  `json:"followers"`
+}
+
+// AuthorStatsResponse represents an author's statistics across their articles
+type AuthorStatsResponse struct {
+	Range          string                `json:"range"`
+	Granularity    string                `json:"granularity"`
+	From           string                `json:"from"`
+	To             string                `json:"to"`
+	Totals         StatsTotals           `json:"totals"`
+	NewFollowers   int                   `json:"new_followers"`
+	Series         []StatsPoint          `json:"series"`
+	FollowerGrowth []FollowerGrowthPoint `json:"follower_growth"`
+	TopArticles    []TopArticleResponse  `json:"top_articles"`
+	TopReferrers   []ReferrerResponse    `json:"top_referrers"`
+}
+
+// ArticleStatsResponse represents one article's statistics
+type ArticleStatsResponse struct {
+	ArticleID    string             `json:"article_id"`
+	Title        string             `json:"title"`
+	Slug         string             `json:"slug"`
+	Range        string             `json:"range"`
+	Granularity  string             `json:"granularity"`
+	From         string             `json:"from"`
+	To           string             `json:"to"`
+	Totals       StatsTotals        `json:"totals"`
+	Series       []StatsPoint       `json:"series"`
+	TopReferrers []ReferrerResponse `json:"top_referrers"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+// TrashItemResponse represents a soft-deleted article, comment or user
+type TrashItemResponse struct {
+	ID        string              `json:"id"`
+	Type      string              `json:"type"`
+	Title     string              `json:"title"`                // Article title, comment excerpt or username
+	Slug      string              `json:"slug,omitempty"`       // Articles only
+	ArticleID string              `json:"article_id,omitempty"` // Comments only
+	Owner     *PublicUserResponse `json:"owner,omitempty"`      // Article author or comment writer
+	DeletedAt time.Time           `json:"deleted_at"`
+	PurgeAt   *time.Time          `json:"purge_at,omitempty"` // When the retention job will purge the item
+}
+
+// TrashRestoreResponse represents an item restored from the trash
+type TrashRestoreResponse struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Slug        string `json:"slug,omitempty"`         // Articles only
+	SlugChanged bool   `json:"slug_changed,omitempty"` // The old slug was taken, so the article got a new one
+}
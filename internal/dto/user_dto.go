@@ -93,6 +93,7 @@ type UserProfileResponse struct {
 
 // FollowListResponse represents a paginated list of followers/following
 type FollowListResponse struct {
-	Users []PublicUserResponse `json:"users"`
-	Total int64                `json:"total"`
+	Users      []PublicUserResponse `json:"users"`
+	Total      int64                `json:"total"` // Zero on pages fetched by cursor
+	NextCursor string               `json:"next_cursor,omitempty"`
 }
@@ -0,0 +1,235 @@
+// Package exporter writes articles to a zip archive of Markdown files with YAML
+// frontmatter, together with the uploaded media they reference. The archive is
+// written as a stream and can be imported again with the importer package.
+package exporter
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/importer"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"go.yaml.in/yaml/v3"
+)
+
+// Archive layout
+const (
+	articlesDir = "articles"
+	mediaDir    = "media"
+)
+
+// uploadsRoute is the public route uploaded files are served from
+const uploadsRoute = "/uploads/"
+
+// frontmatter is the YAML header written at the top of each article. Field
+// names match the ones the importer reads.
+type frontmatter struct {
+	ID              string   `yaml:"id"`
+	Title           string   `yaml:"title"`
+	Slug            string   `yaml:"slug"`
+	Status          string   `yaml:"status"`
+	Date            string   `yaml:"date,omitempty"`
+	Created         string   `yaml:"created"`
+	Updated         string   `yaml:"updated"`
+	Format          string   `yaml:"format"`
+	Author          string   `yaml:"author,omitempty"`
+	Lang            string   `yaml:"lang,omitempty"`
+	Categories      []string `yaml:"categories,omitempty"`
+	Tags            []string `yaml:"tags,omitempty"`
+	Excerpt         string   `yaml:"excerpt,omitempty"`
+	Image           string   `yaml:"image,omitempty"`
+	MetaTitle       string   `yaml:"meta_title,omitempty"`
+	MetaDescription string   `yaml:"meta_description,omitempty"`
+	MetaKeywords    string   `yaml:"meta_keywords,omitempty"`
+}
+
+// Writer writes articles to a zip archive. Articles go to articles/<slug>.md
+// and the uploaded files they link to go to media/, with the links rewritten
+// to point there.
+type Writer struct {
+	zip          *zip.Writer
+	uploadPath   string
+	uploadPrefix string
+	articles     map[string]bool
+	media        map[string]string // Upload path -> archive path, "" if the file is missing
+	ArticleCount int
+	MediaCount   int
+}
+
+// NewWriter creates a writer that streams the archive to w. uploadPath is the
+// directory uploaded media are stored in.
+func NewWriter(w io.Writer, uploadPath string) *Writer {
+	return &Writer{
+		zip:          zip.NewWriter(w),
+		uploadPath:   uploadPath,
+		uploadPrefix: "/" + strings.Trim(path.Clean(filepath.ToSlash(uploadPath)), "/") + "/",
+		articles:     make(map[string]bool),
+		media:        make(map[string]string),
+	}
+}
+
+// WriteArticle adds an article and the media it references to the archive.
+// Categories, tags and the author must be preloaded.
+func (w *Writer) WriteArticle(article *models.Article) error {
+	links := importer.Links(article.Content)
+	if article.FeaturedImageURL != nil && *article.FeaturedImageURL != "" {
+		links = append(links, *article.FeaturedImageURL)
+	}
+
+	// Media are written before the article so that each zip entry is written in one go
+	replacements := make(map[string]string)
+	for _, link := range links {
+		if _, done := replacements[link]; done {
+			continue
+		}
+		name, ok, err := w.addMedia(link)
+		if err != nil {
+			return err
+		}
+		if ok {
+			replacements[link] = "../" + name
+		}
+	}
+
+	meta := frontmatter{
+		ID:              article.ID.String(),
+		Title:           article.Title,
+		Slug:            article.Slug,
+		Status:          string(article.Status),
+		Created:         formatTime(article.CreatedAt),
+		Updated:         formatTime(article.UpdatedAt),
+		Format:          string(article.ContentFormat),
+		Lang:            article.Locale,
+		Excerpt:         article.Excerpt,
+		MetaTitle:       article.MetaTitle,
+		MetaDescription: article.MetaDescription,
+		MetaKeywords:    article.MetaKeywords,
+	}
+	if article.PublishedAt != nil {
+		meta.Date = formatTime(*article.PublishedAt)
+	}
+	if article.Author != nil {
+		meta.Author = article.Author.Username
+	}
+	for _, category := range article.Categories {
+		meta.Categories = append(meta.Categories, category.Name)
+	}
+	for _, tag := range article.Tags {
+		meta.Tags = append(meta.Tags, tag.Name)
+	}
+	if article.FeaturedImageURL != nil {
+		meta.Image = *article.FeaturedImageURL
+		if replacement, ok := replacements[meta.Image]; ok {
+			meta.Image = replacement
+		}
+	}
+
+	header, err := yaml.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to encode frontmatter of %s: %w", article.Slug, err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(header)
+	buf.WriteString("---\n\n")
+	buf.WriteString(strings.TrimSpace(importer.RewriteLinks(article.Content, replacements)))
+	buf.WriteString("\n")
+
+	file, err := w.zip.Create(w.articleName(article.Slug))
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	w.ArticleCount++
+	return nil
+}
+
+// Close finishes the archive. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	return w.zip.Close()
+}
+
+// addMedia copies an uploaded file a link points to into the archive, once.
+// Links to anything other than an existing upload are left alone.
+func (w *Writer) addMedia(link string) (string, bool, error) {
+	parsed, err := url.Parse(strings.TrimSpace(link))
+	if err != nil || (parsed.Scheme != "" && parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return "", false, nil
+	}
+
+	// path.Clean resolves ".." segments, so the name cannot escape the upload directory
+	linkPath := path.Clean(parsed.Path)
+	var name string
+	switch {
+	case strings.HasPrefix(linkPath, uploadsRoute):
+		name = strings.TrimPrefix(linkPath, uploadsRoute)
+	case strings.HasPrefix(linkPath, w.uploadPrefix):
+		name = strings.TrimPrefix(linkPath, w.uploadPrefix)
+	default:
+		return "", false, nil
+	}
+
+	if archived, ok := w.media[name]; ok {
+		return archived, archived != "", nil
+	}
+
+	src, err := os.Open(filepath.Join(w.uploadPath, filepath.FromSlash(name)))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			w.media[name] = ""
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	defer src.Close()
+
+	if info, err := src.Stat(); err != nil || info.IsDir() {
+		w.media[name] = ""
+		return "", false, nil
+	}
+
+	archived := path.Join(mediaDir, name)
+	dst, err := w.zip.Create(archived)
+	if err != nil {
+		return "", false, err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		return "", false, fmt.Errorf("failed to copy %s: %w", name, err)
+	}
+
+	w.media[name] = archived
+	w.MediaCount++
+	return archived, true, nil
+}
+
+// articleName returns a unique archive path for an article
+func (w *Writer) articleName(slug string) string {
+	if slug == "" {
+		slug = "article"
+	}
+	name := path.Join(articlesDir, slug+".md")
+	for i := 1; w.articles[name]; i++ {
+		name = path.Join(articlesDir, fmt.Sprintf("%s-%d.md", slug, i))
+	}
+	w.articles[name] = true
+	return name
+}
+
+// formatTime formats a time for frontmatter
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
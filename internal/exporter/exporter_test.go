@@ -0,0 +1,151 @@
+package exporter
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/importer"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportedArticle() *models.Article {
+	publishedAt := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	featured := "/uploads/cover.png"
+	return &models.Article{
+		ID:               uuid.New(),
+		Title:            "Hello: World",
+		Slug:             "hello-world",
+		Content:          "Intro\n\n![photo](/uploads/photo.png)\n\n![remote](https://example.com/a.png) ![gone](/uploads/missing.png)",
+		ContentFormat:    models.ContentFormatMarkdown,
+		Excerpt:          "Intro",
+		FeaturedImageURL: &featured,
+		Status:           models.StatusPublished,
+		PublishedAt:      &publishedAt,
+		CreatedAt:        publishedAt,
+		UpdatedAt:        publishedAt,
+		Locale:           "ur",
+		MetaTitle:        "Hello",
+		MetaDescription:  "A greeting",
+		Author:           &models.User{Username: "jane"},
+		Categories:       []models.Category{{Name: "News"}},
+		Tags:             []models.Tag{{Name: "Go"}, {Name: "Web"}},
+	}
+}
+
+func readZip(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[file.Name] = string(content)
+	}
+	return files
+}
+
+func TestWriter_WriteArticle(t *testing.T) {
+	uploadPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(uploadPath, "photo.png"), []byte("photo"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(uploadPath, "cover.png"), []byte("cover"), 0644))
+
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, uploadPath)
+	require.NoError(t, writer.WriteArticle(exportedArticle()))
+	require.NoError(t, writer.Close())
+
+	assert.Equal(t, 1, writer.ArticleCount)
+	assert.Equal(t, 2, writer.MediaCount)
+
+	files := readZip(t, buf.Bytes())
+	assert.Len(t, files, 3)
+	assert.Equal(t, "photo", files["media/photo.png"])
+	assert.Equal(t, "cover", files["media/cover.png"])
+
+	content := files["articles/hello-world.md"]
+	assert.Contains(t, content, "title: 'Hello: World'")
+	assert.Contains(t, content, "![photo](../media/photo.png)")
+	assert.Contains(t, content, "![remote](https://example.com/a.png)")
+	assert.Contains(t, content, "![gone](/uploads/missing.png)")
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	uploadPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(uploadPath, "photo.png"), []byte("photo"), 0644))
+
+	article := exportedArticle()
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, uploadPath)
+	require.NoError(t, writer.WriteArticle(article))
+	require.NoError(t, writer.Close())
+
+	archive, err := importer.Parse("export.zip", bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, archive.Posts, 1)
+
+	post := archive.Posts[0]
+	assert.Equal(t, article.Title, post.Title)
+	assert.Equal(t, article.Slug, post.Slug)
+	assert.Equal(t, importer.StatusPublished, post.Status)
+	assert.Equal(t, "markdown", post.Format)
+	assert.Equal(t, "jane", post.Author.Login)
+	assert.Equal(t, "ur", post.Locale)
+	assert.Equal(t, []string{"News"}, post.Categories)
+	assert.Equal(t, []string{"Go", "Web"}, post.Tags)
+	assert.Equal(t, "A greeting", post.MetaDescription)
+	require.NotNil(t, post.PublishedAt)
+	assert.True(t, article.PublishedAt.Equal(*post.PublishedAt))
+
+	refs := archive.LocalFiles(&post)
+	assert.Equal(t, "media/photo.png", refs["../media/photo.png"])
+}
+
+func TestWriter_DuplicateSlugsAndSharedMedia(t *testing.T) {
+	uploadPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(uploadPath, "photo.png"), []byte("photo"), 0644))
+
+	first := &models.Article{ID: uuid.New(), Title: "One", Slug: "same", Content: `<img src="/uploads/photo.png">`, ContentFormat: models.ContentFormatHTML}
+	second := &models.Article{ID: uuid.New(), Title: "Two", Slug: "same", Content: `<img src="/uploads/photo.png">`, ContentFormat: models.ContentFormatHTML}
+
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, uploadPath)
+	require.NoError(t, writer.WriteArticle(first))
+	require.NoError(t, writer.WriteArticle(second))
+	require.NoError(t, writer.Close())
+
+	files := readZip(t, buf.Bytes())
+	assert.Contains(t, files, "articles/same.md")
+	assert.Contains(t, files, "articles/same-1.md")
+	assert.Contains(t, files["articles/same-1.md"], "format: html")
+	assert.Equal(t, 1, writer.MediaCount)
+}
+
+func TestWriter_IgnoresPathTraversal(t *testing.T) {
+	uploadPath := filepath.Join(t.TempDir(), "uploads")
+	require.NoError(t, os.MkdirAll(uploadPath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(uploadPath), "secret.txt"), []byte("secret"), 0644))
+
+	article := &models.Article{ID: uuid.New(), Title: "Sneaky", Slug: "sneaky", Content: "[x](/uploads/../secret.txt)"}
+
+	var buf bytes.Buffer
+	writer := NewWriter(&buf, uploadPath)
+	require.NoError(t, writer.WriteArticle(article))
+	require.NoError(t, writer.Close())
+
+	assert.Equal(t, 0, writer.MediaCount)
+	assert.Len(t, readZip(t, buf.Bytes()), 1)
+}
@@ -0,0 +1,190 @@
+// Package fingerprint finds near-duplicate texts. A text is cut into
+// overlapping word shingles, and a MinHash signature of the shingles estimates
+// how similar two texts are. Signatures are split into bands for
+// locality-sensitive hashing, so likely matches can be found with an indexed
+// lookup instead of comparing every pair of texts.
+package fingerprint
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	ShingleSize = 5   // Words per shingle
+	NumHashes   = 128 // Length of a signature
+	Bands       = 32  // LSH bands; each covers NumHashes/Bands hashes
+	MinWords    = 50  // Shorter texts are too short to judge and aren't fingerprinted
+	MaxPassages = 10  // Compare reports at most this many passages
+)
+
+// Signature is the MinHash signature of a text
+type Signature []uint64
+
+// seeds are the per-hash-function salts, derived once from a fixed sequence
+var seeds = func() []uint64 {
+	seeds := make([]uint64, NumHashes)
+	state := uint64(0x5eed)
+	for i := range seeds {
+		state += 0x9e3779b97f4a7c15
+		seeds[i] = mix(state)
+	}
+	return seeds
+}()
+
+// mix is the splitmix64 finalizer, a fast well-distributed 64-bit hash
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// Words splits a text into words, dropping punctuation
+func Words(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// shingleHashes returns the hash of the shingle starting at each word.
+// Words are compared case-insensitively.
+func shingleHashes(words []string) []uint64 {
+	if len(words) < ShingleSize {
+		return nil
+	}
+	hashes := make([]uint64, len(words)-ShingleSize+1)
+	for i := range hashes {
+		h := fnv.New64a()
+		for _, word := range words[i : i+ShingleSize] {
+			h.Write([]byte(strings.ToLower(word)))
+			h.Write([]byte{' '})
+		}
+		hashes[i] = h.Sum64()
+	}
+	return hashes
+}
+
+// Sign returns the MinHash signature of a list of words
+func Sign(words []string) Signature {
+	sig := make(Signature, NumHashes)
+	for i := range sig {
+		sig[i] = ^uint64(0)
+	}
+	for _, shingle := range shingleHashes(words) {
+		for i, seed := range seeds {
+			if h := mix(shingle ^ seed); h < sig[i] {
+				sig[i] = h
+			}
+		}
+	}
+	return sig
+}
+
+// Similarity estimates the Jaccard similarity of the texts behind two signatures
+func (s Signature) Similarity(other Signature) float64 {
+	if len(s) != NumHashes || len(other) != NumHashes {
+		return 0
+	}
+	same := 0
+	for i := range s {
+		if s[i] == other[i] {
+			same++
+		}
+	}
+	return float64(same) / NumHashes
+}
+
+// BandHashes returns one hash per LSH band. Texts sharing any band hash are
+// likely to be similar.
+func (s Signature) BandHashes() []uint64 {
+	rows := NumHashes / Bands
+	hashes := make([]uint64, Bands)
+	for band := range hashes {
+		h := uint64(band)
+		for _, value := range s[band*rows : (band+1)*rows] {
+			h = mix(h ^ value)
+		}
+		hashes[band] = h
+	}
+	return hashes
+}
+
+// Bytes encodes the signature for storage
+func (s Signature) Bytes() []byte {
+	buf := make([]byte, 8*len(s))
+	for i, value := range s {
+		binary.BigEndian.PutUint64(buf[8*i:], value)
+	}
+	return buf
+}
+
+// FromBytes decodes a stored signature
+func FromBytes(buf []byte) Signature {
+	sig := make(Signature, len(buf)/8)
+	for i := range sig {
+		sig[i] = binary.BigEndian.Uint64(buf[8*i:])
+	}
+	return sig
+}
+
+// Compare reports how much of text a is found in text b: the share of a's
+// shingles that also occur in b, from 0 to 1, and the passages of a made of
+// those shingles, longest first
+func Compare(a, b []string) (float64, []string) {
+	hashesA := shingleHashes(a)
+	if len(hashesA) == 0 {
+		return 0, nil
+	}
+
+	inB := make(map[uint64]bool)
+	for _, h := range shingleHashes(b) {
+		inB[h] = true
+	}
+
+	distinct := make(map[uint64]bool, len(hashesA))
+	shared := make(map[uint64]bool)
+	for _, h := range hashesA {
+		distinct[h] = true
+		if inB[h] {
+			shared[h] = true
+		}
+	}
+
+	// A passage is a run of shared shingles; overlapping shingles make one passage
+	var passages []string
+	start, end := -1, -1
+	flush := func() {
+		if start >= 0 {
+			passages = append(passages, strings.Join(a[start:end], " "))
+			start = -1
+		}
+	}
+	for i, h := range hashesA {
+		if !inB[h] {
+			if i >= end {
+				flush()
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+		end = i + ShingleSize
+	}
+	flush()
+
+	sort.SliceStable(passages, func(i, j int) bool {
+		return len(passages[i]) > len(passages[j])
+	})
+	if len(passages) > MaxPassages {
+		passages = passages[:MaxPassages]
+	}
+
+	return float64(len(shared)) / float64(len(distinct)), passages
+}
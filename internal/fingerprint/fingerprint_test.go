@@ -0,0 +1,105 @@
+package fingerprint
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// essay returns n distinct words, so every shingle is unique
+func essay(prefix string, n int) []string {
+	words := make([]string, n)
+	for i := range words {
+		words[i] = fmt.Sprintf("%s%d", prefix, i)
+	}
+	return words
+}
+
+func TestWords(t *testing.T) {
+	assert.Equal(t, []string{"Hello", "world", "it", "s", "2025"}, Words("Hello, world — it's 2025!"))
+}
+
+func TestSign_SimilarityTracksOverlap(t *testing.T) {
+	original := essay("w", 400)
+
+	edited := append([]string(nil), original...)
+	for i := 0; i < len(edited); i += 40 {
+		edited[i] = "changed"
+	}
+	unrelated := essay("x", 400)
+
+	sig := Sign(original)
+	assert.Equal(t, 1.0, sig.Similarity(Sign(original)))
+	assert.Greater(t, sig.Similarity(Sign(edited)), 0.6)
+	assert.Less(t, sig.Similarity(Sign(unrelated)), 0.05)
+}
+
+func TestSign_CaseInsensitive(t *testing.T) {
+	words := essay("Word", 60)
+	lower := make([]string, len(words))
+	for i, w := range words {
+		lower[i] = strings.ToLower(w)
+	}
+
+	assert.Equal(t, Sign(words), Sign(lower))
+}
+
+func TestBandHashes_SharedForNearDuplicates(t *testing.T) {
+	original := essay("w", 400)
+	edited := append([]string(nil), original...)
+	edited[200] = "changed"
+
+	a, b := Sign(original).BandHashes(), Sign(edited).BandHashes()
+	unrelated := Sign(essay("x", 400)).BandHashes()
+
+	assert.Len(t, a, Bands)
+	sharedBands := func(x, y []uint64) int {
+		n := 0
+		for i := range x {
+			if x[i] == y[i] {
+				n++
+			}
+		}
+		return n
+	}
+	assert.Greater(t, sharedBands(a, b), Bands/2)
+	assert.Equal(t, 0, sharedBands(a, unrelated))
+}
+
+func TestBytesRoundTrip(t *testing.T) {
+	sig := Sign(essay("w", 60))
+
+	assert.Equal(t, sig, FromBytes(sig.Bytes()))
+}
+
+func TestCompare_OverlapAndPassages(t *testing.T) {
+	copied := essay("c", 30)
+	a := append(append(essay("a", 20), copied...), essay("b", 20)...)
+	b := append(append(essay("z", 10), copied...), "and", "more")
+
+	overlap, passages := Compare(a, b)
+
+	// 26 of a's 66 shingles fall entirely inside the copied words
+	assert.InDelta(t, 26.0/66.0, overlap, 1e-9)
+	assert.Equal(t, []string{strings.Join(copied, " ")}, passages)
+}
+
+func TestCompare_PassagesLongestFirst(t *testing.T) {
+	short := essay("s", 6)
+	long := essay("l", 12)
+	a := append(append(append(short, essay("a", 10)...), long...), essay("b", 10)...)
+	b := append(append(long, "gap", "gap", "gap", "gap", "gap"), short...)
+
+	_, passages := Compare(a, b)
+
+	assert.Equal(t, []string{strings.Join(long, " "), strings.Join(short, " ")}, passages)
+}
+
+func TestCompare_ShortText(t *testing.T) {
+	overlap, passages := Compare([]string{"too", "short"}, essay("w", 100))
+
+	assert.Zero(t, overlap)
+	assert.Nil(t, passages)
+}
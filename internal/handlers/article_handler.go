@@ -6,6 +6,7 @@ import (
 
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
 	"github.com/alfafaa/alfafaa-blog/internal/services"
 	"github.com/alfafaa/alfafaa-blog/internal/utils"
 	"github.com/gin-gonic/gin"
@@ -28,11 +29,12 @@ func NewArticleHandler(articleService services.ArticleService, engagementService
 
 // GetArticles returns a list of articles
 // @Summary List articles
-// @Description Get a paginated list of published articles (editors can see all)
+// @Description Get a paginated list of published articles (editors can see all). Unlisted articles are left out, and followers-only articles are only listed for the author's followers.
 // @Tags articles
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(10)
+// @Param cursor query string false "next_cursor of the previous page; replaces page and skips the total count"
 // @Param category query string false "Filter by category slug"
 // @Param tag query string false "Filter by tag slug"
 // @Param author query string false "Filter by author ID"
@@ -46,27 +48,36 @@ func (h *ArticleHandler) GetArticles(c *gin.Context) {
 		return
 	}
 
-	// Check if user can see unpublished articles
-	includeUnpublished := middlewares.IsEditor(c)
+	// Editors can see unpublished articles; followers see followers-only ones
+	viewer := services.Viewer{
+		UserID:   middlewares.GetUserID(c),
+		IsEditor: middlewares.IsEditor(c),
+	}
 
-	articles, total, err := h.articleService.GetArticles(&query, includeUnpublished)
+	articles, total, next, err := h.articleService.GetArticles(&query, viewer)
 	if err != nil {
 		utils.HandleError(c, err)
 		return
 	}
 
-	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	enrichArticleList(c, h.engagementService, articles)
+	meta := pageMeta(&query.PaginationQuery, total, next)
 	utils.SuccessResponseWithMeta(c, http.StatusOK, "Articles retrieved successfully", articles, meta)
 }
 
 // GetArticle returns a single article by slug
 // @Summary Get article by slug
-// @Description Get a single article by its slug (increments view count for non-authenticated users)
+// @Description Get a single article by its slug (counts a view once per visitor per view window; bots and the author are not counted). Drafts and scheduled articles are only visible to their author and editors, and followers-only articles to the author's followers. Anonymous readers get a truncated HTML preview of members-only articles (is_truncated).
 // @Tags articles
 // @Produce json
 // @Param slug path string true "Article slug"
+// @Param format query string false "Content format: markdown (source) or html (rendered)"
+// @Param lang query string false "Preferred locale; overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred locales; a translation is served if the reader doesn't accept the article's locale"
 // @Success 200 {object} utils.Response{data=dto.ArticleDetailResponse} "Article retrieved successfully"
-// @Failure 400 {object} utils.Response "Invalid slug"
+// @Success 301 {object} utils.Response{data=utils.RedirectDetails} "Slug changed; follow the Location header"
+// @Success 304 "Not modified since the ETag (which starts with the article version) the client has"
+// @Failure 400 {object} utils.Response "Invalid slug or format"
 // @Failure 404 {object} utils.Response "Article not found"
 // @Router /articles/{slug} [get]
 func (h *ArticleHandler) GetArticle(c *gin.Context) {
@@ -76,10 +87,23 @@ func (h *ArticleHandler) GetArticle(c *gin.Context) {
 		return
 	}
 
-	// Increment view count for public access
-	incrementView := !middlewares.IsAuthenticated(c)
+	format := c.Query("format")
+	if format != "" && !models.ContentFormat(format).IsValid() {
+		utils.ErrorResponseJSON(c, http.StatusBadRequest, "INVALID_FORMAT", "Format must be markdown or html", nil)
+		return
+	}
 
-	article, err := h.articleService.GetArticle(slug, incrementView)
+	// Views are deduplicated per visitor, so signed-in readers are counted too
+	viewer := services.Viewer{
+		UserID:    middlewares.GetUserID(c),
+		IsEditor:  middlewares.IsEditor(c),
+		Locales:   utils.ParseLocalePreferences(c.Query("lang"), c.GetHeader("Accept-Language")),
+		ClientIP:  c.ClientIP(),
+		UserAgent: c.GetHeader("User-Agent"),
+		Referrer:  c.GetHeader("Referer"),
+	}
+
+	article, err := h.articleService.GetArticle(slug, viewer, true)
 	if err != nil {
 		utils.HandleError(c, err)
 		return
@@ -98,6 +122,10 @@ func (h *ArticleHandler) GetArticle(c *gin.Context) {
 		}
 	}
 
+	article.ApplyFormat(format)
+
+	c.Header("Content-Language", article.Locale)
+	c.Header("ETag", utils.VersionETag(article.Version))
 	utils.SuccessResponse(c, http.StatusOK, "Article retrieved successfully", article)
 }
 
@@ -129,23 +157,28 @@ func (h *ArticleHandler) CreateArticle(c *gin.Context) {
 		return
 	}
 
+	c.Header("ETag", utils.VersionETag(article.Version))
 	utils.SuccessResponse(c, http.StatusCreated, "Article created successfully", article)
 }
 
 // UpdateArticle updates an article
 // @Summary Update article
-// @Description Update an article (authors can only update their own, editors can update any)
+// @Description Update an article (authors can only update their own, editors can update any). Edits to a published article are saved as a pending revision and returned with 202.
 // @Tags articles
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Article ID (UUID)"
+// @Param If-Match header string false "Version ETag the edit is based on (required unless version is in the body)"
 // @Param request body dto.UpdateArticleRequest true "Article update data"
 // @Success 200 {object} utils.Response{data=dto.ArticleDetailResponse} "Article updated successfully"
+// @Success 202 {object} utils.Response{data=dto.ArticleDetailResponse} "Changes saved as a pending revision"
 // @Failure 400 {object} utils.Response "Validation error"
 // @Failure 401 {object} utils.Response "Unauthorized"
 // @Failure 403 {object} utils.Response "Forbidden - not the author"
 // @Failure 404 {object} utils.Response "Article not found"
+// @Failure 409 {object} utils.VersionConflictResponse "Article was modified by someone else"
+// @Failure 428 {object} utils.Response "Missing If-Match header or version"
 // @Router /articles/{id} [put]
 func (h *ArticleHandler) UpdateArticle(c *gin.Context) {
 	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
@@ -160,6 +193,20 @@ func (h *ArticleHandler) UpdateArticle(c *gin.Context) {
 		return
 	}
 
+	// The edit must name the version it is based on so concurrent saves cannot overwrite each other
+	if ifMatch := c.GetHeader("If-Match"); ifMatch != "" {
+		version, ok := utils.ParseVersionETag(ifMatch)
+		if !ok {
+			utils.ErrorResponseJSON(c, http.StatusBadRequest, "INVALID_IF_MATCH", "If-Match must be a single article version ETag", nil)
+			return
+		}
+		req.Version = &version
+	}
+	if req.Version == nil {
+		utils.ErrorResponseJSON(c, http.StatusPreconditionRequired, "PRECONDITION_REQUIRED", "Send the article version in an If-Match header or the version field", nil)
+		return
+	}
+
 	userID := middlewares.GetUserID(c)
 	isEditor := middlewares.IsEditor(c)
 
@@ -169,6 +216,11 @@ func (h *ArticleHandler) UpdateArticle(c *gin.Context) {
 		return
 	}
 
+	c.Header("ETag", utils.VersionETag(article.Version))
+	if article.PendingRevision != nil {
+		utils.SuccessResponse(c, http.StatusAccepted, "Changes saved for review; the live article is unchanged", article)
+		return
+	}
 	utils.SuccessResponse(c, http.StatusOK, "Article updated successfully", article)
 }
 
@@ -261,28 +313,161 @@ func (h *ArticleHandler) UnpublishArticle(c *gin.Context) {
 	utils.SuccessResponse(c, http.StatusOK, "Article unpublished successfully", article)
 }
 
+// UpdateCommentSettings changes an article's comment settings
+// @Summary Update comment settings
+// @Description Turn comments on or off, lock them, close them a number of days after publication, or limit them to followers or verified users. Changes apply at once, even on published articles (author or editor).
+// @Tags articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Param request body dto.CommentSettingsRequest true "Settings to change"
+// @Success 200 {object} utils.Response{data=dto.CommentSettingsResponse} "Comment settings updated successfully"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{id}/comment-settings [patch]
+func (h *ArticleHandler) UpdateCommentSettings(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	var req dto.CommentSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	settings, err := h.articleService.UpdateCommentSettings(id, &req, middlewares.GetUserID(c), middlewares.IsEditor(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Comment settings updated successfully", settings)
+}
+
+// PickArticle features an article as a staff pick
+// @Summary Set staff pick
+// @Description Feature a published article as a staff pick, or update an existing pick, with an optional curator note, display position and start/end dates (requires editor role)
+// @Tags articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Param request body dto.StaffPickRequest true "Staff pick details"
+// @Success 200 {object} utils.Response{data=dto.StaffPickResponse} "Staff pick saved successfully"
+// @Failure 400 {object} utils.Response "Invalid ID, schedule or unpublished article"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - requires editor role"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{id}/staff-pick [put]
+func (h *ArticleHandler) PickArticle(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+	if id == "" {
+		utils.ErrorResponseJSON(c, http.StatusBadRequest, "INVALID_ID", "Article ID is required", nil)
+		return
+	}
+
+	var req dto.StaffPickRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	pick, err := h.articleService.PickArticle(id, &req, middlewares.GetUserID(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Staff pick saved successfully", pick)
+}
+
+// UnpickArticle removes an article from the staff picks
+// @Summary Remove staff pick
+// @Description Remove an article from the staff picks along with its curation details (requires editor role)
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Success 200 {object} utils.Response "Staff pick removed successfully"
+// @Failure 400 {object} utils.Response "Invalid ID or article is not a staff pick"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - requires editor role"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{id}/staff-pick [delete]
+func (h *ArticleHandler) UnpickArticle(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+	if id == "" {
+		utils.ErrorResponseJSON(c, http.StatusBadRequest, "INVALID_ID", "Article ID is required", nil)
+		return
+	}
+
+	if err := h.articleService.UnpickArticle(id); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Staff pick removed successfully", nil)
+}
+
+// BulkUpdateArticles applies one action to many articles
+// @Summary Bulk article action
+// @Description Publish, unpublish, delete, re-tag, re-categorize or mark as staff picks the articles picked by ID or by a list filter (requires editor role). Articles are changed in chunks of 50 and each article's outcome is reported; bulk publishing does not notify followers.
+// @Tags articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.BulkArticleRequest true "Action and article selection"
+// @Success 200 {object} utils.Response{data=dto.BulkArticleReport} "Bulk action applied"
+// @Failure 400 {object} utils.Response "Validation error or filter matches too many articles"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - requires editor role"
+// @Failure 404 {object} utils.Response "Tag, category or filter target not found"
+// @Router /articles/bulk [post]
+func (h *ArticleHandler) BulkUpdateArticles(c *gin.Context) {
+	var req dto.BulkArticleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	report, err := h.articleService.BulkUpdateArticles(&req)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Bulk action applied", report)
+}
+
 // GetTrendingArticles returns trending articles
 // @Summary Get trending articles
-// @Description Get articles sorted by view count
+// @Description Get the articles with the most recent engagement (views, likes, comments and bookmarks), with older activity counting less
 // @Tags articles
 // @Produce json
 // @Param limit query int false "Number of articles to return" default(10)
+// @Param window query string false "Period the engagement is counted over" Enums(24h, 7d, 30d) default(7d)
+// @Param category query string false "Only articles in this category (slug)"
+// @Param lang query string false "Only articles in this language (BCP 47 tag, e.g. ur or en-GB)"
 // @Success 200 {object} utils.Response{data=[]dto.ArticleListResponse} "Trending articles retrieved successfully"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 404 {object} utils.Response "Category not found"
 // @Router /articles/trending [get]
 func (h *ArticleHandler) GetTrendingArticles(c *gin.Context) {
-	limit := 10
-	if l := c.Query("limit"); l != "" {
-		if parsed, err := strconv.Atoi(l); err == nil {
-			limit = parsed
-		}
+	var query dto.TrendingQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
 	}
 
-	articles, err := h.articleService.GetTrendingArticles(limit)
+	articles, err := h.articleService.GetTrendingArticles(&query)
 	if err != nil {
 		utils.HandleError(c, err)
 		return
 	}
 
+	enrichArticleList(c, h.engagementService, articles)
 	utils.SuccessResponse(c, http.StatusOK, "Trending articles retrieved successfully", articles)
 }
 
@@ -308,17 +493,19 @@ func (h *ArticleHandler) GetRecentArticles(c *gin.Context) {
 		return
 	}
 
+	enrichArticleList(c, h.engagementService, articles)
 	utils.SuccessResponse(c, http.StatusOK, "Recent articles retrieved successfully", articles)
 }
 
 // GetRelatedArticles returns related articles
 // @Summary Get related articles
-// @Description Get articles related to a specific article based on categories and tags
+// @Description Get articles related to a specific article, ranked by shared tags and categories, text similarity, recency and popularity
 // @Tags articles
 // @Produce json
 // @Param slug path string true "Article slug"
 // @Param limit query int false "Number of articles to return" default(5)
 // @Success 200 {object} utils.Response{data=[]dto.ArticleListResponse} "Related articles retrieved successfully"
+// @Success 301 {object} utils.Response{data=utils.RedirectDetails} "Slug changed; follow the Location header"
 // @Failure 400 {object} utils.Response "Invalid slug"
 // @Failure 404 {object} utils.Response "Article not found"
 // @Router /articles/{slug}/related [get]
@@ -342,5 +529,308 @@ func (h *ArticleHandler) GetRelatedArticles(c *gin.Context) {
 		return
 	}
 
+	enrichArticleList(c, h.engagementService, articles)
 	utils.SuccessResponse(c, http.StatusOK, "Related articles retrieved successfully", articles)
 }
+
+// GetEditLock returns who is currently editing an article
+// @Summary Get edit lock
+// @Description Show whether someone is editing the article (advisory; does not block saves)
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Success 200 {object} utils.Response{data=dto.EditLockResponse} "Edit lock retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{id}/lock [get]
+func (h *ArticleHandler) GetEditLock(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	lock, err := h.articleService.GetEditLock(id, middlewares.GetUserID(c), middlewares.IsEditor(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	if lock == nil {
+		utils.SuccessResponse(c, http.StatusOK, "Article is not being edited", nil)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Edit lock retrieved successfully", lock)
+}
+
+// AcquireEditLock takes or refreshes the edit lock on an article
+// @Summary Acquire edit lock
+// @Description Mark the article as being edited by the current user. Locks expire after 5 minutes unless refreshed by calling this endpoint again.
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Success 200 {object} utils.Response{data=dto.EditLockResponse} "Edit lock acquired"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Failure 409 {object} utils.Response "Another user is editing the article"
+// @Router /articles/{id}/lock [post]
+func (h *ArticleHandler) AcquireEditLock(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	lock, err := h.articleService.AcquireEditLock(id, middlewares.GetUserID(c), middlewares.IsEditor(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Edit lock acquired", lock)
+}
+
+// ReleaseEditLock releases the edit lock on an article
+// @Summary Release edit lock
+// @Description Release the current user's edit lock (editors can release any lock)
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Success 200 {object} utils.Response "Edit lock released"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - lock held by another user"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{id}/lock [delete]
+func (h *ArticleHandler) ReleaseEditLock(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	if err := h.articleService.ReleaseEditLock(id, middlewares.GetUserID(c), middlewares.IsEditor(c)); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Edit lock released", nil)
+}
+
+// GetPendingRevisions lists pending revisions awaiting review
+// @Summary List pending revisions
+// @Description List edits to published articles that are waiting for an editor to publish them (requires editor role)
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(20)
+// @Success 200 {object} utils.ResponseWithMeta{data=[]dto.ArticleRevisionResponse} "Pending revisions retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - requires editor role"
+// @Router /articles/revisions [get]
+func (h *ArticleHandler) GetPendingRevisions(c *gin.Context) {
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	revisions, total, err := h.articleService.GetPendingRevisions(&query)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	utils.SuccessResponseWithMeta(c, http.StatusOK, "Pending revisions retrieved successfully", revisions, meta)
+}
+
+// GetPendingRevision returns the pending changes to an article
+// @Summary Get pending revision
+// @Description Get the changes to a published article that are waiting to be published (author or editor)
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Success 200 {object} utils.Response{data=dto.ArticleRevisionResponse} "Pending revision retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Article not found or no pending changes"
+// @Router /articles/{id}/revision [get]
+func (h *ArticleHandler) GetPendingRevision(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	revision, err := h.articleService.GetPendingRevision(id, middlewares.GetUserID(c), middlewares.IsEditor(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Pending revision retrieved successfully", revision)
+}
+
+// PublishRevision publishes the pending changes to an article
+// @Summary Publish changes
+// @Description Apply the pending revision to the live article (requires editor role)
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Success 200 {object} utils.Response{data=dto.ArticleDetailResponse} "Changes published successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - requires editor role"
+// @Failure 404 {object} utils.Response "Article not found or no pending changes"
+// @Failure 409 {object} utils.Response "The article changed after the revision was saved"
+// @Router /articles/{id}/revision/publish [patch]
+func (h *ArticleHandler) PublishRevision(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	article, err := h.articleService.PublishRevision(id, middlewares.GetUserID(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	c.Header("ETag", utils.VersionETag(article.Version))
+	utils.SuccessResponse(c, http.StatusOK, "Changes published successfully", article)
+}
+
+// DiscardRevision discards the pending changes to an article
+// @Summary Discard changes
+// @Description Throw away the pending revision; the live article is unchanged (author or editor)
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Success 200 {object} utils.Response "Changes discarded"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Article not found or no pending changes"
+// @Router /articles/{id}/revision [delete]
+func (h *ArticleHandler) DiscardRevision(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	if err := h.articleService.DiscardRevision(id, middlewares.GetUserID(c), middlewares.IsEditor(c)); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Changes discarded", nil)
+}
+
+// previewPath is the public route that serves preview links
+const previewPath = "/api/v1/preview/"
+
+// CreatePreviewLink creates a signed preview link for an unpublished article
+// @Summary Create preview link
+// @Description Create an expiring link that shows a draft or scheduled article without login
+// @Tags articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Param request body dto.CreatePreviewRequest false "Link expiry"
+// @Success 201 {object} utils.Response{data=dto.PreviewLinkResponse} "Preview link created successfully"
+// @Failure 400 {object} utils.Response "Validation error or article already published"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{id}/previews [post]
+func (h *ArticleHandler) CreatePreviewLink(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	var req dto.CreatePreviewRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+			return
+		}
+	}
+
+	link, err := h.articleService.CreatePreviewLink(id, &req, middlewares.GetUserID(c), middlewares.IsEditor(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	link.URL = previewPath + link.Token
+	utils.SuccessResponse(c, http.StatusCreated, "Preview link created successfully", link)
+}
+
+// GetPreviewLinks lists the preview links of an article
+// @Summary List preview links
+// @Description List the preview links of an article, including expired and revoked ones
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Success 200 {object} utils.Response{data=[]dto.PreviewLinkResponse} "Preview links retrieved successfully"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{id}/previews [get]
+func (h *ArticleHandler) GetPreviewLinks(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	links, err := h.articleService.GetPreviewLinks(id, middlewares.GetUserID(c), middlewares.IsEditor(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	for i := range links {
+		links[i].URL = previewPath + links[i].Token
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Preview links retrieved successfully", links)
+}
+
+// RevokePreviewLink revokes a preview link
+// @Summary Revoke preview link
+// @Description Stop a preview link from working before it expires
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Article ID (UUID)"
+// @Param preview_id path string true "Preview link ID (UUID)"
+// @Success 200 {object} utils.Response "Preview link revoked"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Article or preview link not found"
+// @Router /articles/{id}/previews/{preview_id} [delete]
+func (h *ArticleHandler) RevokePreviewLink(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+
+	if err := h.articleService.RevokePreviewLink(id, c.Param("id"), middlewares.GetUserID(c), middlewares.IsEditor(c)); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Preview link revoked", nil)
+}
+
+// GetPreview shows the article behind a preview link
+// @Summary View article preview
+// @Description Show a draft or scheduled article through a signed preview link. No login is needed and the view is not counted.
+// @Tags articles
+// @Produce json
+// @Param token path string true "Preview token"
+// @Param format query string false "Content format: markdown (source) or html (rendered)"
+// @Success 200 {object} utils.Response{data=dto.ArticleDetailResponse} "Preview retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid format"
+// @Failure 404 {object} utils.Response "Preview link invalid, expired or revoked"
+// @Router /preview/{token} [get]
+func (h *ArticleHandler) GetPreview(c *gin.Context) {
+	format := c.Query("format")
+	if format != "" && !models.ContentFormat(format).IsValid() {
+		utils.ErrorResponseJSON(c, http.StatusBadRequest, "INVALID_FORMAT", "Format must be markdown or html", nil)
+		return
+	}
+
+	// Previews must not be cached by shared caches or indexed by search engines
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("X-Robots-Tag", "noindex, nofollow")
+
+	article, err := h.articleService.GetPreview(c.Param("token"))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	article.ApplyFormat(format)
+	utils.SuccessResponse(c, http.StatusOK, "Preview retrieved successfully", article)
+}
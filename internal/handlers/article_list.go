@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// enrichArticleList adds like and comment counts to a page of articles, and
+// whether the signed-in reader has liked or bookmarked them. It runs after
+// the page is read so that cached pages stay the same for every reader.
+func enrichArticleList(c *gin.Context, engagementService services.EngagementService, articles []dto.ArticleListItemResponse) {
+	if engagementService == nil {
+		return
+	}
+	engagementService.EnrichArticleList(articles, middlewares.GetUserID(c))
+}
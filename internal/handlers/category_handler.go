@@ -11,13 +11,15 @@ import (
 
 // CategoryHandler handles category-related HTTP requests
 type CategoryHandler struct {
-	categoryService services.CategoryService
+	categoryService   services.CategoryService
+	engagementService services.EngagementService
 }
 
 // NewCategoryHandler creates a new category handler
-func NewCategoryHandler(categoryService services.CategoryService) *CategoryHandler {
+func NewCategoryHandler(categoryService services.CategoryService, engagementService services.EngagementService) *CategoryHandler {
 	return &CategoryHandler{
-		categoryService: categoryService,
+		categoryService:   categoryService,
+		engagementService: engagementService,
 	}
 }
 
@@ -63,6 +65,8 @@ func (h *CategoryHandler) GetCategories(c *gin.Context) {
 // @Produce json
 // @Param slug path string true "Category slug"
 // @Success 200 {object} utils.Response{data=dto.CategoryResponse} "Category retrieved successfully"
+// @Success 301 {object} utils.Response{data=utils.RedirectDetails} "Slug changed; follow the Location header"
+// @Success 304 "Not modified since the ETag the client has"
 // @Failure 400 {object} utils.Response "Invalid slug"
 // @Failure 404 {object} utils.Response "Category not found"
 // @Router /categories/{slug} [get]
@@ -186,6 +190,7 @@ func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(10)
 // @Success 200 {object} utils.ResponseWithMeta{data=[]dto.ArticleListResponse} "Articles retrieved successfully"
+// @Success 301 {object} utils.Response{data=utils.RedirectDetails} "Slug changed; follow the Location header"
 // @Failure 400 {object} utils.Response "Invalid slug"
 // @Failure 404 {object} utils.Response "Category not found"
 // @Router /categories/{slug}/articles [get]
@@ -208,6 +213,7 @@ func (h *CategoryHandler) GetCategoryArticles(c *gin.Context) {
 		return
 	}
 
+	enrichArticleList(c, h.engagementService, articles)
 	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
 	utils.SuccessResponseWithMeta(c, http.StatusOK, "Articles retrieved successfully", articles, meta)
 }
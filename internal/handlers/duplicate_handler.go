@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// DuplicateHandler handles the duplicate content report
+type DuplicateHandler struct {
+	duplicateService services.DuplicateService
+}
+
+// NewDuplicateHandler creates a new duplicate handler
+func NewDuplicateHandler(duplicateService services.DuplicateService) *DuplicateHandler {
+	return &DuplicateHandler{
+		duplicateService: duplicateService,
+	}
+}
+
+// GetDuplicateReport handles listing articles flagged as near-duplicates
+// @Summary List duplicate articles
+// @Description List articles whose text largely repeats an earlier article, with the shared passages and percentage overlap (requires editor role)
+// @Tags articles
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Review status" Enums(pending, dismissed, confirmed) default(pending)
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(20)
+// @Success 200 {object} utils.ResponseWithMeta{data=[]dto.DuplicateFlagResponse} "Duplicate report retrieved successfully"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - requires editor role"
+// @Router /articles/duplicates [get]
+func (h *DuplicateHandler) GetDuplicateReport(c *gin.Context) {
+	var query dto.DuplicateQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	flags, total, err := h.duplicateService.GetDuplicateReport(&query)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	utils.SuccessResponseWithMeta(c, http.StatusOK, "Duplicate report retrieved successfully", flags, meta)
+}
+
+// ReviewDuplicate handles an editor's decision on a flagged article
+// @Summary Review a duplicate flag
+// @Description Confirm or dismiss a near-duplicate flag. Dismissed flags are not raised again for the same pair of articles (requires editor role).
+// @Tags articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Duplicate flag ID (UUID)"
+// @Param request body dto.ReviewDuplicateRequest true "Review decision"
+// @Success 200 {object} utils.Response{data=dto.DuplicateFlagResponse} "Duplicate flag reviewed"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - requires editor role"
+// @Failure 404 {object} utils.Response "Duplicate flag not found"
+// @Router /articles/duplicates/{id} [patch]
+func (h *DuplicateHandler) ReviewDuplicate(c *gin.Context) {
+	var req dto.ReviewDuplicateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	flag, err := h.duplicateService.ReviewDuplicate(c.Param("id"), middlewares.GetUserID(c), &req)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Duplicate flag reviewed", flag)
+}
@@ -154,6 +154,7 @@ func (h *EngagementHandler) UnbookmarkArticle(c *gin.Context) {
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(20)
+// @Param cursor query string false "next_cursor of the previous page; replaces page and skips the total count"
 // @Success 200 {object} utils.ResponseWithMeta{data=[]dto.ArticleListItemResponse} "Bookmarked articles retrieved"
 // @Failure 401 {object} utils.Response "Unauthorized"
 // @Router /users/bookmarks [get]
@@ -166,13 +167,14 @@ func (h *EngagementHandler) GetBookmarkedArticles(c *gin.Context) {
 		return
 	}
 
-	articles, total, err := h.engagementService.GetBookmarkedArticles(userID, &query)
+	articles, total, next, err := h.engagementService.GetBookmarkedArticles(userID, &query)
 	if err != nil {
 		utils.HandleError(c, err)
 		return
 	}
 
-	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	h.engagementService.EnrichArticleList(articles, userID)
+	meta := pageMeta(&query, total, next)
 	utils.SuccessResponseWithMeta(c, http.StatusOK, "Bookmarked articles retrieved", articles, meta)
 }
 
@@ -180,12 +182,13 @@ func (h *EngagementHandler) GetBookmarkedArticles(c *gin.Context) {
 
 // GetComments handles getting comments for an article
 // @Summary Get article comments
-// @Description Get comments for an article with nested replies
+// @Description Get comments for an article with nested replies. Drafts and followers-only articles the reader can't open are reported as not found.
 // @Tags engagement
 // @Produce json
 // @Param slug path string true "Article slug"
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(20)
+// @Param cursor query string false "next_cursor of the previous page; replaces page and skips the total count"
 // @Success 200 {object} utils.ResponseWithMeta{data=[]dto.EngagementCommentResponse} "Comments retrieved"
 // @Failure 404 {object} utils.Response "Article not found"
 // @Router /articles/{slug}/comments [get]
@@ -198,13 +201,13 @@ func (h *EngagementHandler) GetComments(c *gin.Context) {
 		return
 	}
 
-	comments, total, err := h.engagementService.GetComments(slug, &query)
+	comments, total, next, err := h.engagementService.GetComments(middlewares.GetUserID(c), slug, &query)
 	if err != nil {
 		utils.HandleError(c, err)
 		return
 	}
 
-	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	meta := pageMeta(&query, total, next)
 	utils.SuccessResponseWithMeta(c, http.StatusOK, "Comments retrieved", comments, meta)
 }
 
@@ -314,6 +317,7 @@ func (h *EngagementHandler) DeleteComment(c *gin.Context) {
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(20)
+// @Param cursor query string false "next_cursor of the previous page; replaces page and skips the total count"
 // @Success 200 {object} utils.ResponseWithMeta{data=[]dto.NotificationResponse} "Notifications retrieved"
 // @Failure 401 {object} utils.Response "Unauthorized"
 // @Router /notifications [get]
@@ -326,13 +330,13 @@ func (h *EngagementHandler) GetNotifications(c *gin.Context) {
 		return
 	}
 
-	notifications, total, err := h.engagementService.GetNotifications(userID, &query)
+	notifications, total, next, err := h.engagementService.GetNotifications(userID, &query)
 	if err != nil {
 		utils.HandleError(c, err)
 		return
 	}
 
-	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	meta := pageMeta(&query, total, next)
 	utils.SuccessResponseWithMeta(c, http.StatusOK, "Notifications retrieved", notifications, meta)
 }
 
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ExportHandler handles article export HTTP requests
+type ExportHandler struct {
+	exportService services.ExportService
+}
+
+// NewExportHandler creates a new export handler
+func NewExportHandler(exportService services.ExportService) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportUserArticles streams an archive of a user's articles
+// @Summary Export a user's articles
+// @Description Download all of a user's articles, including drafts, as a zip of Markdown files with YAML frontmatter and the uploaded media they reference (the user themselves or admin)
+// @Tags users
+// @Produce application/zip
+// @Security BearerAuth
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {file} file "Zip archive"
+// @Failure 400 {object} utils.Response "Invalid ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - can only export own articles"
+// @Failure 404 {object} utils.Response "User not found"
+// @Router /users/{id}/articles/export [get]
+func (h *ExportHandler) ExportUserArticles(c *gin.Context) {
+	export, err := h.exportService.ExportAuthorArticles(c.Param("id"), middlewares.GetUserID(c), middlewares.IsAdmin(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	streamExport(c, export)
+}
+
+// ExportArticles streams an archive of every article on the site
+// @Summary Export all articles
+// @Description Download every article as a zip of Markdown files with YAML frontmatter and the uploaded media they reference (admin only)
+// @Tags articles
+// @Produce application/zip
+// @Security BearerAuth
+// @Success 200 {file} file "Zip archive"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden"
+// @Router /articles/export [get]
+func (h *ExportHandler) ExportArticles(c *gin.Context) {
+	streamExport(c, h.exportService.ExportAllArticles())
+}
+
+// streamExport writes an export as a file download
+func streamExport(c *gin.Context, export *services.ArticleExport) {
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Filename))
+	c.Header("Cache-Control", "private, no-store")
+	c.Status(http.StatusOK)
+
+	if err := export.Write(c.Writer); err != nil {
+		// The response has started, so the client is left with a truncated archive
+		utils.Error("Article export failed", zap.Error(err), zap.String("filename", export.Filename))
+		c.Abort()
+	}
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// HighlightHandler handles highlight-related HTTP requests
+type HighlightHandler struct {
+	highlightService services.HighlightService
+}
+
+// NewHighlightHandler creates a new highlight handler
+func NewHighlightHandler(highlightService services.HighlightService) *HighlightHandler {
+	return &HighlightHandler{
+		highlightService: highlightService,
+	}
+}
+
+// CreateHighlight handles highlighting a passage of an article
+// @Summary Highlight a passage
+// @Description Highlight a passage of a published article, optionally with a note. Public highlights count towards the article's top highlights.
+// @Tags highlights
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Article slug"
+// @Param request body dto.CreateHighlightRequest true "Highlighted passage"
+// @Success 201 {object} utils.Response{data=dto.HighlightResponse} "Highlight created"
+// @Failure 400 {object} utils.Response "Validation error or quote not found in the article"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{slug}/highlights [post]
+func (h *HighlightHandler) CreateHighlight(c *gin.Context) {
+	var req dto.CreateHighlightRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	highlight, err := h.highlightService.CreateHighlight(middlewares.GetUserID(c), c.Param("slug"), &req)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "Highlight created", highlight)
+}
+
+// GetPopularHighlights handles listing an article's top public highlights
+// @Summary Get top highlights
+// @Description Get the passages of an article highlighted publicly by the most readers. Followers-only articles are limited to the author's followers, and members-only articles to signed-in readers.
+// @Tags highlights
+// @Produce json
+// @Param slug path string true "Article slug"
+// @Success 200 {object} utils.Response{data=[]dto.PopularHighlightResponse} "Highlights retrieved"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{slug}/highlights [get]
+func (h *HighlightHandler) GetPopularHighlights(c *gin.Context) {
+	highlights, err := h.highlightService.GetPopularHighlights(middlewares.GetUserID(c), c.Param("slug"))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Highlights retrieved", highlights)
+}
+
+// GetMyHighlights handles listing the current user's highlights
+// @Summary Get my highlights
+// @Description Get the current user's highlights and notes, newest first
+// @Tags highlights
+// @Produce json
+// @Security BearerAuth
+// @Param article query string false "Only highlights on the article with this slug"
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(20)
+// @Success 200 {object} utils.ResponseWithMeta{data=[]dto.HighlightResponse} "Highlights retrieved"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /users/highlights [get]
+func (h *HighlightHandler) GetMyHighlights(c *gin.Context) {
+	var query dto.HighlightQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	highlights, total, err := h.highlightService.GetMyHighlights(middlewares.GetUserID(c), &query)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	utils.SuccessResponseWithMeta(c, http.StatusOK, "Highlights retrieved", highlights, meta)
+}
+
+// DeleteHighlight handles deleting a highlight
+// @Summary Delete a highlight
+// @Description Delete one of the current user's highlights
+// @Tags highlights
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Highlight ID"
+// @Success 200 {object} utils.Response "Highlight deleted"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden"
+// @Failure 404 {object} utils.Response "Highlight not found"
+// @Router /users/highlights/{id} [delete]
+func (h *HighlightHandler) DeleteHighlight(c *gin.Context) {
+	if err := h.highlightService.DeleteHighlight(middlewares.GetUserID(c), c.Param("id")); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Highlight deleted", nil)
+}
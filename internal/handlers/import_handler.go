@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportSize caps the size of an uploaded export
+const maxImportSize = 256 << 20
+
+// ImportHandler handles bulk import HTTP requests
+type ImportHandler struct {
+	importService services.ImportService
+}
+
+// NewImportHandler creates a new import handler
+func NewImportHandler(importService services.ImportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+// Import handles an uploaded blog export
+// @Summary Import articles
+// @Description Import posts from a WordPress WXR export (.xml, or .zip with wp-content/uploads) or a zip archive of Markdown files with YAML frontmatter (admin only). Posts imported before are skipped, so an import can be repeated safely.
+// @Tags imports
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "WXR export or zip archive"
+// @Param dry_run formData boolean false "Report what would be imported without writing anything"
+// @Success 200 {object} utils.Response{data=dto.ImportReport} "Dry run completed"
+// @Success 201 {object} utils.Response{data=dto.ImportReport} "Import completed"
+// @Failure 400 {object} utils.Response "No file provided or invalid export"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden"
+// @Failure 413 {object} utils.Response "File too large"
+// @Router /imports [post]
+func (h *ImportHandler) Import(c *gin.Context) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		utils.ErrorResponseJSON(c, http.StatusBadRequest, "NO_FILE", "No file provided", nil)
+		return
+	}
+
+	if file.Size > maxImportSize {
+		utils.ErrorResponseJSON(c, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE", "Import file is too large", nil)
+		return
+	}
+
+	dryRun, _ := strconv.ParseBool(c.DefaultPostForm("dry_run", c.Query("dry_run")))
+
+	src, err := file.Open()
+	if err != nil {
+		utils.ErrorResponseJSON(c, http.StatusBadRequest, "INVALID_FILE", "Failed to read file", nil)
+		return
+	}
+	defer src.Close()
+
+	userID := middlewares.GetUserID(c)
+
+	// The upload is read in place rather than copied into memory
+	report, err := h.importService.Import(file.Filename, src, file.Size, userID, dryRun)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	if dryRun {
+		utils.SuccessResponse(c, http.StatusOK, "Dry run completed", report)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "Import completed", report)
+}
@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+)
+
+// pageMeta builds the pagination metadata of a list response. Pages fetched
+// by cursor aren't counted, so they only carry the next cursor.
+func pageMeta(query *dto.PaginationQuery, total int64, nextCursor string) *utils.Meta {
+	if query.IsKeyset() {
+		return utils.NewCursorMeta(query.GetPerPage(), nextCursor)
+	}
+	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	meta.NextCursor = nextCursor
+	return meta
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ReadingHandler handles reading progress and history requests
+type ReadingHandler struct {
+	readingService services.ReadingService
+}
+
+// NewReadingHandler creates a new reading handler
+func NewReadingHandler(readingService services.ReadingService) *ReadingHandler {
+	return &ReadingHandler{
+		readingService: readingService,
+	}
+}
+
+// RecordProgress handles a reading progress report
+// @Summary Record reading progress
+// @Description Save the reader's scroll position in an article and add to their time spent on it. An article counts as read once the reader reaches 90% with at least a quarter of its reading time spent.
+// @Tags reading
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Article slug"
+// @Param request body dto.ReadingProgressRequest true "Scroll position and seconds spent since the last report"
+// @Success 200 {object} utils.Response{data=dto.ReadingProgressResponse} "Progress saved"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{slug}/progress [post]
+func (h *ReadingHandler) RecordProgress(c *gin.Context) {
+	var req dto.ReadingProgressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	progress, err := h.readingService.RecordProgress(middlewares.GetUserID(c), c.Param("slug"), &req)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Progress saved", progress)
+}
+
+// GetHistory handles listing the current user's reading history
+// @Summary Get reading history
+// @Description Get the articles the current user has read or started, most recently read first, with the position to resume from
+// @Tags reading
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "reading (not finished, for continue reading) or read" Enums(reading, read)
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(20)
+// @Success 200 {object} utils.ResponseWithMeta{data=[]dto.ReadingProgressResponse} "Reading history retrieved"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /users/me/history [get]
+func (h *ReadingHandler) GetHistory(c *gin.Context) {
+	var query dto.ReadingHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	history, total, err := h.readingService.GetHistory(middlewares.GetUserID(c), &query)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	utils.SuccessResponseWithMeta(c, http.StatusOK, "Reading history retrieved", history, meta)
+}
+
+// DeleteHistoryEntry handles removing one article from the reading history
+// @Summary Delete a reading history entry
+// @Description Remove one article from the current user's reading history
+// @Tags reading
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "History entry ID"
+// @Success 200 {object} utils.Response "History entry deleted"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 404 {object} utils.Response "History entry not found"
+// @Router /users/me/history/{id} [delete]
+func (h *ReadingHandler) DeleteHistoryEntry(c *gin.Context) {
+	if err := h.readingService.DeleteHistoryEntry(middlewares.GetUserID(c), c.Param("id")); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "History entry deleted", nil)
+}
+
+// ClearHistory handles clearing the reading history
+// @Summary Clear reading history
+// @Description Remove the current user's whole reading history. Read counts of articles are not changed.
+// @Tags reading
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response "Reading history cleared"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /users/me/history [delete]
+func (h *ReadingHandler) ClearHistory(c *gin.Context) {
+	if err := h.readingService.ClearHistory(middlewares.GetUserID(c)); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Reading history cleared", nil)
+}
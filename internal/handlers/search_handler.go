@@ -11,13 +11,15 @@ import (
 
 // SearchHandler handles search-related HTTP requests
 type SearchHandler struct {
-	searchService services.SearchService
+	searchService     services.SearchService
+	engagementService services.EngagementService
 }
 
 // NewSearchHandler creates a new search handler
-func NewSearchHandler(searchService services.SearchService) *SearchHandler {
+func NewSearchHandler(searchService services.SearchService, engagementService services.EngagementService) *SearchHandler {
 	return &SearchHandler{
-		searchService: searchService,
+		searchService:     searchService,
+		engagementService: engagementService,
 	}
 }
 
@@ -51,5 +53,6 @@ func (h *SearchHandler) Search(c *gin.Context) {
 		return
 	}
 
+	enrichArticleList(c, h.engagementService, results.Articles)
 	utils.SuccessResponse(c, http.StatusOK, "Search completed successfully", results)
 }
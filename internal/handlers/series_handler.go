@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// SeriesHandler handles series-related HTTP requests
+type SeriesHandler struct {
+	seriesService services.SeriesService
+}
+
+// NewSeriesHandler creates a new series handler
+func NewSeriesHandler(seriesService services.SeriesService) *SeriesHandler {
+	return &SeriesHandler{
+		seriesService: seriesService,
+	}
+}
+
+// GetSeriesList returns a list of series
+// @Summary List series
+// @Description Get a paginated list of article series
+// @Tags series
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(20)
+// @Param author_id query string false "Filter by author ID"
+// @Success 200 {object} utils.ResponseWithMeta{data=[]dto.SeriesResponse} "Series retrieved successfully"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Router /series [get]
+func (h *SeriesHandler) GetSeriesList(c *gin.Context) {
+	var query dto.SeriesListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	series, total, err := h.seriesService.GetSeriesList(&query)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	utils.SuccessResponseWithMeta(c, http.StatusOK, "Series retrieved successfully", series, meta)
+}
+
+// GetSeries returns a single series by slug
+// @Summary Get series by slug
+// @Description Get a series with its ordered parts (drafts are only listed for the author and editors, followers-only parts for the author's followers; unlisted parts are never listed)
+// @Tags series
+// @Produce json
+// @Param slug path string true "Series slug"
+// @Success 200 {object} utils.Response{data=dto.SeriesDetailResponse} "Series retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid slug"
+// @Failure 404 {object} utils.Response "Series not found"
+// @Router /series/{slug} [get]
+func (h *SeriesHandler) GetSeries(c *gin.Context) {
+	slug := c.Param("slug")
+	if slug == "" {
+		utils.ErrorResponseJSON(c, http.StatusBadRequest, "INVALID_SLUG", "Series slug is required", nil)
+		return
+	}
+
+	viewer := services.Viewer{
+		UserID:   middlewares.GetUserID(c),
+		IsEditor: middlewares.IsEditor(c),
+	}
+
+	series, err := h.seriesService.GetSeries(slug, viewer)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Series retrieved successfully", series)
+}
+
+// CreateSeries creates a new series
+// @Summary Create series
+// @Description Create a new series from the author's articles (requires author role or higher)
+// @Tags series
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateSeriesRequest true "Series data"
+// @Success 201 {object} utils.Response{data=dto.SeriesDetailResponse} "Series created successfully"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - article not owned"
+// @Failure 409 {object} utils.Response "Article already belongs to another series"
+// @Router /series [post]
+func (h *SeriesHandler) CreateSeries(c *gin.Context) {
+	var req dto.CreateSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	series, err := h.seriesService.CreateSeries(&req, middlewares.GetUserID(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusCreated, "Series created successfully", series)
+}
+
+// UpdateSeries updates a series
+// @Summary Update series
+// @Description Update a series; article_ids replaces the ordered list of parts (authors can only update their own, editors can update any)
+// @Tags series
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Series ID (UUID)"
+// @Param request body dto.UpdateSeriesRequest true "Series update data"
+// @Success 200 {object} utils.Response{data=dto.SeriesDetailResponse} "Series updated successfully"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Series not found"
+// @Failure 409 {object} utils.Response "Article already belongs to another series"
+// @Router /series/{id} [put]
+func (h *SeriesHandler) UpdateSeries(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+	if id == "" {
+		utils.ErrorResponseJSON(c, http.StatusBadRequest, "INVALID_ID", "Series ID is required", nil)
+		return
+	}
+
+	var req dto.UpdateSeriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	series, err := h.seriesService.UpdateSeries(id, &req, middlewares.GetUserID(c), middlewares.IsEditor(c))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Series updated successfully", series)
+}
+
+// DeleteSeries deletes a series
+// @Summary Delete series
+// @Description Delete a series; its articles are kept (authors can only delete their own, editors can delete any)
+// @Tags series
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Series ID (UUID)"
+// @Success 200 {object} utils.Response "Series deleted successfully"
+// @Failure 400 {object} utils.Response "Invalid ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden - not the author"
+// @Failure 404 {object} utils.Response "Series not found"
+// @Router /series/{id} [delete]
+func (h *SeriesHandler) DeleteSeries(c *gin.Context) {
+	id := c.Param("slug") // Gin requires consistent param names; value is a UUID
+	if id == "" {
+		utils.ErrorResponseJSON(c, http.StatusBadRequest, "INVALID_ID", "Series ID is required", nil)
+		return
+	}
+
+	if err := h.seriesService.DeleteSeries(id, middlewares.GetUserID(c), middlewares.IsEditor(c)); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Series deleted successfully", nil)
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/middlewares"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// StatsHandler handles author and article statistics requests
+type StatsHandler struct {
+	statsService services.StatsService
+}
+
+// NewStatsHandler creates a new stats handler
+func NewStatsHandler(statsService services.StatsService) *StatsHandler {
+	return &StatsHandler{
+		statsService: statsService,
+	}
+}
+
+// GetMyStats handles the current user's statistics across their articles
+// @Summary Get my stats
+// @Description Get views, reads, read ratio, likes, comments and bookmarks across the current user's articles as a time series, with their top articles, top referrers and follower growth
+// @Tags stats
+// @Produce json
+// @Security BearerAuth
+// @Param range query string false "Period ending today" Enums(7d, 30d, 90d, 1y) default(30d)
+// @Param granularity query string false "Series interval" Enums(day, week, month) default(day)
+// @Success 200 {object} utils.Response{data=dto.AuthorStatsResponse} "Stats retrieved"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Router /users/me/stats [get]
+func (h *StatsHandler) GetMyStats(c *gin.Context) {
+	var query dto.StatsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	stats, err := h.statsService.GetAuthorStats(middlewares.GetUserID(c), &query)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Stats retrieved", stats)
+}
+
+// GetArticleStats handles an article's statistics
+// @Summary Get article stats
+// @Description Get an article's views, reads, read ratio, likes, comments and bookmarks as a time series, with its top referrers. Only the author and editors can see them.
+// @Tags stats
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Article slug"
+// @Param range query string false "Period ending today" Enums(7d, 30d, 90d, 1y) default(30d)
+// @Param granularity query string false "Series interval" Enums(day, week, month) default(day)
+// @Success 200 {object} utils.Response{data=dto.ArticleStatsResponse} "Stats retrieved"
+// @Failure 400 {object} utils.Response "Validation error"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Not the author or an editor"
+// @Failure 404 {object} utils.Response "Article not found"
+// @Router /articles/{slug}/stats [get]
+func (h *StatsHandler) GetArticleStats(c *gin.Context) {
+	var query dto.StatsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	stats, err := h.statsService.GetArticleStats(c.Param("slug"), middlewares.GetUserID(c), middlewares.IsEditor(c), &query)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Stats retrieved", stats)
+}
@@ -12,13 +12,15 @@ import (
 
 // TagHandler handles tag-related HTTP requests
 type TagHandler struct {
-	tagService services.TagService
+	tagService        services.TagService
+	engagementService services.EngagementService
 }
 
 // NewTagHandler creates a new tag handler
-func NewTagHandler(tagService services.TagService) *TagHandler {
+func NewTagHandler(tagService services.TagService, engagementService services.EngagementService) *TagHandler {
 	return &TagHandler{
-		tagService: tagService,
+		tagService:        tagService,
+		engagementService: engagementService,
 	}
 }
 
@@ -56,6 +58,8 @@ func (h *TagHandler) GetTags(c *gin.Context) {
 // @Produce json
 // @Param slug path string true "Tag slug"
 // @Success 200 {object} utils.Response{data=dto.TagResponse} "Tag retrieved successfully"
+// @Success 301 {object} utils.Response{data=utils.RedirectDetails} "Slug changed; follow the Location header"
+// @Success 304 "Not modified since the ETag the client has"
 // @Failure 400 {object} utils.Response "Invalid slug"
 // @Failure 404 {object} utils.Response "Tag not found"
 // @Router /tags/{slug} [get]
@@ -179,6 +183,7 @@ func (h *TagHandler) DeleteTag(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(10)
 // @Success 200 {object} utils.ResponseWithMeta{data=[]dto.ArticleListResponse} "Articles retrieved successfully"
+// @Success 301 {object} utils.Response{data=utils.RedirectDetails} "Slug changed; follow the Location header"
 // @Failure 400 {object} utils.Response "Invalid slug"
 // @Failure 404 {object} utils.Response "Tag not found"
 // @Router /tags/{slug}/articles [get]
@@ -201,6 +206,7 @@ func (h *TagHandler) GetTagArticles(c *gin.Context) {
 		return
 	}
 
+	enrichArticleList(c, h.engagementService, articles)
 	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
 	utils.SuccessResponseWithMeta(c, http.StatusOK, "Articles retrieved successfully", articles, meta)
 }
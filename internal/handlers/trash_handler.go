@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/services"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// TrashHandler handles trash HTTP requests
+type TrashHandler struct {
+	trashService services.TrashService
+}
+
+// NewTrashHandler creates a new trash handler
+func NewTrashHandler(trashService services.TrashService) *TrashHandler {
+	return &TrashHandler{
+		trashService: trashService,
+	}
+}
+
+// GetTrash lists deleted items of one type
+// @Summary List deleted items
+// @Description List soft-deleted articles, comments or users, most recently deleted first, with the time each will be purged (admin only)
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param type path string true "Item type" Enums(articles, comments, users)
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(20)
+// @Success 200 {object} utils.ResponseWithMeta{data=[]dto.TrashItemResponse} "Deleted items retrieved successfully"
+// @Failure 400 {object} utils.Response "Invalid type"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden"
+// @Router /trash/{type} [get]
+func (h *TrashHandler) GetTrash(c *gin.Context) {
+	var query dto.PaginationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
+		return
+	}
+
+	items, total, err := h.trashService.GetTrash(c.Param("type"), &query)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
+	utils.SuccessResponseWithMeta(c, http.StatusOK, "Deleted items retrieved successfully", items, meta)
+}
+
+// RestoreItem restores a deleted item
+// @Summary Restore a deleted item
+// @Description Restore a soft-deleted article, comment or user (admin only). A restored article gets its tag usage back and a new slug if its old one was taken; comments and articles whose author, article or parent comment is still deleted can't be restored.
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param type path string true "Item type" Enums(articles, comments, users)
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {object} utils.Response{data=dto.TrashRestoreResponse} "Item restored successfully"
+// @Failure 400 {object} utils.Response "Invalid type or ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden"
+// @Failure 404 {object} utils.Response "Item not in trash"
+// @Failure 409 {object} utils.Response "Item depends on something that is still deleted"
+// @Router /trash/{type}/{id}/restore [post]
+func (h *TrashHandler) RestoreItem(c *gin.Context) {
+	restored, err := h.trashService.Restore(c.Param("type"), c.Param("id"))
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Item restored successfully", restored)
+}
+
+// PurgeItem permanently deletes an item in the trash
+// @Summary Purge a deleted item
+// @Description Permanently delete a soft-deleted article (with its comments and engagement), comment (with its deleted replies) or user (admin only). Users who still own content can't be purged.
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param type path string true "Item type" Enums(articles, comments, users)
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {object} utils.Response "Item purged successfully"
+// @Failure 400 {object} utils.Response "Invalid type or ID"
+// @Failure 401 {object} utils.Response "Unauthorized"
+// @Failure 403 {object} utils.Response "Forbidden"
+// @Failure 404 {object} utils.Response "Item not in trash"
+// @Failure 409 {object} utils.Response "Item still has live replies or content"
+// @Router /trash/{type}/{id} [delete]
+func (h *TrashHandler) PurgeItem(c *gin.Context) {
+	if err := h.trashService.Purge(c.Param("type"), c.Param("id")); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.SuccessResponse(c, http.StatusOK, "Item purged successfully", nil)
+}
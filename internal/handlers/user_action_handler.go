@@ -12,13 +12,15 @@ import (
 
 // UserActionHandler handles user social actions (follow, interests, feed)
 type UserActionHandler struct {
-	userService services.UserService
+	userService       services.UserService
+	engagementService services.EngagementService
 }
 
 // NewUserActionHandler creates a new user action handler
-func NewUserActionHandler(userService services.UserService) *UserActionHandler {
+func NewUserActionHandler(userService services.UserService, engagementService services.EngagementService) *UserActionHandler {
 	return &UserActionHandler{
-		userService: userService,
+		userService:       userService,
+		engagementService: engagementService,
 	}
 }
 
@@ -97,6 +99,7 @@ func (h *UserActionHandler) UnfollowUser(c *gin.Context) {
 // @Param id path string true "User ID"
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(20)
+// @Param cursor query string false "next_cursor of the previous page; replaces page and skips the total count"
 // @Success 200 {object} utils.Response{data=dto.FollowListResponse} "Followers retrieved successfully"
 // @Failure 400 {object} utils.Response "Invalid request"
 // @Failure 404 {object} utils.Response "User not found"
@@ -131,6 +134,7 @@ func (h *UserActionHandler) GetFollowers(c *gin.Context) {
 // @Param id path string true "User ID"
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(20)
+// @Param cursor query string false "next_cursor of the previous page; replaces page and skips the total count"
 // @Success 200 {object} utils.Response{data=dto.FollowListResponse} "Following list retrieved successfully"
 // @Failure 400 {object} utils.Response "Invalid request"
 // @Failure 404 {object} utils.Response "User not found"
@@ -225,6 +229,7 @@ func (h *UserActionHandler) GetInterests(c *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(20)
 // @Param sort query string false "Sort by: newest, oldest, popular" default(newest)
+// @Param exclude_read query bool false "Leave out articles the user has finished reading"
 // @Success 200 {object} utils.Response{data=[]dto.ArticleListItemResponse} "Feed retrieved successfully"
 // @Failure 401 {object} utils.Response "Unauthorized"
 // @Router /articles/feed [get]
@@ -235,7 +240,7 @@ func (h *UserActionHandler) GetPersonalizedFeed(c *gin.Context) {
 		return
 	}
 
-	var query dto.PaginationQuery
+	var query dto.FeedQuery
 	if err := c.ShouldBindQuery(&query); err != nil {
 		utils.HandleValidationError(c, utils.ParseValidationErrors(err))
 		return
@@ -247,6 +252,7 @@ func (h *UserActionHandler) GetPersonalizedFeed(c *gin.Context) {
 		return
 	}
 
+	enrichArticleList(c, h.engagementService, articles)
 	utils.SuccessResponseWithMeta(c, http.StatusOK, "Feed retrieved successfully", articles, &utils.Meta{
 		Page:       query.GetPage(),
 		PerPage:    query.GetPerPage(),
@@ -257,12 +263,11 @@ func (h *UserActionHandler) GetPersonalizedFeed(c *gin.Context) {
 
 // GetStaffPicks handles getting staff-picked articles
 // @Summary Get staff picks
-// @Description Get articles marked as staff picks
+// @Description Get the staff picks currently on display, ordered by their position and then by publication date. Picks outside their start/end dates are left out.
 // @Tags articles
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(20)
-// @Param sort query string false "Sort by: newest, oldest, popular" default(newest)
 // @Success 200 {object} utils.Response{data=[]dto.ArticleListItemResponse} "Staff picks retrieved successfully"
 // @Router /articles/staff-picks [get]
 func (h *UserActionHandler) GetStaffPicks(c *gin.Context) {
@@ -278,6 +283,7 @@ func (h *UserActionHandler) GetStaffPicks(c *gin.Context) {
 		return
 	}
 
+	enrichArticleList(c, h.engagementService, articles)
 	utils.SuccessResponseWithMeta(c, http.StatusOK, "Staff picks retrieved successfully", articles, &utils.Meta{
 		Page:       query.GetPage(),
 		PerPage:    query.GetPerPage(),
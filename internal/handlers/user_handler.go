@@ -12,13 +12,15 @@ import (
 
 // UserHandler handles user-related HTTP requests
 type UserHandler struct {
-	userService services.UserService
+	userService       services.UserService
+	engagementService services.EngagementService
 }
 
 // NewUserHandler creates a new user handler
-func NewUserHandler(userService services.UserService) *UserHandler {
+func NewUserHandler(userService services.UserService, engagementService services.EngagementService) *UserHandler {
 	return &UserHandler{
-		userService: userService,
+		userService:       userService,
+		engagementService: engagementService,
 	}
 }
 
@@ -215,6 +217,7 @@ func (h *UserHandler) GetUserArticles(c *gin.Context) {
 		return
 	}
 
+	enrichArticleList(c, h.engagementService, articles)
 	meta := utils.NewMeta(query.GetPage(), query.GetPerPage(), total)
 	utils.SuccessResponseWithMeta(c, http.StatusOK, "Articles retrieved successfully", articles, meta)
 }
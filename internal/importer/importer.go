@@ -0,0 +1,295 @@
+// Package importer parses blog exports (WordPress WXR files and zip archives of
+// Markdown files with YAML frontmatter) into posts ready to be imported.
+// It only reads the export; mapping posts onto the database is done by the import service.
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Source formats
+const (
+	SourceWXR      = "wxr"
+	SourceMarkdown = "markdown"
+)
+
+// Post statuses
+const (
+	StatusDraft     = "draft"
+	StatusPublished = "published"
+)
+
+// zipLimits caps what may be extracted from a zip archive. Sizes are
+// uncompressed, so a small archive can't expand into more than this.
+type zipLimits struct {
+	fileSize  int64 // Size of a single file
+	totalSize int64 // Combined size of all extracted files
+	entries   int   // Number of entries, including skipped ones
+}
+
+// archiveLimits are the limits applied to uploaded zip archives
+var archiveLimits = zipLimits{
+	fileSize:  32 << 20,
+	totalSize: 512 << 20,
+	entries:   10000,
+}
+
+// ErrUnsupportedFormat is returned when the export is neither WXR nor a zip archive
+var ErrUnsupportedFormat = errors.New("unsupported import format: expected a WordPress .xml export or a .zip archive")
+
+// Author identifies a post author as named in the export
+type Author struct {
+	Login       string `json:"login,omitempty"`
+	Email       string `json:"email,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// Key returns the most specific identifier of the author
+func (a Author) Key() string {
+	switch {
+	case a.Email != "":
+		return a.Email
+	case a.Login != "":
+		return a.Login
+	default:
+		return a.DisplayName
+	}
+}
+
+// Post represents a single article found in an export
+type Post struct {
+	SourceID        string // Stable ID within the export, used to make re-runs idempotent
+	Path            string // Location of the post in the archive, used to resolve relative links
+	Title           string
+	Slug            string
+	Content         string
+	Format          string // "html" or "markdown"
+	Excerpt         string
+	Status          string
+	PublishedAt     *time.Time
+	Locale          string
+	Author          Author
+	Categories      []string
+	Tags            []string
+	FeaturedImage   string
+	MetaTitle       string
+	MetaDescription string
+	MetaKeywords    string
+}
+
+// Archive is the parsed content of an export
+type Archive struct {
+	Source  string
+	Posts   []Post
+	Files   map[string][]byte // Other files in the archive keyed by slash-separated path
+	Skipped []string          // Entries that were not imported, with the reason
+}
+
+// Parse reads an export of the given size. The format is chosen from the file
+// name: .xml is a WordPress WXR export and .zip is an archive of Markdown files,
+// or of a WXR file together with its wp-content/uploads directory.
+func Parse(filename string, r io.ReaderAt, size int64) (*Archive, error) {
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".xml":
+		return ParseWXR(io.NewSectionReader(r, 0, size))
+	case ".zip":
+		return parseZip(r, size, archiveLimits)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// parseZip reads a zip archive and parses the export inside it
+func parseZip(r io.ReaderAt, size int64, limits zipLimits) (*Archive, error) {
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip archive: %w", err)
+	}
+	if len(reader.File) > limits.entries {
+		return nil, fmt.Errorf("archive has more than %d entries", limits.entries)
+	}
+
+	files := make(map[string][]byte)
+	var total int64
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		name := path.Clean(strings.TrimPrefix(strings.ReplaceAll(file.Name, "\\", "/"), "/"))
+		if strings.HasPrefix(name, "../") || strings.HasPrefix(name, "__MACOSX/") || strings.HasPrefix(path.Base(name), ".") {
+			continue
+		}
+		if file.UncompressedSize64 > uint64(limits.fileSize) {
+			return nil, fmt.Errorf("%s is larger than %d MB", name, limits.fileSize>>20)
+		}
+
+		// The sizes in the zip headers may lie, so the limits are applied to what is read
+		limit := min(limits.fileSize, limits.totalSize-total)
+		content, err := readZipFile(file, limit)
+		if errors.Is(err, errFileTooLarge) && limit < limits.fileSize {
+			return nil, fmt.Errorf("archive is larger than %d MB uncompressed", limits.totalSize>>20)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		total += int64(len(content))
+		files[name] = content
+	}
+
+	// A zip holding a WXR export: the XML plus its uploads
+	for name, content := range files {
+		if strings.EqualFold(path.Ext(name), ".xml") {
+			archive, err := ParseWXR(bytes.NewReader(content))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			delete(files, name)
+			archive.Files = files
+			return archive, nil
+		}
+	}
+
+	return parseMarkdownFiles(files)
+}
+
+// errFileTooLarge is returned when a file in a zip archive exceeds the size it may have
+var errFileTooLarge = errors.New("file too large")
+
+// readZipFile reads a file from a zip archive, failing if it holds more than limit bytes
+func readZipFile(file *zip.File, limit int64) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > limit {
+		return nil, errFileTooLarge
+	}
+	return content, nil
+}
+
+var (
+	// htmlLinkRegex matches src and href attributes
+	htmlLinkRegex = regexp.MustCompile(`(?i)(?:src|href)\s*=\s*["']([^"']+)["']`)
+	// markdownLinkRegex matches Markdown link and image targets
+	markdownLinkRegex = regexp.MustCompile(`\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+)
+
+// LocalFiles returns the links in a post that point to files in the archive,
+// mapped to the archive path they resolve to
+func (a *Archive) LocalFiles(post *Post) map[string]string {
+	refs := make(map[string]string)
+	if len(a.Files) == 0 {
+		return refs
+	}
+
+	links := Links(post.Content)
+	if post.FeaturedImage != "" {
+		links = append(links, post.FeaturedImage)
+	}
+
+	for _, link := range links {
+		if _, seen := refs[link]; seen {
+			continue
+		}
+		if name, ok := a.ResolveFile(post, link); ok {
+			refs[link] = name
+		}
+	}
+
+	return refs
+}
+
+// Links returns the src/href attribute values and Markdown link targets in the content
+func Links(content string) []string {
+	var links []string
+	for _, match := range htmlLinkRegex.FindAllStringSubmatch(content, -1) {
+		links = append(links, match[1])
+	}
+	for _, match := range markdownLinkRegex.FindAllStringSubmatch(content, -1) {
+		links = append(links, match[1])
+	}
+	return links
+}
+
+// ResolveFile finds the archive file a link points to. Relative links are
+// resolved against the post's location. Absolute URLs match a file whose path
+// is a suffix of the URL path of at least two segments, such as the
+// "2020/01/photo.jpg" part of a wp-content/uploads URL.
+func (a *Archive) ResolveFile(post *Post, link string) (string, bool) {
+	parsed, err := url.Parse(strings.TrimSpace(link))
+	if err != nil || parsed.Path == "" {
+		return "", false
+	}
+
+	linkPath, err := url.PathUnescape(parsed.Path)
+	if err != nil {
+		linkPath = parsed.Path
+	}
+
+	if parsed.Scheme == "" && parsed.Host == "" {
+		var name string
+		if strings.HasPrefix(linkPath, "/") {
+			name = path.Clean(strings.TrimPrefix(linkPath, "/"))
+		} else {
+			name = path.Join(path.Dir(post.Path), linkPath)
+		}
+		if _, ok := a.Files[name]; ok {
+			return name, true
+		}
+		if !strings.HasPrefix(linkPath, "/") {
+			return "", false
+		}
+	} else if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", false
+	}
+
+	segments := strings.Split(strings.Trim(linkPath, "/"), "/")
+	for i := 0; i <= len(segments)-2; i++ {
+		suffix := strings.Join(segments[i:], "/")
+		for name := range a.Files {
+			if name == suffix || strings.HasSuffix(name, "/"+suffix) {
+				return name, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// RewriteLinks replaces link targets in the content using the given mapping.
+// Only src/href attributes and Markdown link targets are touched.
+func RewriteLinks(content string, replacements map[string]string) string {
+	if len(replacements) == 0 {
+		return content
+	}
+
+	rewrite := func(re *regexp.Regexp) func(string) string {
+		return func(match string) string {
+			sub := re.FindStringSubmatchIndex(match)
+			link := match[sub[2]:sub[3]]
+			replacement, ok := replacements[link]
+			if !ok {
+				return match
+			}
+			return match[:sub[2]] + replacement + match[sub[3]:]
+		}
+	}
+
+	content = htmlLinkRegex.ReplaceAllStringFunc(content, rewrite(htmlLinkRegex))
+	return markdownLinkRegex.ReplaceAllStringFunc(content, rewrite(markdownLinkRegex))
+}
@@ -0,0 +1,142 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildZip(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestParse_UnsupportedFormat(t *testing.T) {
+	_, err := Parse("export.json", bytes.NewReader([]byte("{}")), 2)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestParse_MarkdownZip(t *testing.T) {
+	data := buildZip(t, map[string]string{
+		"posts/first.md":            "---\ntitle: First\n---\n![a](../images/a.png)",
+		"posts/second.markdown":     "# Second\n\ntext",
+		"posts/broken.md":           "no title here",
+		"images/a.png":              "png",
+		"__MACOSX/posts/._first.md": "junk",
+		".DS_Store":                 "junk",
+	})
+
+	archive, err := Parse("site.zip", data, data.Size())
+	require.NoError(t, err)
+
+	assert.Equal(t, SourceMarkdown, archive.Source)
+	require.Len(t, archive.Posts, 2)
+	assert.Equal(t, "First", archive.Posts[0].Title)
+	assert.Equal(t, "Second", archive.Posts[1].Title)
+	assert.Len(t, archive.Skipped, 1)
+	assert.Equal(t, map[string][]byte{"images/a.png": []byte("png")}, archive.Files)
+}
+
+func TestParse_WXRZip(t *testing.T) {
+	data := buildZip(t, map[string]string{
+		"export.xml":                           testWXR,
+		"wp-content/uploads/2020/01/photo.jpg": "jpg",
+	})
+
+	archive, err := Parse("site.zip", data, data.Size())
+	require.NoError(t, err)
+
+	assert.Equal(t, SourceWXR, archive.Source)
+	assert.Len(t, archive.Posts, 2)
+
+	refs := archive.LocalFiles(&archive.Posts[0])
+	assert.Equal(t, map[string]string{
+		"https://old.example.com/wp-content/uploads/2020/01/photo.jpg": "wp-content/uploads/2020/01/photo.jpg",
+	}, refs)
+}
+
+func TestParse_ZipWithoutPosts(t *testing.T) {
+	data := buildZip(t, map[string]string{"images/a.png": "png"})
+	_, err := Parse("site.zip", data, data.Size())
+	assert.Error(t, err)
+}
+
+func TestParseZip_TooManyEntries(t *testing.T) {
+	data := buildZip(t, map[string]string{"a.md": "a", "b.md": "b", "c.md": "c"})
+
+	_, err := parseZip(data, data.Size(), zipLimits{fileSize: 1 << 20, totalSize: 1 << 20, entries: 2})
+
+	assert.ErrorContains(t, err, "more than 2 entries")
+}
+
+func TestParseZip_TotalSizeLimit(t *testing.T) {
+	// Each file is within the per-file limit, but together they expand past the total
+	data := buildZip(t, map[string]string{
+		"posts/a.md": "---\ntitle: A\n---\n" + strings.Repeat("a", 600),
+		"posts/b.md": "---\ntitle: B\n---\n" + strings.Repeat("b", 600),
+	})
+
+	_, err := parseZip(data, data.Size(), zipLimits{fileSize: 1 << 10, totalSize: 1 << 10, entries: 10})
+	assert.ErrorContains(t, err, "uncompressed")
+
+	archive, err := parseZip(data, data.Size(), zipLimits{fileSize: 1 << 10, totalSize: 2 << 10, entries: 10})
+	require.NoError(t, err)
+	assert.Len(t, archive.Posts, 2)
+}
+
+func TestResolveFile(t *testing.T) {
+	archive := &Archive{Files: map[string][]byte{
+		"posts/images/a.png":                   nil,
+		"static/b.png":                         nil,
+		"wp-content/uploads/2020/01/photo.jpg": nil,
+	}}
+	post := &Post{Path: "posts/first.md"}
+
+	tests := []struct {
+		name     string
+		link     string
+		expected string
+		ok       bool
+	}{
+		{name: "relative", link: "images/a.png", expected: "posts/images/a.png", ok: true},
+		{name: "relative with dot", link: "./images/a.png", expected: "posts/images/a.png", ok: true},
+		{name: "root relative", link: "/static/b.png", expected: "static/b.png", ok: true},
+		{name: "absolute url", link: "https://old.example.com/wp-content/uploads/2020/01/photo.jpg?w=300", expected: "wp-content/uploads/2020/01/photo.jpg", ok: true},
+		{name: "single segment url", link: "https://cdn.example.com/photo.jpg", ok: false},
+		{name: "missing file", link: "images/missing.png", ok: false},
+		{name: "mailto", link: "mailto:jane@example.com", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := archive.ResolveFile(post, tt.link)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+}
+
+func TestRewriteLinks(t *testing.T) {
+	content := `<img src="a.png"> <a href='b.png'>b</a> ![c](a.png "title") [d](other.png)`
+	result := RewriteLinks(content, map[string]string{
+		"a.png": "/uploads/1.png",
+		"b.png": "/uploads/2.png",
+	})
+
+	assert.Equal(t, `<img src="/uploads/1.png"> <a href='/uploads/2.png'>b</a> ![c](/uploads/1.png "title") [d](other.png)`, result)
+}
@@ -0,0 +1,235 @@
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// frontmatterDelimiter opens and closes the YAML block at the top of a Markdown file
+const frontmatterDelimiter = "---"
+
+// frontmatter holds the fields we read from a Markdown file. Field names follow
+// the common Jekyll, Hugo and Ghost conventions, plus the fields written by our
+// own export.
+type frontmatter struct {
+	ID              string     `yaml:"id"`
+	Title           string     `yaml:"title"`
+	Slug            string     `yaml:"slug"`
+	Date            string     `yaml:"date"`
+	Draft           bool       `yaml:"draft"`
+	Status          string     `yaml:"status"`
+	Published       *bool      `yaml:"published"`
+	Author          string     `yaml:"author"`
+	Categories      stringList `yaml:"categories"`
+	Category        string     `yaml:"category"`
+	Tags            stringList `yaml:"tags"`
+	Excerpt         string     `yaml:"excerpt"`
+	Description     string     `yaml:"description"`
+	Summary         string     `yaml:"summary"`
+	Image           string     `yaml:"image"`
+	FeaturedImage   string     `yaml:"featured_image"`
+	Cover           string     `yaml:"cover"`
+	Lang            string     `yaml:"lang"`
+	Locale          string     `yaml:"locale"`
+	Format          string     `yaml:"format"`
+	MetaTitle       string     `yaml:"meta_title"`
+	MetaDescription string     `yaml:"meta_description"`
+	MetaKeywords    string     `yaml:"meta_keywords"`
+}
+
+// stringList accepts either a YAML list or a single comma-separated string
+type stringList []string
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (l *stringList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		for _, item := range strings.Split(node.Value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*l = append(*l, item)
+			}
+		}
+		return nil
+	}
+	var items []string
+	if err := node.Decode(&items); err != nil {
+		return err
+	}
+	*l = items
+	return nil
+}
+
+// frontmatterDateLayouts are the date formats accepted in frontmatter
+var frontmatterDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseMarkdownFiles parses every .md file in an archive; the remaining files
+// are kept as media candidates
+func parseMarkdownFiles(files map[string][]byte) (*Archive, error) {
+	archive := &Archive{Source: SourceMarkdown, Files: make(map[string][]byte)}
+
+	var names []string
+	for name, content := range files {
+		ext := strings.ToLower(path.Ext(name))
+		if ext == ".md" || ext == ".markdown" {
+			names = append(names, name)
+		} else {
+			archive.Files[name] = content
+		}
+	}
+	if len(names) == 0 {
+		return nil, errors.New("archive contains no Markdown files")
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		post, err := ParseMarkdown(name, files[name])
+		if err != nil {
+			archive.Skipped = append(archive.Skipped, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		archive.Posts = append(archive.Posts, *post)
+	}
+
+	return archive, nil
+}
+
+// ParseMarkdown parses a Markdown file with optional YAML frontmatter. Missing
+// fields fall back to the file: the title to the first heading, the slug to the file name.
+func ParseMarkdown(name string, data []byte) (*Post, error) {
+	meta, body, err := splitFrontmatter(data)
+	if err != nil {
+		return nil, err
+	}
+
+	post := &Post{
+		SourceID:   "markdown:" + name,
+		Path:       name,
+		Title:      strings.TrimSpace(meta.Title),
+		Slug:       strings.TrimSpace(meta.Slug),
+		Content:    strings.TrimSpace(body),
+		Format:     "markdown",
+		Author:     Author{Login: strings.TrimSpace(meta.Author)},
+		Categories: meta.Categories,
+		Tags:       meta.Tags,
+		Status:     StatusPublished,
+	}
+	if meta.ID != "" {
+		post.SourceID = "markdown:id:" + strings.TrimSpace(meta.ID)
+	}
+	if strings.Contains(post.Author.Login, "@") {
+		post.Author = Author{Email: post.Author.Login}
+	}
+	if meta.Category != "" {
+		post.Categories = append(post.Categories, strings.TrimSpace(meta.Category))
+	}
+
+	post.Excerpt = firstNonEmpty(meta.Excerpt, meta.Description, meta.Summary)
+	post.FeaturedImage = firstNonEmpty(meta.FeaturedImage, meta.Image, meta.Cover)
+	post.Locale = firstNonEmpty(meta.Locale, meta.Lang)
+	post.MetaTitle = strings.TrimSpace(meta.MetaTitle)
+	post.MetaDescription = strings.TrimSpace(meta.MetaDescription)
+	post.MetaKeywords = strings.TrimSpace(meta.MetaKeywords)
+
+	// Files exported from an HTML article keep their HTML body
+	if strings.EqualFold(meta.Format, "html") {
+		post.Format = "html"
+	}
+
+	if meta.Draft || (meta.Published != nil && !*meta.Published) || (meta.Status != "" && meta.Status != "published" && meta.Status != "publish") {
+		post.Status = StatusDraft
+	}
+
+	if meta.Date != "" {
+		date, err := parseFrontmatterDate(meta.Date)
+		if err != nil {
+			return nil, err
+		}
+		post.PublishedAt = &date
+	}
+
+	if post.Title == "" {
+		post.Title, post.Content = extractTitle(post.Content)
+	}
+	if post.Title == "" {
+		return nil, errors.New("missing title")
+	}
+	if post.Slug == "" {
+		post.Slug = strings.TrimSuffix(path.Base(name), path.Ext(name))
+	}
+
+	return post, nil
+}
+
+// splitFrontmatter separates the YAML frontmatter from the Markdown body
+func splitFrontmatter(data []byte) (frontmatter, string, error) {
+	var meta frontmatter
+
+	text := strings.TrimPrefix(strings.ReplaceAll(string(data), "\r\n", "\n"), "\ufeff")
+	if !strings.HasPrefix(text, frontmatterDelimiter+"\n") {
+		return meta, text, nil
+	}
+
+	rest := text[len(frontmatterDelimiter)+1:]
+	end := strings.Index(rest, "\n"+frontmatterDelimiter)
+	if end < 0 {
+		return meta, "", errors.New("unterminated frontmatter")
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader([]byte(rest[:end])))
+	if err := decoder.Decode(&meta); err != nil && !errors.Is(err, io.EOF) {
+		return meta, "", fmt.Errorf("invalid frontmatter: %w", err)
+	}
+
+	body := rest[end+len(frontmatterDelimiter)+1:]
+	if i := strings.Index(body, "\n"); i >= 0 {
+		body = body[i+1:]
+	} else {
+		body = ""
+	}
+
+	return meta, body, nil
+}
+
+// parseFrontmatterDate parses the date formats commonly found in frontmatter
+func parseFrontmatterDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range frontmatterDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// extractTitle takes the title from a leading "# Heading" line and removes it from the body
+func extractTitle(body string) (string, string) {
+	line, rest, _ := strings.Cut(body, "\n")
+	if strings.HasPrefix(line, "# ") {
+		return strings.TrimSpace(strings.TrimPrefix(line, "# ")), strings.TrimSpace(rest)
+	}
+	return "", body
+}
+
+// firstNonEmpty returns the first non-blank value
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
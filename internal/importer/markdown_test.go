@@ -0,0 +1,89 @@
+package importer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	data := []byte(`---
+title: "Getting Started"
+slug: getting-started
+date: 2021-03-04
+author: jane@example.com
+categories: [Guides]
+tags: go, web
+description: An introduction
+image: images/cover.png
+lang: ur
+---
+Body with ![diagram](images/diagram.png).
+`)
+
+	post, err := ParseMarkdown("posts/getting-started.md", data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "markdown:posts/getting-started.md", post.SourceID)
+	assert.Equal(t, "Getting Started", post.Title)
+	assert.Equal(t, "getting-started", post.Slug)
+	assert.Equal(t, "markdown", post.Format)
+	assert.Equal(t, StatusPublished, post.Status)
+	assert.Equal(t, Author{Email: "jane@example.com"}, post.Author)
+	assert.Equal(t, []string{"Guides"}, post.Categories)
+	assert.Equal(t, []string{"go", "web"}, post.Tags)
+	assert.Equal(t, "An introduction", post.Excerpt)
+	assert.Equal(t, "images/cover.png", post.FeaturedImage)
+	assert.Equal(t, "ur", post.Locale)
+	assert.Equal(t, "Body with ![diagram](images/diagram.png).", post.Content)
+	require.NotNil(t, post.PublishedAt)
+	assert.Equal(t, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), *post.PublishedAt)
+}
+
+func TestParseMarkdown_Fallbacks(t *testing.T) {
+	post, err := ParseMarkdown("notes/my-note.md", []byte("# My Note\n\nSome text"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "My Note", post.Title)
+	assert.Equal(t, "my-note", post.Slug)
+	assert.Equal(t, "Some text", post.Content)
+	assert.Nil(t, post.PublishedAt)
+}
+
+func TestParseMarkdown_Draft(t *testing.T) {
+	post, err := ParseMarkdown("draft.md", []byte("---\ntitle: Draft\ndraft: true\nid: 42\n---\ntext"))
+	require.NoError(t, err)
+
+	assert.Equal(t, StatusDraft, post.Status)
+	assert.Equal(t, "markdown:id:42", post.SourceID)
+}
+
+func TestParseMarkdown_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "missing title", data: "just text"},
+		{name: "unterminated frontmatter", data: "---\ntitle: x\n"},
+		{name: "invalid yaml", data: "---\ntitle: [x\n---\n"},
+		{name: "invalid date", data: "---\ntitle: x\ndate: yesterday\n---\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseMarkdown("post.md", []byte(tt.data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseMarkdown_HTMLFormat(t *testing.T) {
+	post, err := ParseMarkdown("page.md", []byte("---\ntitle: Page\nformat: html\nmeta_title: Page title\n---\n<p>text</p>"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "html", post.Format)
+	assert.Equal(t, "Page title", post.MetaTitle)
+	assert.Equal(t, "<p>text</p>", post.Content)
+}
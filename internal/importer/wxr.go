@@ -0,0 +1,222 @@
+package importer
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// wxrDateLayout is the date format used by WordPress exports
+const wxrDateLayout = "2006-01-02 15:04:05"
+
+// wxrDocument mirrors the parts of a WordPress eXtended RSS export we import.
+// Elements are matched by local name so that every WXR version (1.0-1.2) parses.
+type wxrDocument struct {
+	Channel struct {
+		Language string      `xml:"language"`
+		Authors  []wxrAuthor `xml:"author"`
+		Items    []wxrItem   `xml:"item"`
+	} `xml:"channel"`
+}
+
+type wxrAuthor struct {
+	Login       string `xml:"author_login"`
+	Email       string `xml:"author_email"`
+	DisplayName string `xml:"author_display_name"`
+}
+
+type wxrItem struct {
+	Title      string        `xml:"title"`
+	GUID       string        `xml:"guid"`
+	Creator    string        `xml:"creator"`
+	Encoded    []wxrEncoded  `xml:"encoded"`
+	PostID     string        `xml:"post_id"`
+	PostDate   string        `xml:"post_date"`
+	PostGMT    string        `xml:"post_date_gmt"`
+	PostName   string        `xml:"post_name"`
+	Status     string        `xml:"status"`
+	PostType   string        `xml:"post_type"`
+	Attachment string        `xml:"attachment_url"`
+	Categories []wxrCategory `xml:"category"`
+	Meta       []wxrMeta     `xml:"postmeta"`
+}
+
+// wxrEncoded holds content:encoded and excerpt:encoded, told apart by namespace
+type wxrEncoded struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+type wxrCategory struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+type wxrMeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+// ParseWXR parses a WordPress WXR export. Posts keep their WordPress status,
+// slug, author login, categories, tags and featured image; pages, attachments
+// and trashed posts are listed in Skipped.
+func ParseWXR(r io.Reader) (*Archive, error) {
+	var doc wxrDocument
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		// WordPress exports are UTF-8; tolerate mislabelled encodings
+		return input, nil
+	}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid WXR file: %w", err)
+	}
+	if len(doc.Channel.Items) == 0 && len(doc.Channel.Authors) == 0 {
+		return nil, errors.New("invalid WXR file: no channel items found")
+	}
+
+	authors := make(map[string]Author, len(doc.Channel.Authors))
+	for _, author := range doc.Channel.Authors {
+		authors[author.Login] = Author{
+			Login:       strings.TrimSpace(author.Login),
+			Email:       strings.TrimSpace(author.Email),
+			DisplayName: strings.TrimSpace(author.DisplayName),
+		}
+	}
+
+	// Featured images reference attachments by post ID
+	attachments := make(map[string]string)
+	for _, item := range doc.Channel.Items {
+		if item.PostType == "attachment" && item.Attachment != "" {
+			attachments[item.PostID] = strings.TrimSpace(item.Attachment)
+		}
+	}
+
+	archive := &Archive{Source: SourceWXR}
+	for _, item := range doc.Channel.Items {
+		switch {
+		case item.PostType == "attachment":
+			continue
+		case item.PostType != "" && item.PostType != "post":
+			archive.Skipped = append(archive.Skipped, fmt.Sprintf("%q: post type %q is not imported", item.Title, item.PostType))
+			continue
+		case item.Status == "trash" || item.Status == "auto-draft" || item.Status == "inherit":
+			archive.Skipped = append(archive.Skipped, fmt.Sprintf("%q: status %q is not imported", item.Title, item.Status))
+			continue
+		}
+
+		archive.Posts = append(archive.Posts, item.toPost(authors, attachments, doc.Channel.Language))
+	}
+
+	return archive, nil
+}
+
+// toPost converts a WXR item to a post
+func (item wxrItem) toPost(authors map[string]Author, attachments map[string]string, language string) Post {
+	post := Post{
+		SourceID: "wxr:" + strings.TrimSpace(item.GUID),
+		Title:    strings.TrimSpace(item.Title),
+		Slug:     strings.TrimSpace(item.PostName),
+		Format:   "html",
+		Locale:   strings.TrimSpace(language),
+		Status:   StatusDraft,
+	}
+	if strings.TrimSpace(item.GUID) == "" {
+		post.SourceID = "wxr:post:" + item.PostID
+	}
+
+	for _, encoded := range item.Encoded {
+		if strings.Contains(encoded.XMLName.Space, "excerpt") {
+			post.Excerpt = strings.TrimSpace(encoded.Value)
+		} else {
+			post.Content = autop(encoded.Value)
+		}
+	}
+
+	creator := strings.TrimSpace(item.Creator)
+	if author, ok := authors[creator]; ok {
+		post.Author = author
+	} else {
+		post.Author = Author{Login: creator}
+	}
+
+	// Published and scheduled posts keep their date; "future" posts stay scheduled
+	if item.Status == "publish" || item.Status == "future" {
+		post.Status = StatusPublished
+	}
+	if date, ok := parseWXRDate(item.PostGMT, item.PostDate); ok {
+		post.PublishedAt = &date
+	}
+
+	for _, category := range item.Categories {
+		name := strings.TrimSpace(category.Name)
+		if name == "" {
+			continue
+		}
+		switch category.Domain {
+		case "category":
+			if category.Nicename != "uncategorized" {
+				post.Categories = append(post.Categories, name)
+			}
+		case "post_tag":
+			post.Tags = append(post.Tags, name)
+		}
+	}
+
+	for _, meta := range item.Meta {
+		if meta.Key == "_thumbnail_id" {
+			post.FeaturedImage = attachments[strings.TrimSpace(meta.Value)]
+		}
+	}
+
+	return post
+}
+
+// parseWXRDate reads the GMT post date, falling back to the local date as UTC.
+// Unpublished posts carry a zero date, which is reported as missing.
+func parseWXRDate(gmt, local string) (time.Time, bool) {
+	for _, value := range []string{gmt, local} {
+		value = strings.TrimSpace(value)
+		if value == "" || strings.HasPrefix(value, "0000") {
+			continue
+		}
+		if date, err := time.Parse(wxrDateLayout, value); err == nil {
+			return date.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+var (
+	// blockTagRegex matches content that already starts with a block-level element
+	blockTagRegex = regexp.MustCompile(`(?i)^<(p|div|h[1-6]|ul|ol|li|blockquote|pre|table|figure|hr|img|iframe|!--)[\s>/]`)
+	// paragraphBreakRegex matches blank lines between paragraphs
+	paragraphBreakRegex = regexp.MustCompile(`\n\s*\n`)
+)
+
+// autop wraps the plain-text paragraphs WordPress stores without markup in <p>
+// tags and turns single line breaks inside them into <br>, like wpautop does
+func autop(content string) string {
+	content = strings.TrimSpace(strings.ReplaceAll(content, "\r\n", "\n"))
+	if content == "" {
+		return ""
+	}
+
+	blocks := paragraphBreakRegex.Split(content, -1)
+	for i, block := range blocks {
+		block = strings.TrimSpace(block)
+		if blockTagRegex.MatchString(block) {
+			blocks[i] = block
+			continue
+		}
+		blocks[i] = "<p>" + strings.ReplaceAll(block, "\n", "<br>\n") + "</p>"
+	}
+
+	return strings.Join(blocks, "\n")
+}
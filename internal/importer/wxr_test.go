@@ -0,0 +1,127 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWXR = `<?xml version="1.0" encoding="UTF-8" ?>
+<rss version="2.0"
+	xmlns:excerpt="http://wordpress.org/export/1.2/excerpt/"
+	xmlns:content="http://purl.org/rss/1.0/modules/content/"
+	xmlns:dc="http://purl.org/dc/elements/1.1/"
+	xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<title>Old Blog</title>
+	<language>ur-PK</language>
+	<wp:author>
+		<wp:author_login><![CDATA[jane]]></wp:author_login>
+		<wp:author_email><![CDATA[jane@example.com]]></wp:author_email>
+		<wp:author_display_name><![CDATA[Jane Doe]]></wp:author_display_name>
+	</wp:author>
+	<item>
+		<title>Hello &amp; Welcome</title>
+		<guid isPermaLink="false">https://old.example.com/?p=10</guid>
+		<dc:creator><![CDATA[jane]]></dc:creator>
+		<content:encoded><![CDATA[First paragraph
+second line
+
+<img src="https://old.example.com/wp-content/uploads/2020/01/photo.jpg" />]]></content:encoded>
+		<excerpt:encoded><![CDATA[A short summary]]></excerpt:encoded>
+		<wp:post_id>10</wp:post_id>
+		<wp:post_date><![CDATA[2020-01-02 08:00:00]]></wp:post_date>
+		<wp:post_date_gmt><![CDATA[2020-01-02 03:00:00]]></wp:post_date_gmt>
+		<wp:post_name><![CDATA[hello-welcome]]></wp:post_name>
+		<wp:status><![CDATA[publish]]></wp:status>
+		<wp:post_type><![CDATA[post]]></wp:post_type>
+		<category domain="category" nicename="news"><![CDATA[News]]></category>
+		<category domain="category" nicename="uncategorized"><![CDATA[Uncategorized]]></category>
+		<category domain="post_tag" nicename="go"><![CDATA[Go]]></category>
+		<wp:postmeta>
+			<wp:meta_key><![CDATA[_thumbnail_id]]></wp:meta_key>
+			<wp:meta_value><![CDATA[11]]></wp:meta_value>
+		</wp:postmeta>
+	</item>
+	<item>
+		<title>photo</title>
+		<wp:post_id>11</wp:post_id>
+		<wp:post_type><![CDATA[attachment]]></wp:post_type>
+		<wp:attachment_url><![CDATA[https://old.example.com/wp-content/uploads/2020/01/photo.jpg]]></wp:attachment_url>
+	</item>
+	<item>
+		<title>Work in progress</title>
+		<guid isPermaLink="false">https://old.example.com/?p=12</guid>
+		<dc:creator><![CDATA[ghost]]></dc:creator>
+		<content:encoded><![CDATA[<p>Not done yet</p>]]></content:encoded>
+		<wp:post_id>12</wp:post_id>
+		<wp:post_date_gmt><![CDATA[0000-00-00 00:00:00]]></wp:post_date_gmt>
+		<wp:status><![CDATA[draft]]></wp:status>
+		<wp:post_type><![CDATA[post]]></wp:post_type>
+	</item>
+	<item>
+		<title>About</title>
+		<wp:post_id>13</wp:post_id>
+		<wp:status><![CDATA[publish]]></wp:status>
+		<wp:post_type><![CDATA[page]]></wp:post_type>
+	</item>
+</channel>
+</rss>`
+
+func TestParseWXR(t *testing.T) {
+	archive, err := ParseWXR(strings.NewReader(testWXR))
+	require.NoError(t, err)
+
+	assert.Equal(t, SourceWXR, archive.Source)
+	require.Len(t, archive.Posts, 2)
+	assert.Len(t, archive.Skipped, 1)
+
+	post := archive.Posts[0]
+	assert.Equal(t, "wxr:https://old.example.com/?p=10", post.SourceID)
+	assert.Equal(t, "Hello & Welcome", post.Title)
+	assert.Equal(t, "hello-welcome", post.Slug)
+	assert.Equal(t, "html", post.Format)
+	assert.Equal(t, "ur-PK", post.Locale)
+	assert.Equal(t, StatusPublished, post.Status)
+	assert.Equal(t, "A short summary", post.Excerpt)
+	assert.Equal(t, Author{Login: "jane", Email: "jane@example.com", DisplayName: "Jane Doe"}, post.Author)
+	assert.Equal(t, []string{"News"}, post.Categories)
+	assert.Equal(t, []string{"Go"}, post.Tags)
+	assert.Equal(t, "https://old.example.com/wp-content/uploads/2020/01/photo.jpg", post.FeaturedImage)
+	require.NotNil(t, post.PublishedAt)
+	assert.Equal(t, time.Date(2020, 1, 2, 3, 0, 0, 0, time.UTC), *post.PublishedAt)
+	assert.Contains(t, post.Content, "<p>First paragraph<br>\nsecond line</p>")
+	assert.Contains(t, post.Content, `<img src="https://old.example.com/wp-content/uploads/2020/01/photo.jpg" />`)
+
+	draft := archive.Posts[1]
+	assert.Equal(t, StatusDraft, draft.Status)
+	assert.Nil(t, draft.PublishedAt)
+	assert.Equal(t, Author{Login: "ghost"}, draft.Author)
+}
+
+func TestParseWXR_Invalid(t *testing.T) {
+	_, err := ParseWXR(strings.NewReader("not xml at all"))
+	assert.Error(t, err)
+}
+
+func TestAutop(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "empty", input: "", expected: ""},
+		{name: "plain paragraphs", input: "one\n\ntwo", expected: "<p>one</p>\n<p>two</p>"},
+		{name: "line break", input: "one\ntwo", expected: "<p>one<br>\ntwo</p>"},
+		{name: "existing block", input: "<h2>Title</h2>\n\ntext", expected: "<h2>Title</h2>\n<p>text</p>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, autop(tt.input))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Package jobs runs background maintenance tasks on a fixed interval inside
+// the API process.
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"go.uber.org/zap"
+)
+
+// Job is a task the scheduler runs periodically
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs jobs in the background until it is stopped. Each job runs
+// once when the scheduler starts and then every interval; a run that fails or
+// panics is logged and the job carries on.
+type Scheduler struct {
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler creates an empty scheduler
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Add registers a job. Jobs with no interval are disabled and ignored.
+func (s *Scheduler) Add(job Job) {
+	if job.Interval <= 0 {
+		utils.Info("Background job disabled", zap.String("job", job.Name))
+		return
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every registered job in its own goroutine
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+}
+
+// Stop cancels the running jobs and waits for them to return
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+// loop runs a job until the context is canceled
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		runJob(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runJob runs a job once, logging failures
+func runJob(ctx context.Context, job Job) {
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			utils.Error("Background job panicked", zap.String("job", job.Name), zap.String("panic", fmt.Sprint(r)))
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil {
+		utils.Error("Background job failed", zap.String("job", job.Name), zap.Error(err))
+		return
+	}
+	utils.Debug("Background job finished", zap.String("job", job.Name), zap.Duration("duration", time.Since(start)))
+}
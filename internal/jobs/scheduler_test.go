@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_RunsJobRepeatedly(t *testing.T) {
+	var runs atomic.Int32
+	scheduler := NewScheduler()
+	scheduler.Add(Job{
+		Name:     "count",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+
+	scheduler.Start()
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+	scheduler.Stop()
+
+	stopped := runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, stopped, runs.Load())
+}
+
+func TestScheduler_SurvivesErrorsAndPanics(t *testing.T) {
+	var runs atomic.Int32
+	scheduler := NewScheduler()
+	scheduler.Add(Job{
+		Name:     "flaky",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			if runs.Add(1) == 1 {
+				panic("boom")
+			}
+			return errors.New("failed")
+		},
+	})
+
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+}
+
+func TestScheduler_IgnoresDisabledJobs(t *testing.T) {
+	scheduler := NewScheduler()
+	scheduler.Add(Job{
+		Name: "disabled",
+		Run: func(ctx context.Context) error {
+			t.Error("disabled job ran")
+			return nil
+		},
+	})
+
+	scheduler.Start()
+	scheduler.Stop()
+
+	assert.Empty(t, scheduler.jobs)
+}
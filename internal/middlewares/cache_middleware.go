@@ -0,0 +1,106 @@
+package middlewares
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// bufferedWriter holds back a response so that its ETag can be computed
+// before anything is sent
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+// HTTPCacheMiddleware makes successful GET responses cacheable and
+// conditional. It gives them a strong ETag computed from the body, keeping
+// an ETag the handler set as its prefix, and answers a matching
+// If-None-Match with 304 Not Modified. Responses carry no Last-Modified,
+// since counts in them change without touching updated_at, so
+// If-Modified-Since is ignored. Anonymous responses may be kept by shared
+// caches for sharedMaxAge; responses to signed-in readers are private.
+// Request headers the output depends on besides Authorization, such as
+// Accept-Language, are passed as vary.
+func HTTPCacheMiddleware(sharedMaxAge time.Duration, vary ...string) gin.HandlerFunc {
+	publicCacheControl := "public, max-age=0, s-maxage=" + strconv.Itoa(int(sharedMaxAge.Seconds()))
+
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		writer := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = original
+
+		header := original.Header()
+		if writer.status != http.StatusOK {
+			original.WriteHeader(writer.status)
+			_, _ = original.Write(writer.body.Bytes())
+			return
+		}
+
+		// Readers who send a token may get their own like and bookmark state
+		// (and editors unpublished content), so only their browser may keep it
+		header.Add("Vary", "Authorization")
+		for _, name := range vary {
+			header.Add("Vary", name)
+		}
+		if c.GetHeader("Authorization") != "" {
+			header.Set("Cache-Control", "private, no-cache")
+		} else {
+			header.Set("Cache-Control", publicCacheControl)
+		}
+		header.Del("Pragma")
+		header.Del("Expires")
+
+		etag := utils.ContentETag(header.Get("ETag"), writer.body.Bytes())
+		header.Set("ETag", etag)
+
+		if utils.ETagMatches(c.GetHeader("If-None-Match"), etag) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			original.WriteHeader(http.StatusNotModified)
+			original.WriteHeaderNow()
+			return
+		}
+
+		original.WriteHeader(http.StatusOK)
+		_, _ = original.Write(writer.body.Bytes())
+	}
+}
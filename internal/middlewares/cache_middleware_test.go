@@ -0,0 +1,118 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const testModifiedSince = "Sun, 01 Mar 2026 12:00:00 GMT"
+
+func newCacheRouter(vary ...string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(HTTPCacheMiddleware(time.Minute, vary...))
+
+	router.GET("/article", func(c *gin.Context) {
+		c.Header("ETag", `"v3"`)
+		c.JSON(http.StatusOK, gin.H{"title": "Hello"})
+	})
+	router.GET("/missing", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+	})
+	router.POST("/article", func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"title": "Hello"})
+	})
+	return router
+}
+
+func serve(router *gin.Engine, method, path string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestHTTPCache_SetsETagAndPublicCaching(t *testing.T) {
+	w := serve(newCacheRouter(), http.MethodGet, "/article", nil)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"title":"Hello"}`, w.Body.String())
+	assert.True(t, strings.HasPrefix(w.Header().Get("ETag"), `"v3-`), "handler ETag kept as prefix")
+	assert.Equal(t, "public, max-age=0, s-maxage=60", w.Header().Get("Cache-Control"))
+	assert.Equal(t, []string{"Authorization"}, w.Header().Values("Vary"))
+}
+
+func TestHTTPCache_IfNoneMatch(t *testing.T) {
+	router := newCacheRouter()
+	etag := serve(router, http.MethodGet, "/article", nil).Header().Get("ETag")
+
+	matched := serve(router, http.MethodGet, "/article", map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, matched.Code)
+	assert.Empty(t, matched.Body.String())
+	assert.Equal(t, etag, matched.Header().Get("ETag"))
+
+	mismatched := serve(router, http.MethodGet, "/article", map[string]string{"If-None-Match": `"v2-0000000000000000"`})
+	assert.Equal(t, http.StatusOK, mismatched.Code)
+	assert.JSONEq(t, `{"title":"Hello"}`, mismatched.Body.String())
+}
+
+func TestHTTPCache_IgnoresIfModifiedSince(t *testing.T) {
+	router := newCacheRouter()
+	etag := serve(router, http.MethodGet, "/article", nil).Header().Get("ETag")
+
+	// Unmodified by date, but only the ETag is compared
+	w := serve(router, http.MethodGet, "/article", map[string]string{"If-Modified-Since": testModifiedSince})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serve(router, http.MethodGet, "/article", map[string]string{
+		"If-None-Match":     `"v2-0000000000000000"`,
+		"If-Modified-Since": testModifiedSince,
+	})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serve(router, http.MethodGet, "/article", map[string]string{
+		"If-None-Match":     etag,
+		"If-Modified-Since": "Sat, 01 Mar 2025 12:00:00 GMT",
+	})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+}
+
+func TestHTTPCache_NonOKPassesThrough(t *testing.T) {
+	w := serve(newCacheRouter(), http.MethodGet, "/missing", map[string]string{"If-None-Match": "*"})
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"error":"not found"}`, w.Body.String())
+	assert.Empty(t, w.Header().Get("ETag"))
+	assert.Empty(t, w.Header().Get("Cache-Control"))
+	assert.Empty(t, w.Header().Values("Vary"))
+}
+
+func TestHTTPCache_WritesPassThrough(t *testing.T) {
+	w := serve(newCacheRouter(), http.MethodPost, "/article", nil)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Empty(t, w.Header().Get("ETag"))
+}
+
+func TestHTTPCache_AuthenticatedResponsesArePrivate(t *testing.T) {
+	w := serve(newCacheRouter(), http.MethodGet, "/article", map[string]string{"Authorization": "Bearer token"})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+	assert.Contains(t, w.Header().Values("Vary"), "Authorization")
+}
+
+func TestHTTPCache_VariesOnExtraHeaders(t *testing.T) {
+	w := serve(newCacheRouter("Accept-Language"), http.MethodGet, "/article", nil)
+
+	assert.Equal(t, []string{"Authorization", "Accept-Language"}, w.Header().Values("Vary"))
+}
@@ -25,8 +25,8 @@ func DefaultCORSConfig() CORSConfig {
 	return CORSConfig{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "X-Request-ID"},
-		ExposedHeaders:   []string{"Content-Length", "Content-Type", "X-Request-ID"},
+		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "X-Request-ID", "If-Match"},
+		ExposedHeaders:   []string{"Content-Length", "Content-Type", "X-Request-ID", "ETag"},
 		AllowCredentials: true,
 		MaxAge:           86400, // 24 hours
 	}
@@ -37,8 +37,8 @@ func ProductionCORSConfig(allowedOrigins []string) CORSConfig {
 	return CORSConfig{
 		AllowedOrigins:   allowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "X-Request-ID"},
-		ExposedHeaders:   []string{"Content-Length", "Content-Type", "X-Request-ID"},
+		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "X-Request-ID", "If-Match"},
+		ExposedHeaders:   []string{"Content-Length", "Content-Type", "X-Request-ID", "ETag"},
 		AllowCredentials: true,
 		MaxAge:           43200, // 12 hours
 	}
@@ -122,8 +122,8 @@ func DevelopmentCORS() gin.HandlerFunc {
 
 		c.Header("Access-Control-Allow-Origin", origin)
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, X-Requested-With, X-Request-ID")
-		c.Header("Access-Control-Expose-Headers", "Content-Length, Content-Type, X-Request-ID")
+		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, X-Requested-With, X-Request-ID, If-Match")
+		c.Header("Access-Control-Expose-Headers", "Content-Length, Content-Type, X-Request-ID, ETag")
 		c.Header("Access-Control-Allow-Credentials", "true")
 		c.Header("Access-Control-Max-Age", "86400")
 
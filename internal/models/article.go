@@ -25,32 +25,109 @@ func (s ArticleStatus) IsValid() bool {
 	return false
 }
 
+// ContentFormat represents the markup language an article is authored in
+type ContentFormat string
+
+const (
+	ContentFormatMarkdown ContentFormat = "markdown"
+	ContentFormatHTML     ContentFormat = "html"
+)
+
+// IsValid checks if the content format is valid
+func (f ContentFormat) IsValid() bool {
+	switch f {
+	case ContentFormatMarkdown, ContentFormatHTML:
+		return true
+	}
+	return false
+}
+
+// ArticleVisibility controls who may find and read a published article
+type ArticleVisibility string
+
+const (
+	VisibilityPublic    ArticleVisibility = "public"    // Listed and readable by everyone
+	VisibilityUnlisted  ArticleVisibility = "unlisted"  // Readable by anyone with the link, never listed
+	VisibilityFollowers ArticleVisibility = "followers" // Readable by the author's followers
+	VisibilityMembers   ArticleVisibility = "members"   // Readable by signed-in users; others get a preview
+)
+
+// IsValid checks if the visibility is valid
+func (v ArticleVisibility) IsValid() bool {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityFollowers, VisibilityMembers:
+		return true
+	}
+	return false
+}
+
+// CommentAudience limits who may comment on an article
+type CommentAudience string
+
+const (
+	CommentAudienceEveryone  CommentAudience = "everyone"
+	CommentAudienceFollowers CommentAudience = "followers" // The author's followers
+	CommentAudienceVerified  CommentAudience = "verified"  // Users with a verified email address
+)
+
+// IsValid checks if the comment audience is valid
+func (a CommentAudience) IsValid() bool {
+	switch a {
+	case CommentAudienceEveryone, CommentAudienceFollowers, CommentAudienceVerified:
+		return true
+	}
+	return false
+}
+
+// CommentState is whether an article takes new comments
+type CommentState string
+
+const (
+	CommentStateOpen     CommentState = "open"
+	CommentStateDisabled CommentState = "disabled" // Comments are turned off
+	CommentStateLocked   CommentState = "locked"   // Existing comments are read-only
+	CommentStateClosed   CommentState = "closed"   // Closed automatically after CommentsCloseAfterDays
+)
+
 // Article represents a blog article/post
 type Article struct {
-	ID                 uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	Title              string         `gorm:"type:varchar(255);not null;index" json:"title"`
-	Slug               string         `gorm:"type:varchar(255);uniqueIndex;not null" json:"slug"`
-	Excerpt            string         `gorm:"type:text" json:"excerpt"`
-	Content            string         `gorm:"type:text;not null" json:"content"`
-	FeaturedImageURL   *string        `gorm:"type:varchar(500)" json:"featured_image_url"`
-	AuthorID           uuid.UUID      `gorm:"type:uuid;not null;index" json:"author_id"`
-	Status             ArticleStatus  `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
-	PublishedAt        *time.Time     `gorm:"index" json:"published_at"`
-	ViewCount          int            `gorm:"default:0" json:"view_count"`
-	ReadingTimeMinutes int            `gorm:"default:1" json:"reading_time_minutes"`
-	IsStaffPick        bool           `gorm:"default:false;index" json:"is_staff_pick"`
-	MetaTitle          string         `gorm:"type:varchar(70)" json:"meta_title"`
-	MetaDescription    string         `gorm:"type:varchar(160)" json:"meta_description"`
-	MetaKeywords       string         `gorm:"type:varchar(255)" json:"meta_keywords"`
-	CreatedAt          time.Time      `json:"created_at"`
-	UpdatedAt          time.Time      `json:"updated_at"`
-	DeletedAt          gorm.DeletedAt `gorm:"index" json:"-"`
+	ID                     uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Title                  string            `gorm:"type:varchar(255);not null;index" json:"title"`
+	Slug                   string            `gorm:"type:varchar(255);uniqueIndex;not null" json:"slug"`
+	Excerpt                string            `gorm:"type:text" json:"excerpt"`
+	Content                string            `gorm:"type:text;not null" json:"content"`
+	ContentFormat          ContentFormat     `gorm:"type:varchar(20);not null;default:'html'" json:"content_format"`
+	ContentHTML            string            `gorm:"type:text" json:"content_html"`
+	TableOfContents        string            `gorm:"type:text" json:"-"` // JSON-encoded heading list
+	FeaturedImageURL       *string           `gorm:"type:varchar(500)" json:"featured_image_url"`
+	AuthorID               uuid.UUID         `gorm:"type:uuid;not null;index" json:"author_id"`
+	Status                 ArticleStatus     `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
+	Visibility             ArticleVisibility `gorm:"type:varchar(20);not null;default:'public';index" json:"visibility"`
+	PublishedAt            *time.Time        `gorm:"index" json:"published_at"`
+	ViewCount              int               `gorm:"default:0" json:"view_count"`
+	ReadCount              int               `gorm:"default:0" json:"read_count"` // Readers who finished the article
+	ReadingTimeMinutes     int               `gorm:"default:1" json:"reading_time_minutes"`
+	IsStaffPick            bool              `gorm:"default:false;index" json:"is_staff_pick"`
+	CommentsDisabled       bool              `gorm:"default:false" json:"comments_disabled"`
+	CommentsLocked         bool              `gorm:"default:false" json:"comments_locked"`
+	CommentsCloseAfterDays *int              `json:"comments_close_after_days"` // Days after publication; nil never closes
+	CommentAudience        CommentAudience   `gorm:"type:varchar(20);not null;default:'everyone'" json:"comment_audience"`
+	MetaTitle              string            `gorm:"type:varchar(70)" json:"meta_title"`
+	MetaDescription        string            `gorm:"type:varchar(160)" json:"meta_description"`
+	MetaKeywords           string            `gorm:"type:varchar(255)" json:"meta_keywords"`
+	Version                int               `gorm:"not null;default:1" json:"version"`                          // Incremented on every update (optimistic locking)
+	Locale                 string            `gorm:"type:varchar(35);not null;default:'en';index" json:"locale"` // BCP 47 language tag
+	TranslationGroupID     uuid.UUID         `gorm:"type:uuid;not null;index" json:"translation_group_id"`       // Shared by all translations of an article
+	CreatedAt              time.Time         `json:"created_at"`
+	UpdatedAt              time.Time         `json:"updated_at"`
+	DeletedAt              gorm.DeletedAt    `gorm:"index" json:"-"`
 
 	// Relationships
 	Author     *User      `gorm:"foreignKey:AuthorID" json:"author,omitempty"`
 	Categories []Category `gorm:"many2many:article_categories;" json:"categories,omitempty"`
 	Tags       []Tag      `gorm:"many2many:article_tags;" json:"tags,omitempty"`
 	Comments   []Comment  `gorm:"foreignKey:ArticleID" json:"comments,omitempty"`
+	StaffPick  *StaffPick `gorm:"foreignKey:ArticleID" json:"staff_pick,omitempty"`
 }
 
 // TableName returns the table name for the Article model
@@ -63,6 +140,18 @@ func (a *Article) BeforeCreate(tx *gorm.DB) error {
 	if a.ID == uuid.Nil {
 		a.ID = uuid.New()
 	}
+	if a.Version == 0 {
+		a.Version = 1
+	}
+	if a.TranslationGroupID == uuid.Nil {
+		a.TranslationGroupID = a.ID
+	}
+	if a.Visibility == "" {
+		a.Visibility = VisibilityPublic
+	}
+	if a.CommentAudience == "" {
+		a.CommentAudience = CommentAudienceEveryone
+	}
 	return nil
 }
 
@@ -89,6 +178,39 @@ func (a *Article) IsPublished() bool {
 	return a.Status == StatusPublished && a.PublishedAt != nil
 }
 
+// IsScheduled checks if the article is published with a publication date still in the future
+func (a *Article) IsScheduled() bool {
+	return a.Status == StatusPublished && a.PublishedAt != nil && a.PublishedAt.After(time.Now())
+}
+
+// IsPubliclyVisible checks if anyone may read the article by its slug
+func (a *Article) IsPubliclyVisible() bool {
+	return a.Status == StatusPublished && !a.IsScheduled()
+}
+
+// CommentsClosesAt returns when the article stops taking comments automatically, if ever
+func (a *Article) CommentsClosesAt() *time.Time {
+	if a.CommentsCloseAfterDays == nil || a.PublishedAt == nil {
+		return nil
+	}
+	closesAt := a.PublishedAt.AddDate(0, 0, *a.CommentsCloseAfterDays)
+	return &closesAt
+}
+
+// CommentState reports whether the article takes new comments at the given time
+func (a *Article) CommentState(now time.Time) CommentState {
+	switch {
+	case a.CommentsDisabled:
+		return CommentStateDisabled
+	case a.CommentsLocked:
+		return CommentStateLocked
+	}
+	if closesAt := a.CommentsClosesAt(); closesAt != nil && !now.Before(*closesAt) {
+		return CommentStateClosed
+	}
+	return CommentStateOpen
+}
+
 // IsDraft checks if the article is a draft
 func (a *Article) IsDraft() bool {
 	return a.Status == StatusDraft
@@ -163,3 +285,51 @@ func (a *Article) HasTag(tagID uuid.UUID) bool {
 func (a *Article) SetStaffPick(isStaffPick bool) {
 	a.IsStaffPick = isStaffPick
 }
+
+// ArticleEditLock represents an advisory lock telling others that a user is editing an article
+type ArticleEditLock struct {
+	ArticleID uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`
+	ExpiresAt time.Time `gorm:"not null" json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Relationships
+	User *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// TableName returns the table name for the ArticleEditLock model
+func (ArticleEditLock) TableName() string {
+	return "article_edit_locks"
+}
+
+// IsExpired checks if the lock has lapsed
+func (l *ArticleEditLock) IsExpired() bool {
+	return time.Now().After(l.ExpiresAt)
+}
+
+// StaffPick holds the curation details of an article featured by editors.
+// Articles without a start or end date are featured indefinitely.
+type StaffPick struct {
+	ArticleID uuid.UUID  `gorm:"type:uuid;primaryKey" json:"article_id"`
+	CuratorID *uuid.UUID `gorm:"type:uuid" json:"curator_id"`
+	Note      string     `gorm:"type:varchar(500)" json:"note"`
+	Position  *int       `json:"position"` // Lower positions are shown first; unordered picks come last
+	StartsAt  *time.Time `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+
+	// Relationships
+	Curator *User `gorm:"foreignKey:CuratorID" json:"curator,omitempty"`
+}
+
+// TableName returns the table name for the StaffPick model
+func (StaffPick) TableName() string {
+	return "staff_picks"
+}
+
+// IsActive checks if the pick is within its display window at the given time
+func (p *StaffPick) IsActive(now time.Time) bool {
+	return (p.StartsAt == nil || !p.StartsAt.After(now)) && (p.EndsAt == nil || p.EndsAt.After(now))
+}
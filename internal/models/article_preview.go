@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ArticlePreview represents a signed, expiring link that lets anyone read an unpublished article.
+// The signed token carries the preview ID, so revoking the row invalidates the link.
+type ArticlePreview struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ArticleID uuid.UUID  `gorm:"type:uuid;not null;index" json:"article_id"`
+	CreatedBy uuid.UUID  `gorm:"type:uuid;not null" json:"created_by"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+
+	// Relationships
+	Article *Article `gorm:"foreignKey:ArticleID" json:"article,omitempty"`
+}
+
+// TableName returns the table name for the ArticlePreview model
+func (ArticlePreview) TableName() string {
+	return "article_previews"
+}
+
+// BeforeCreate is a GORM hook that runs before creating a preview link
+func (p *ArticlePreview) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive checks if the preview link can still be used
+func (p *ArticlePreview) IsActive() bool {
+	return p.RevokedAt == nil && time.Now().Before(p.ExpiresAt)
+}
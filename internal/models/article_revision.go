@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RevisionStatus represents the review state of an article revision
+type RevisionStatus string
+
+const (
+	RevisionPending   RevisionStatus = "pending"
+	RevisionPublished RevisionStatus = "published"
+	RevisionDiscarded RevisionStatus = "discarded"
+)
+
+// ArticleRevision represents a working copy of edits to a published article.
+// The live article stays untouched until an editor publishes the changes.
+type ArticleRevision struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ArticleID   uuid.UUID      `gorm:"type:uuid;not null;index" json:"article_id"`
+	EditorID    uuid.UUID      `gorm:"type:uuid;not null" json:"editor_id"` // Last user to save the working copy
+	Changes     string         `gorm:"type:text;not null" json:"-"`         // JSON-encoded field changes
+	BaseVersion int            `gorm:"not null" json:"base_version"`        // Article version the edits apply to
+	Status      RevisionStatus `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	ReviewedBy  *uuid.UUID     `gorm:"type:uuid" json:"reviewed_by,omitempty"`
+	ReviewedAt  *time.Time     `json:"reviewed_at,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+
+	// Relationships
+	Article *Article `gorm:"foreignKey:ArticleID" json:"article,omitempty"`
+	Editor  *User    `gorm:"foreignKey:EditorID" json:"editor,omitempty"`
+}
+
+// TableName returns the table name for the ArticleRevision model
+func (ArticleRevision) TableName() string {
+	return "article_revisions"
+}
+
+// BeforeCreate is a GORM hook that runs before creating a revision
+func (r *ArticleRevision) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsPending checks if the revision is still awaiting publication
+func (r *ArticleRevision) IsPending() bool {
+	return r.Status == RevisionPending
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArticleVisit records one visitor's views of an article on one day (UTC).
+// VisitorKey is a salted hash, so visitors can't be identified or followed
+// from one day to the next.
+type ArticleVisit struct {
+	ArticleID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	VisitorKey   string    `gorm:"type:varchar(64);primaryKey" json:"-"`
+	Day          time.Time `gorm:"type:date;primaryKey" json:"day"`
+	Views        int       `gorm:"not null;default:1" json:"views"`
+	LastViewedAt time.Time `gorm:"not null" json:"last_viewed_at"` // When the last counted view happened
+}
+
+// TableName returns the table name for the ArticleVisit model
+func (ArticleVisit) TableName() string {
+	return "article_visits"
+}
+
+// ArticleDailyStat holds an article's totals for one day (UTC)
+type ArticleDailyStat struct {
+	ArticleID      uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	Day            time.Time `gorm:"type:date;primaryKey" json:"day"`
+	Views          int       `gorm:"not null;default:0" json:"views"`
+	UniqueVisitors int       `gorm:"not null;default:0" json:"unique_visitors"`
+	Reads          int       `gorm:"not null;default:0" json:"reads"`
+	Likes          int       `gorm:"not null;default:0" json:"likes"`
+	Comments       int       `gorm:"not null;default:0" json:"comments"`
+	Bookmarks      int       `gorm:"not null;default:0" json:"bookmarks"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for the ArticleDailyStat model
+func (ArticleDailyStat) TableName() string {
+	return "article_daily_stats"
+}
+
+// ArticleReferrer counts an article's views from another site on one day (UTC).
+// Source is the referring host name.
+type ArticleReferrer struct {
+	ArticleID uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	Day       time.Time `gorm:"type:date;primaryKey" json:"day"`
+	Source    string    `gorm:"type:varchar(255);primaryKey" json:"source"`
+	Views     int       `gorm:"not null;default:0" json:"views"`
+}
+
+// TableName returns the table name for the ArticleReferrer model
+func (ArticleReferrer) TableName() string {
+	return "article_referrers"
+}
+
+// StatsDay returns the day (midnight UTC) that statistics for t are kept under
+func StatsDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
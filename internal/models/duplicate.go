@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DuplicateStatus represents the review state of a duplicate flag
+type DuplicateStatus string
+
+const (
+	DuplicateStatusPending   DuplicateStatus = "pending"
+	DuplicateStatusDismissed DuplicateStatus = "dismissed"
+	DuplicateStatusConfirmed DuplicateStatus = "confirmed"
+)
+
+// ArticleFingerprint stores the MinHash signature of an article's text
+type ArticleFingerprint struct {
+	ArticleID uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	Signature []byte    `gorm:"not null" json:"-"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for the ArticleFingerprint model
+func (ArticleFingerprint) TableName() string {
+	return "article_fingerprints"
+}
+
+// FingerprintBand is one locality-sensitive hash bucket of an article's signature.
+// Articles sharing any (band, hash) pair are candidates for a full comparison.
+type FingerprintBand struct {
+	ArticleID uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	Band      int       `gorm:"primaryKey" json:"band"`
+	Hash      int64     `gorm:"not null;index" json:"hash"`
+}
+
+// TableName returns the table name for the FingerprintBand model
+func (FingerprintBand) TableName() string {
+	return "fingerprint_bands"
+}
+
+// DuplicateFlag marks an article whose text largely repeats an earlier article.
+// ArticleID is always the newer of the two; Overlap is the share of its text
+// found in the matched article and Passages holds the shared runs as JSON.
+type DuplicateFlag struct {
+	ID               uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ArticleID        uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_duplicate_pair" json:"article_id"`
+	MatchedArticleID uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_duplicate_pair" json:"matched_article_id"`
+	Similarity       float64         `gorm:"not null" json:"similarity"`
+	Overlap          float64         `gorm:"not null" json:"overlap"`
+	Passages         string          `gorm:"type:text;default:'[]'" json:"-"`
+	Status           DuplicateStatus `gorm:"type:varchar(20);default:'pending';index" json:"status"`
+	ReviewedBy       *uuid.UUID      `gorm:"type:uuid" json:"reviewed_by,omitempty"`
+	ReviewedAt       *time.Time      `json:"reviewed_at,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+
+	// Relationships
+	Article        *Article `gorm:"foreignKey:ArticleID" json:"article,omitempty"`
+	MatchedArticle *Article `gorm:"foreignKey:MatchedArticleID" json:"matched_article,omitempty"`
+	Reviewer       *User    `gorm:"foreignKey:ReviewedBy" json:"reviewer,omitempty"`
+}
+
+// TableName returns the table name for the DuplicateFlag model
+func (DuplicateFlag) TableName() string {
+	return "duplicate_flags"
+}
+
+// BeforeCreate is a GORM hook that runs before creating a duplicate flag
+func (d *DuplicateFlag) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
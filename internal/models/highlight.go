@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Highlight represents a passage of an article marked by a reader.
+// The passage is anchored by its quoted text plus a little of the text around it,
+// so clients can still place it after small edits to the article.
+type Highlight struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+	ArticleID uuid.UUID `gorm:"type:uuid;not null;index" json:"article_id"`
+	Quote     string    `gorm:"type:text;not null" json:"quote"`
+	Prefix    string    `gorm:"type:varchar(100);default:''" json:"prefix"`
+	Suffix    string    `gorm:"type:varchar(100);default:''" json:"suffix"`
+	Note      string    `gorm:"type:text;default:''" json:"note"`
+	IsPublic  bool      `gorm:"default:false;index" json:"is_public"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Relationships
+	User    *User    `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Article *Article `gorm:"foreignKey:ArticleID" json:"article,omitempty"`
+}
+
+// TableName returns the table name for the Highlight model
+func (Highlight) TableName() string {
+	return "highlights"
+}
+
+// BeforeCreate is a GORM hook that runs before creating a highlight
+func (h *Highlight) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ImportEntityType identifies the kind of resource created by an import
+type ImportEntityType string
+
+const (
+	ImportEntityArticle ImportEntityType = "article"
+	ImportEntityMedia   ImportEntityType = "media"
+)
+
+// ImportRecord links an item of an imported archive to the resource created
+// from it, so that re-running an import does not create duplicates
+type ImportRecord struct {
+	ID         uuid.UUID        `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EntityType ImportEntityType `gorm:"type:varchar(20);not null;uniqueIndex:idx_import_records_source" json:"entity_type"`
+	SourceKey  string           `gorm:"type:varchar(512);not null;uniqueIndex:idx_import_records_source" json:"source_key"`
+	EntityID   uuid.UUID        `gorm:"type:uuid;not null;index" json:"entity_id"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+// TableName returns the table name for the ImportRecord model
+func (ImportRecord) TableName() string {
+	return "import_records"
+}
+
+// BeforeCreate is a GORM hook that runs before creating an import record
+func (r *ImportRecord) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
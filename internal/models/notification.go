@@ -11,10 +11,11 @@ import (
 type NotificationType string
 
 const (
-	NotificationTypeLike    NotificationType = "like"
-	NotificationTypeComment NotificationType = "comment"
-	NotificationTypeFollow  NotificationType = "follow"
-	NotificationTypeArticle NotificationType = "article"
+	NotificationTypeLike      NotificationType = "like"
+	NotificationTypeComment   NotificationType = "comment"
+	NotificationTypeFollow    NotificationType = "follow"
+	NotificationTypeArticle   NotificationType = "article"
+	NotificationTypeHighlight NotificationType = "highlight"
 )
 
 // Notification represents a notification to a user
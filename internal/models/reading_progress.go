@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ReadingProgress records how far a user has read an article.
+// Progress is the last reported scroll position, used to resume reading;
+// MaxProgress is the furthest the user has scrolled.
+type ReadingProgress struct {
+	ID               uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID           uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_reading_progress_user_article" json:"user_id"`
+	ArticleID        uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_reading_progress_user_article" json:"article_id"`
+	Progress         int        `gorm:"not null;default:0" json:"progress"`
+	MaxProgress      int        `gorm:"not null;default:0" json:"max_progress"`
+	TimeSpentSeconds int        `gorm:"not null;default:0" json:"time_spent_seconds"`
+	CompletedAt      *time.Time `json:"completed_at"` // When the article was first read in full
+	LastReadAt       time.Time  `gorm:"not null;index" json:"last_read_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+
+	// Relationships
+	User    *User    `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Article *Article `gorm:"foreignKey:ArticleID" json:"article,omitempty"`
+}
+
+// TableName returns the table name for the ReadingProgress model
+func (ReadingProgress) TableName() string {
+	return "reading_progress"
+}
+
+// ArticleRead records that a user's read of an article was counted in its
+// read count. Unlike reading progress it survives the user clearing their
+// history, so each reader is only counted once.
+type ArticleRead struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey" json:"user_id"`
+	ArticleID uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"` // When the read was counted
+}
+
+// TableName returns the table name for the ArticleRead model
+func (ArticleRead) TableName() string {
+	return "article_reads"
+}
+
+// BeforeCreate is a GORM hook that runs before creating a reading progress entry
+func (p *ReadingProgress) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RelatedArticle is a precomputed suggestion of one article for readers of another.
+// Rows are rebuilt by a background job; Rank 1 is the best match.
+type RelatedArticle struct {
+	ArticleID  uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	RelatedID  uuid.UUID `gorm:"type:uuid;primaryKey" json:"related_id"`
+	Rank       int       `gorm:"not null" json:"rank"`
+	Score      float64   `gorm:"not null" json:"score"`
+	ComputedAt time.Time `gorm:"not null" json:"computed_at"`
+}
+
+// TableName returns the table name for the RelatedArticle model
+func (RelatedArticle) TableName() string {
+	return "related_articles"
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Series represents an author-owned, ordered collection of articles
+type Series struct {
+	ID            uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Title         string         `gorm:"type:varchar(255);not null" json:"title"`
+	Slug          string         `gorm:"type:varchar(255);uniqueIndex;not null" json:"slug"`
+	Description   string         `gorm:"type:text" json:"description"`
+	CoverImageURL *string        `gorm:"type:varchar(500)" json:"cover_image_url"`
+	AuthorID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"author_id"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// Relationships
+	Author *User           `gorm:"foreignKey:AuthorID" json:"author,omitempty"`
+	Parts  []SeriesArticle `gorm:"foreignKey:SeriesID" json:"parts,omitempty"`
+}
+
+// TableName returns the table name for the Series model
+func (Series) TableName() string {
+	return "series"
+}
+
+// BeforeCreate is a GORM hook that runs before creating a series
+func (s *Series) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// SeriesArticle represents an article's position within a series
+// An article can belong to at most one series
+type SeriesArticle struct {
+	SeriesID  uuid.UUID `gorm:"type:uuid;primaryKey" json:"series_id"`
+	ArticleID uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	Position  int       `gorm:"not null" json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Series  *Series  `gorm:"foreignKey:SeriesID" json:"series,omitempty"`
+	Article *Article `gorm:"foreignKey:ArticleID" json:"article,omitempty"`
+}
+
+// TableName returns the table name for the SeriesArticle model
+func (SeriesArticle) TableName() string {
+	return "series_articles"
+}
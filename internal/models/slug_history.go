@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SlugEntityType identifies the kind of resource a historical slug belonged to
+type SlugEntityType string
+
+const (
+	SlugEntityArticle  SlugEntityType = "article"
+	SlugEntityCategory SlugEntityType = "category"
+	SlugEntityTag      SlugEntityType = "tag"
+)
+
+// SlugHistory represents a slug a resource used before it was renamed
+type SlugHistory struct {
+	ID         uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EntityType SlugEntityType `gorm:"type:varchar(20);not null;uniqueIndex:idx_slug_history_entity_slug" json:"entity_type"`
+	EntityID   uuid.UUID      `gorm:"type:uuid;not null;index" json:"entity_id"`
+	OldSlug    string         `gorm:"type:varchar(255);not null;uniqueIndex:idx_slug_history_entity_slug" json:"old_slug"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// TableName returns the table name for the SlugHistory model
+func (SlugHistory) TableName() string {
+	return "slug_history"
+}
+
+// BeforeCreate is a GORM hook that runs before creating a slug history entry
+func (s *SlugHistory) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
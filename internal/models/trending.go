@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrendingScore is an article's precomputed trending score over one window (24h, 7d or 30d)
+type TrendingScore struct {
+	ArticleID  uuid.UUID `gorm:"type:uuid;primaryKey" json:"article_id"`
+	Window     string    `gorm:"column:period;type:varchar(8);primaryKey" json:"window"`
+	Score      float64   `gorm:"not null" json:"score"`
+	ComputedAt time.Time `gorm:"not null" json:"computed_at"`
+}
+
+// TableName returns the table name for the TrendingScore model
+func (TrendingScore) TableName() string {
+	return "article_trending"
+}
@@ -0,0 +1,267 @@
+// Package related scores how closely published articles relate to each other.
+// Relevance combines shared tags and categories with the TF-IDF similarity of
+// titles and content. Recency and popularity are then blended in, so that among
+// similarly relevant articles, recent and widely read ones come first.
+package related
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// Weights of the relevance signals; they add up to 1
+const (
+	tagWeight      = 0.35
+	categoryWeight = 0.15
+	textWeight     = 0.5
+)
+
+// Weights of the final score; they add up to 1
+const (
+	relevanceWeight  = 0.8
+	recencyWeight    = 0.1
+	popularityWeight = 0.1
+)
+
+const (
+	minRelevance    = 0.05                // Less relevant candidates are not suggested at all
+	titleBoost      = 3                   // A word in the title counts as much as this many in the content
+	maxContentTerms = 2000                // Only the start of long articles is read
+	maxVectorTerms  = 100                 // Each article keeps its strongest terms only
+	likeWeight      = 5                   // A like counts as much as this many views
+	recencyHalfLife = 90 * 24 * time.Hour // The recency bonus halves every 90 days
+)
+
+// stopwords are common English words that say nothing about a topic
+var stopwords = map[string]bool{
+	"an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "but": true, "by": true,
+	"can": true, "for": true, "from": true, "has": true, "have": true, "he": true, "her": true, "his": true,
+	"how": true, "in": true, "is": true, "it": true, "its": true, "not": true, "of": true, "on": true,
+	"or": true, "our": true, "she": true, "so": true, "that": true, "the": true, "their": true, "them": true,
+	"there": true, "they": true, "this": true, "to": true, "was": true, "we": true, "were": true, "what": true,
+	"when": true, "which": true, "who": true, "will": true, "with": true, "you": true, "your": true,
+}
+
+// Document is an article as seen by the ranker
+type Document struct {
+	ID          uuid.UUID
+	Language    string // Articles are only related to articles in the same language
+	Title       string
+	Text        string // Plain text of the content
+	TagIDs      []uuid.UUID
+	CategoryIDs []uuid.UUID
+	PublishedAt time.Time
+	Views       int
+	Likes       int
+	Listed      bool // Whether the article may be suggested to readers
+}
+
+// Match is a related article and its score
+type Match struct {
+	ID    uuid.UUID
+	Score float64
+}
+
+// vector is a sparse, unit-length term vector
+type vector map[string]float64
+
+// Rank returns up to limit related articles for every document, best first.
+// Only listed documents are suggested, but every document gets suggestions.
+// Documents with nothing related are left out of the result.
+func Rank(docs []Document, limit int, now time.Time) map[uuid.UUID][]Match {
+	groups := make(map[string][]int)
+	for i, doc := range docs {
+		groups[doc.Language] = append(groups[doc.Language], i)
+	}
+
+	maxPopularity := 0.0
+	for _, doc := range docs {
+		maxPopularity = math.Max(maxPopularity, rawPopularity(doc))
+	}
+
+	result := make(map[uuid.UUID][]Match)
+	for _, group := range groups {
+		vectors := termVectors(docs, group)
+
+		for _, i := range group {
+			var matches []Match
+			for _, j := range group {
+				if i == j || !docs[j].Listed {
+					continue
+				}
+
+				relevance := tagWeight*overlap(docs[i].TagIDs, docs[j].TagIDs) +
+					categoryWeight*overlap(docs[i].CategoryIDs, docs[j].CategoryIDs) +
+					textWeight*cosine(vectors[i], vectors[j])
+				if relevance < minRelevance {
+					continue
+				}
+
+				score := relevanceWeight*relevance +
+					recencyWeight*recency(docs[j].PublishedAt, now) +
+					popularityWeight*popularity(docs[j], maxPopularity)
+				matches = append(matches, Match{ID: docs[j].ID, Score: score})
+			}
+
+			if len(matches) == 0 {
+				continue
+			}
+			sort.Slice(matches, func(a, b int) bool {
+				if matches[a].Score != matches[b].Score {
+					return matches[a].Score > matches[b].Score
+				}
+				return matches[a].ID.String() < matches[b].ID.String()
+			})
+			if len(matches) > limit {
+				matches = matches[:limit]
+			}
+			result[docs[i].ID] = matches
+		}
+	}
+
+	return result
+}
+
+// termVectors builds the TF-IDF vector of each document in a group, keyed by document index
+func termVectors(docs []Document, group []int) map[int]vector {
+	counts := make(map[int]map[string]int, len(group))
+	documentFrequency := make(map[string]int)
+	for _, i := range group {
+		tf := make(map[string]int)
+		for _, term := range tokenize(docs[i].Title, 0) {
+			tf[term] += titleBoost
+		}
+		for _, term := range tokenize(docs[i].Text, maxContentTerms) {
+			tf[term]++
+		}
+		for term := range tf {
+			documentFrequency[term]++
+		}
+		counts[i] = tf
+	}
+
+	n := float64(len(group))
+	vectors := make(map[int]vector, len(group))
+	for i, tf := range counts {
+		v := make(vector, len(tf))
+		for term, count := range tf {
+			idf := math.Log((1+n)/(1+float64(documentFrequency[term]))) + 1
+			v[term] = (1 + math.Log(float64(count))) * idf
+		}
+		vectors[i] = normalize(strongest(v, maxVectorTerms))
+	}
+	return vectors
+}
+
+// tokenize splits text into lowercase words, skipping stopwords and single
+// characters. A positive limit stops after that many words.
+func tokenize(text string, limit int) []string {
+	var terms []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		if len([]rune(word)) < 2 || stopwords[word] {
+			continue
+		}
+		terms = append(terms, word)
+		if limit > 0 && len(terms) == limit {
+			break
+		}
+	}
+	return terms
+}
+
+// strongest keeps the n highest-weighted terms of a vector
+func strongest(v vector, n int) vector {
+	if len(v) <= n {
+		return v
+	}
+	terms := make([]string, 0, len(v))
+	for term := range v {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(a, b int) bool {
+		if v[terms[a]] != v[terms[b]] {
+			return v[terms[a]] > v[terms[b]]
+		}
+		return terms[a] < terms[b]
+	})
+	kept := make(vector, n)
+	for _, term := range terms[:n] {
+		kept[term] = v[term]
+	}
+	return kept
+}
+
+// normalize scales a vector to unit length
+func normalize(v vector) vector {
+	var sum float64
+	for _, w := range v {
+		sum += w * w
+	}
+	if sum == 0 {
+		return v
+	}
+	norm := math.Sqrt(sum)
+	for term := range v {
+		v[term] /= norm
+	}
+	return v
+}
+
+// cosine returns the cosine similarity of two unit vectors
+func cosine(a, b vector) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	var dot float64
+	for term, w := range a {
+		dot += w * b[term]
+	}
+	return dot
+}
+
+// overlap returns the cosine similarity of two sets of IDs
+func overlap(a, b []uuid.UUID) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[uuid.UUID]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+	shared := 0
+	for _, id := range b {
+		if set[id] {
+			shared++
+		}
+	}
+	return float64(shared) / math.Sqrt(float64(len(a)*len(b)))
+}
+
+// recency is 1 for an article published now and halves every recencyHalfLife
+func recency(publishedAt, now time.Time) float64 {
+	age := now.Sub(publishedAt)
+	if age <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, float64(age)/float64(recencyHalfLife))
+}
+
+// rawPopularity combines views and likes on a log scale
+func rawPopularity(doc Document) float64 {
+	return math.Log1p(float64(doc.Views + likeWeight*doc.Likes))
+}
+
+// popularity scales an article's popularity to [0, 1] against the most popular article
+func popularity(doc Document, highest float64) float64 {
+	if highest == 0 {
+		return 0
+	}
+	return rawPopularity(doc) / highest
+}
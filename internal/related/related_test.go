@@ -0,0 +1,88 @@
+package related
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func doc(title, text string, tags ...uuid.UUID) Document {
+	return Document{
+		ID:          uuid.New(),
+		Language:    "en",
+		Title:       title,
+		Text:        text,
+		TagIDs:      tags,
+		PublishedAt: now.AddDate(0, 0, -7),
+		Listed:      true,
+	}
+}
+
+func ids(matches []Match) []uuid.UUID {
+	result := make([]uuid.UUID, len(matches))
+	for i, m := range matches {
+		result[i] = m.ID
+	}
+	return result
+}
+
+func TestRank_PrefersSharedTagsAndText(t *testing.T) {
+	golang := uuid.New()
+	source := doc("Concurrency in Go", "Goroutines and channels make concurrent programs simple.", golang)
+	sameTagAndText := doc("Go channels explained", "Channels connect goroutines in concurrent programs.", golang)
+	sameText := doc("Channels everywhere", "Goroutines talk over channels.")
+	unrelated := doc("Baking bread", "Flour, water, salt and yeast.")
+
+	result := Rank([]Document{source, sameTagAndText, sameText, unrelated}, 5, now)
+
+	assert.Equal(t, []uuid.UUID{sameTagAndText.ID, sameText.ID}, ids(result[source.ID]))
+}
+
+func TestRank_SameLanguageAndListedOnly(t *testing.T) {
+	tag := uuid.New()
+	source := doc("Cricket", "The cricket season starts.", tag)
+	urdu := doc("Cricket", "The cricket season starts.", tag)
+	urdu.Language = "ur"
+	unlisted := doc("Cricket", "The cricket season starts.", tag)
+	unlisted.Listed = false
+	listed := doc("Cricket news", "Cricket season news.", tag)
+
+	result := Rank([]Document{source, urdu, unlisted, listed}, 5, now)
+
+	assert.Equal(t, []uuid.UUID{listed.ID}, ids(result[source.ID]))
+	assert.Contains(t, ids(result[unlisted.ID]), listed.ID, "unlisted articles still get suggestions")
+	assert.Empty(t, result[urdu.ID])
+}
+
+func TestRank_RecencyAndPopularityLiftEqualMatches(t *testing.T) {
+	tag := uuid.New()
+	source := doc("Travel", "Notes from the road.", tag)
+	old := doc("Travel", "Notes from the road.", tag)
+	old.PublishedAt = now.AddDate(-2, 0, 0)
+	recent := doc("Travel", "Notes from the road.", tag)
+	popular := doc("Travel", "Notes from the road.", tag)
+	popular.PublishedAt = old.PublishedAt
+	popular.Views = 1000
+	popular.Likes = 50
+
+	result := Rank([]Document{source, old, recent, popular}, 2, now)
+
+	assert.ElementsMatch(t, []uuid.UUID{recent.ID, popular.ID}, ids(result[source.ID]))
+}
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"go", "2025", "قلم", "über"}, tokenize("The Go: 2025 — a قلم, Über!", 0))
+	assert.Equal(t, []string{"one", "two"}, tokenize("one two three", 2))
+}
+
+func TestOverlap(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	assert.Equal(t, 0.0, overlap(nil, []uuid.UUID{a}))
+	assert.InDelta(t, 1.0, overlap([]uuid.UUID{a, b}, []uuid.UUID{b, a}), 1e-9)
+	assert.InDelta(t, 0.5, overlap([]uuid.UUID{a, b}, []uuid.UUID{a, c}), 1e-9)
+}
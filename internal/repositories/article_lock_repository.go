@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ArticleLockRepository defines the interface for advisory edit lock data access
+type ArticleLockRepository interface {
+	Acquire(articleID, userID uuid.UUID, expiresAt time.Time) (*models.ArticleEditLock, error)
+	Find(articleID uuid.UUID) (*models.ArticleEditLock, error)
+	Release(articleID uuid.UUID) error
+	// WithTx returns a new repository instance using the provided transaction
+	WithTx(tx *gorm.DB) ArticleLockRepository
+}
+
+type articleLockRepository struct {
+	db *gorm.DB
+}
+
+// NewArticleLockRepository creates a new article lock repository
+func NewArticleLockRepository(db *gorm.DB) ArticleLockRepository {
+	return &articleLockRepository{db: db}
+}
+
+// WithTx returns a new repository instance using the provided transaction
+func (r *articleLockRepository) WithTx(tx *gorm.DB) ArticleLockRepository {
+	return &articleLockRepository{db: tx}
+}
+
+// Acquire takes or refreshes the lock on an article. The lock only changes hands
+// if it is free, already held by the user, or expired. It returns the lock as it
+// stands afterwards, so callers compare its UserID to see who holds it.
+func (r *articleLockRepository) Acquire(articleID, userID uuid.UUID, expiresAt time.Time) (*models.ArticleEditLock, error) {
+	lock := &models.ArticleEditLock{
+		ArticleID: articleID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	}
+
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "article_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "expires_at", "updated_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Or(
+				clause.Eq{Column: clause.Column{Table: "article_edit_locks", Name: "user_id"}, Value: userID},
+				clause.Lt{Column: clause.Column{Table: "article_edit_locks", Name: "expires_at"}, Value: time.Now()},
+			),
+		}},
+	}).Create(lock).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Find(articleID)
+}
+
+// Find finds the lock on an article, expired or not
+func (r *articleLockRepository) Find(articleID uuid.UUID) (*models.ArticleEditLock, error) {
+	var lock models.ArticleEditLock
+	err := r.db.Preload("User").First(&lock, "article_id = ?", articleID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &lock, nil
+}
+
+// Release removes the lock on an article
+func (r *articleLockRepository) Release(articleID uuid.UUID) error {
+	return r.db.Delete(&models.ArticleEditLock{}, "article_id = ?", articleID).Error
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ArticleLockRepositoryTestSuite struct {
+	suite.Suite
+	db        *gorm.DB
+	repo      ArticleLockRepository
+	articleID uuid.UUID
+	alice     *models.User
+	bob       *models.User
+}
+
+func (suite *ArticleLockRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewArticleLockRepository(suite.db)
+}
+
+func (suite *ArticleLockRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+
+	suite.alice = &models.User{ID: uuid.New(), Username: "alice", Email: "alice@example.com", Role: models.RoleAuthor, IsActive: true}
+	suite.bob = &models.User{ID: uuid.New(), Username: "bob", Email: "bob@example.com", Role: models.RoleEditor, IsActive: true}
+	suite.db.Create(suite.alice)
+	suite.db.Create(suite.bob)
+	suite.articleID = uuid.New()
+}
+
+func TestArticleLockRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticleLockRepositoryTestSuite))
+}
+
+func (suite *ArticleLockRepositoryTestSuite) TestAcquire_FreeLock() {
+	lock, err := suite.repo.Acquire(suite.articleID, suite.alice.ID, time.Now().Add(time.Minute))
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.alice.ID, lock.UserID)
+	assert.Equal(suite.T(), "alice", lock.User.Username)
+}
+
+func (suite *ArticleLockRepositoryTestSuite) TestAcquire_HeldByOther() {
+	suite.repo.Acquire(suite.articleID, suite.alice.ID, time.Now().Add(time.Minute))
+
+	lock, err := suite.repo.Acquire(suite.articleID, suite.bob.ID, time.Now().Add(time.Minute))
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.alice.ID, lock.UserID)
+}
+
+func (suite *ArticleLockRepositoryTestSuite) TestAcquire_TakesOverExpiredLock() {
+	suite.repo.Acquire(suite.articleID, suite.alice.ID, time.Now().Add(-time.Minute))
+
+	lock, err := suite.repo.Acquire(suite.articleID, suite.bob.ID, time.Now().Add(time.Minute))
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.bob.ID, lock.UserID)
+}
+
+func (suite *ArticleLockRepositoryTestSuite) TestAcquire_RefreshesOwnLock() {
+	suite.repo.Acquire(suite.articleID, suite.alice.ID, time.Now().Add(time.Minute))
+	later := time.Now().Add(5 * time.Minute)
+
+	lock, err := suite.repo.Acquire(suite.articleID, suite.alice.ID, later)
+
+	assert.NoError(suite.T(), err)
+	assert.WithinDuration(suite.T(), later, lock.ExpiresAt, time.Second)
+}
+
+func (suite *ArticleLockRepositoryTestSuite) TestRelease() {
+	suite.repo.Acquire(suite.articleID, suite.alice.ID, time.Now().Add(time.Minute))
+
+	err := suite.repo.Release(suite.articleID)
+	assert.NoError(suite.T(), err)
+
+	_, err = suite.repo.Find(suite.articleID)
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+}
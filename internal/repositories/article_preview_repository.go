@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ArticlePreviewRepository defines the interface for article preview link data access
+type ArticlePreviewRepository interface {
+	Create(preview *models.ArticlePreview) error
+	FindByID(id uuid.UUID) (*models.ArticlePreview, error)
+	FindByArticle(articleID uuid.UUID) ([]models.ArticlePreview, error)
+	Revoke(id uuid.UUID) error
+	// WithTx returns a new repository instance using the provided transaction
+	WithTx(tx *gorm.DB) ArticlePreviewRepository
+}
+
+type articlePreviewRepository struct {
+	db *gorm.DB
+}
+
+// NewArticlePreviewRepository creates a new article preview repository
+func NewArticlePreviewRepository(db *gorm.DB) ArticlePreviewRepository {
+	return &articlePreviewRepository{db: db}
+}
+
+// WithTx returns a new repository instance using the provided transaction
+func (r *articlePreviewRepository) WithTx(tx *gorm.DB) ArticlePreviewRepository {
+	return &articlePreviewRepository{db: tx}
+}
+
+// Create creates a new preview link
+func (r *articlePreviewRepository) Create(preview *models.ArticlePreview) error {
+	return r.db.Omit("Article").Create(preview).Error
+}
+
+// FindByID finds a preview link by ID
+func (r *articlePreviewRepository) FindByID(id uuid.UUID) (*models.ArticlePreview, error) {
+	var preview models.ArticlePreview
+	err := r.db.First(&preview, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &preview, nil
+}
+
+// FindByArticle finds all preview links of an article, newest first
+func (r *articlePreviewRepository) FindByArticle(articleID uuid.UUID) ([]models.ArticlePreview, error) {
+	var previews []models.ArticlePreview
+	err := r.db.Where("article_id = ?", articleID).Order("created_at DESC").Find(&previews).Error
+	return previews, err
+}
+
+// Revoke marks a preview link as revoked
+func (r *articlePreviewRepository) Revoke(id uuid.UUID) error {
+	return r.db.Model(&models.ArticlePreview{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now()).Error
+}
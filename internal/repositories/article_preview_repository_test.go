@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ArticlePreviewRepositoryTestSuite struct {
+	suite.Suite
+	db        *gorm.DB
+	repo      ArticlePreviewRepository
+	articleID uuid.UUID
+	authorID  uuid.UUID
+}
+
+func (suite *ArticlePreviewRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewArticlePreviewRepository(suite.db)
+}
+
+func (suite *ArticlePreviewRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+	suite.articleID = uuid.New()
+	suite.authorID = uuid.New()
+}
+
+func TestArticlePreviewRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticlePreviewRepositoryTestSuite))
+}
+
+func (suite *ArticlePreviewRepositoryTestSuite) newPreview(createdAt time.Time) *models.ArticlePreview {
+	preview := &models.ArticlePreview{
+		ArticleID: suite.articleID,
+		CreatedBy: suite.authorID,
+		ExpiresAt: time.Now().Add(time.Hour),
+		CreatedAt: createdAt,
+	}
+	suite.Require().NoError(suite.repo.Create(preview))
+	return preview
+}
+
+func (suite *ArticlePreviewRepositoryTestSuite) TestCreate_FindByID() {
+	preview := suite.newPreview(time.Now())
+
+	found, err := suite.repo.FindByID(preview.ID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.articleID, found.ArticleID)
+	assert.True(suite.T(), found.IsActive())
+}
+
+func (suite *ArticlePreviewRepositoryTestSuite) TestFindByArticle_NewestFirst() {
+	older := suite.newPreview(time.Now().Add(-time.Hour))
+	newer := suite.newPreview(time.Now())
+	suite.db.Create(&models.ArticlePreview{ArticleID: uuid.New(), CreatedBy: suite.authorID, ExpiresAt: time.Now().Add(time.Hour)})
+
+	previews, err := suite.repo.FindByArticle(suite.articleID)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), previews, 2)
+	assert.Equal(suite.T(), newer.ID, previews[0].ID)
+	assert.Equal(suite.T(), older.ID, previews[1].ID)
+}
+
+func (suite *ArticlePreviewRepositoryTestSuite) TestRevoke() {
+	preview := suite.newPreview(time.Now())
+
+	err := suite.repo.Revoke(preview.ID)
+
+	assert.NoError(suite.T(), err)
+	found, _ := suite.repo.FindByID(preview.ID)
+	assert.NotNil(suite.T(), found.RevokedAt)
+	assert.False(suite.T(), found.IsActive())
+}
@@ -1,13 +1,19 @@
 package repositories
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/alfafaa/alfafaa-blog/internal/models"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrVersionConflict is returned when an article was modified since it was loaded
+var ErrVersionConflict = errors.New("article version conflict")
+
 // ArticleRepository defines the interface for article data access
 type ArticleRepository interface {
 	Create(article *models.Article) error
@@ -17,9 +23,10 @@ type ArticleRepository interface {
 	FindByAuthor(authorID uuid.UUID, filters ArticleFilters) ([]models.Article, int64, error)
 	FindByCategory(categoryID uuid.UUID, filters ArticleFilters) ([]models.Article, int64, error)
 	FindByTag(tagID uuid.UUID, filters ArticleFilters) ([]models.Article, int64, error)
-	FindTrending(limit int) ([]models.Article, error)
+	FindTrending(filters TrendingFilters, limit int) ([]models.Article, error)
 	FindRecent(limit int) ([]models.Article, error)
-	FindRelated(articleID uuid.UUID, categoryIDs, tagIDs []uuid.UUID, limit int) ([]models.Article, error)
+	FindRelated(articleID uuid.UUID, categoryIDs, tagIDs []uuid.UUID, limit int, language string) ([]models.Article, error)
+	FindTranslations(groupID uuid.UUID) ([]models.Article, error)
 	FindForUser(userID uuid.UUID, followingIDs, interestCategoryIDs []uuid.UUID, filters ArticleFilters) ([]models.Article, int64, error)
 	FindStaffPicks(filters ArticleFilters) ([]models.Article, int64, error)
 	Update(article *models.Article) error
@@ -30,19 +37,34 @@ type ArticleRepository interface {
 	UpdateTags(article *models.Article, tags []models.Tag) error
 	Search(query string, filters ArticleFilters) ([]models.Article, int64, error)
 	SetStaffPick(id uuid.UUID, isStaffPick bool) error
+	SaveStaffPick(pick *models.StaffPick) error
+	UpdateCommentSettings(article *models.Article) error
 	// WithTx returns a new repository instance using the provided transaction
 	WithTx(tx *gorm.DB) ArticleRepository
 }
 
-// ArticleFilters contains filter options for querying articles
+// ArticleFilters contains filter options for querying articles. Listings only
+// include public and members-only articles, plus followers-only articles when
+// ViewerID follows or is their author, unless AllVisibilities is set.
 type ArticleFilters struct {
-	Status   string
-	AuthorID *uuid.UUID
-	FromDate *time.Time
-	ToDate   *time.Time
-	Limit    int
-	Offset   int
-	Sort     string
+	Status          string
+	AuthorID        *uuid.UUID
+	FromDate        *time.Time
+	ToDate          *time.Time
+	ViewerID        *uuid.UUID // Signed-in reader the listing is for
+	AllVisibilities bool       // Include unlisted and followers-only articles (editors, exports)
+	ExcludeReadBy   *uuid.UUID // Leave out articles this user has finished reading
+	Limit           int
+	Offset          int
+	Cursor          *Keyset // Continue after this article instead of skipping Offset; the total isn't counted
+	Sort            string
+}
+
+// TrendingFilters contains filter options for trending articles
+type TrendingFilters struct {
+	Window     string     // Score window: 24h, 7d or 30d
+	Language   string     // Base language, e.g. "ur"
+	CategoryID *uuid.UUID // Only articles in this category
 }
 
 type articleRepository struct {
@@ -101,7 +123,7 @@ func (r *articleRepository) FindAll(filters ArticleFilters) ([]models.Article, i
 	query = r.applyFilters(query, filters)
 
 	// Get total count
-	if err := query.Count(&total).Error; err != nil {
+	if err := countListing(query, filters, &total); err != nil {
 		return nil, 0, err
 	}
 
@@ -135,7 +157,7 @@ func (r *articleRepository) FindByCategory(categoryID uuid.UUID, filters Article
 
 	query = r.applyFilters(query, filters)
 
-	if err := query.Count(&total).Error; err != nil {
+	if err := countListing(query, filters, &total); err != nil {
 		return nil, 0, err
 	}
 
@@ -161,7 +183,7 @@ func (r *articleRepository) FindByTag(tagID uuid.UUID, filters ArticleFilters) (
 
 	query = r.applyFilters(query, filters)
 
-	if err := query.Count(&total).Error; err != nil {
+	if err := countListing(query, filters, &total); err != nil {
 		return nil, 0, err
 	}
 
@@ -176,12 +198,21 @@ func (r *articleRepository) FindByTag(tagID uuid.UUID, filters ArticleFilters) (
 	return articles, total, err
 }
 
-// FindTrending finds trending articles by view count
-func (r *articleRepository) FindTrending(limit int) ([]models.Article, error) {
+// FindTrending finds the articles with the highest trending score over the
+// window. Articles the trending job hasn't scored come after, most viewed first.
+func (r *articleRepository) FindTrending(filters TrendingFilters, limit int) ([]models.Article, error) {
 	var articles []models.Article
-	err := r.db.
-		Where("status = ?", models.StatusPublished).
-		Order("view_count DESC").
+
+	query := r.db.Model(&models.Article{}).
+		Joins("LEFT JOIN article_trending ON article_trending.article_id = articles.id AND article_trending.period = ?", filters.Window).
+		Where("articles.status = ?", models.StatusPublished)
+	query = whereListed(whereLanguage(query, filters.Language), nil)
+	if filters.CategoryID != nil {
+		query = query.Where("articles.id IN (SELECT article_id FROM article_categories WHERE category_id = ?)", *filters.CategoryID)
+	}
+
+	err := query.
+		Order("COALESCE(article_trending.score, 0) DESC, articles.view_count DESC").
 		Limit(limit).
 		Preload("Author").
 		Preload("Categories").
@@ -193,7 +224,7 @@ func (r *articleRepository) FindTrending(limit int) ([]models.Article, error) {
 // FindRecent finds recently published articles
 func (r *articleRepository) FindRecent(limit int) ([]models.Article, error) {
 	var articles []models.Article
-	err := r.db.
+	err := whereListed(r.db, nil).
 		Where("status = ?", models.StatusPublished).
 		Order("published_at DESC").
 		Limit(limit).
@@ -204,30 +235,31 @@ func (r *articleRepository) FindRecent(limit int) ([]models.Article, error) {
 	return articles, err
 }
 
-// FindRelated finds related articles based on categories and tags
-func (r *articleRepository) FindRelated(articleID uuid.UUID, categoryIDs, tagIDs []uuid.UUID, limit int) ([]models.Article, error) {
+// FindRelated finds the newest articles sharing a category or tag, optionally limited to a language.
+// It is the fallback for articles the related ranking job hasn't scored yet.
+func (r *articleRepository) FindRelated(articleID uuid.UUID, categoryIDs, tagIDs []uuid.UUID, limit int, language string) ([]models.Article, error) {
 	var articles []models.Article
 
 	query := r.db.Model(&models.Article{}).
 		Where("id != ?", articleID).
 		Where("status = ?", models.StatusPublished)
-
-	// Find articles that share categories or tags
-	if len(categoryIDs) > 0 || len(tagIDs) > 0 {
-		subQuery := r.db.Model(&models.Article{}).Select("DISTINCT articles.id")
-
-		if len(categoryIDs) > 0 {
-			subQuery = subQuery.
-				Joins("LEFT JOIN article_categories ON article_categories.article_id = articles.id").
-				Where("article_categories.category_id IN ?", categoryIDs)
-		}
-		if len(tagIDs) > 0 {
-			subQuery = subQuery.
-				Joins("LEFT JOIN article_tags ON article_tags.article_id = articles.id").
-				Or("article_tags.tag_id IN ?", tagIDs)
-		}
-
-		query = query.Where("id IN (?)", subQuery)
+	query = whereLanguage(query, language)
+	query = whereListed(query, nil)
+
+	// Find articles that share categories or tags. The conditions are grouped
+	// so the OR between them doesn't escape the filters above.
+	var shared []string
+	var args []interface{}
+	if len(categoryIDs) > 0 {
+		shared = append(shared, "articles.id IN (SELECT article_id FROM article_categories WHERE category_id IN ?)")
+		args = append(args, categoryIDs)
+	}
+	if len(tagIDs) > 0 {
+		shared = append(shared, "articles.id IN (SELECT article_id FROM article_tags WHERE tag_id IN ?)")
+		args = append(args, tagIDs)
+	}
+	if len(shared) > 0 {
+		query = query.Where("("+strings.Join(shared, " OR ")+")", args...)
 	}
 
 	err := query.
@@ -241,9 +273,59 @@ func (r *articleRepository) FindRelated(articleID uuid.UUID, categoryIDs, tagIDs
 	return articles, err
 }
 
-// Update updates an article
+// FindTranslations finds all articles in a translation group, including the unpublished ones
+func (r *articleRepository) FindTranslations(groupID uuid.UUID) ([]models.Article, error) {
+	var articles []models.Article
+	err := r.db.
+		Where("translation_group_id = ?", groupID).
+		Order("created_at ASC").
+		Find(&articles).Error
+	return articles, err
+}
+
+// whereLanguage limits a query to articles in a language (e.g. "pt"), including
+// regional variants such as "pt-BR". An empty language leaves the query unchanged.
+func whereLanguage(query *gorm.DB, language string) *gorm.DB {
+	if language == "" {
+		return query
+	}
+	return query.Where("(articles.locale = ? OR articles.locale LIKE ?)", language, language+"-%")
+}
+
+// whereListed limits a query to the articles a reader may find in listings:
+// public and members-only articles, plus followers-only articles written by
+// the viewer or by authors they follow. Unlisted articles are never listed.
+func whereListed(query *gorm.DB, viewerID *uuid.UUID) *gorm.DB {
+	listed := []models.ArticleVisibility{models.VisibilityPublic, models.VisibilityMembers}
+	if viewerID == nil {
+		return query.Where("articles.visibility IN ?", listed)
+	}
+	return query.Where(
+		"(articles.visibility IN ? OR (articles.visibility = ? AND (articles.author_id = ? OR articles.author_id IN (SELECT following_id FROM user_follows WHERE follower_id = ?))))",
+		listed, models.VisibilityFollowers, *viewerID, *viewerID,
+	)
+}
+
+// Update updates an article if the stored version still matches article.Version,
+// bumping the version on success. Otherwise it returns ErrVersionConflict.
 func (r *articleRepository) Update(article *models.Article) error {
-	return r.db.Save(article).Error
+	expected := article.Version
+	article.Version = expected + 1
+
+	result := r.db.Model(article).
+		Select("*").
+		Omit(clause.Associations).
+		Where("version = ?", expected).
+		Updates(article)
+	if result.Error != nil {
+		article.Version = expected
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		article.Version = expected
+		return ErrVersionConflict
+	}
+	return nil
 }
 
 // Delete soft deletes an article
@@ -284,7 +366,7 @@ func (r *articleRepository) Search(query string, filters ArticleFilters) ([]mode
 
 	dbQuery = r.applyFilters(dbQuery, filters)
 
-	if err := dbQuery.Count(&total).Error; err != nil {
+	if err := countListing(dbQuery, filters, &total); err != nil {
 		return nil, 0, err
 	}
 
@@ -313,34 +395,57 @@ func (r *articleRepository) applyFilters(query *gorm.DB, filters ArticleFilters)
 	if filters.ToDate != nil {
 		query = query.Where("published_at <= ?", *filters.ToDate)
 	}
+	if !filters.AllVisibilities {
+		query = whereListed(query, filters.ViewerID)
+	}
+	if filters.ExcludeReadBy != nil {
+		query = query.Where("articles.id NOT IN (SELECT article_id FROM reading_progress WHERE user_id = ? AND completed_at IS NOT NULL)", *filters.ExcludeReadBy)
+	}
 	return query
 }
 
-// applyPaginationAndSort applies pagination and sorting to a query
+// applyPaginationAndSort applies pagination and sorting to a query. Articles
+// with equal sort keys are ordered by ID, so that pages never overlap.
 func (r *articleRepository) applyPaginationAndSort(query *gorm.DB, filters ArticleFilters) *gorm.DB {
-	// Apply sorting
-	switch filters.Sort {
-	case "oldest":
-		query = query.Order("created_at ASC")
-	case "popular":
-		query = query.Order("view_count DESC")
-	case "alphabetical":
-		query = query.Order("title ASC")
-	default: // newest
-		query = query.Order("created_at DESC")
-	}
+	column, desc := articleSortKey(filters.Sort)
+	query = orderKeyset(query, column, "articles.id", desc)
 
 	// Apply pagination
+	if filters.Cursor != nil {
+		query = afterKeyset(query, column, "articles.id", desc, filters.Cursor)
+	}
 	if filters.Limit > 0 {
 		query = query.Limit(filters.Limit)
 	}
-	if filters.Offset > 0 {
+	if filters.Offset > 0 && filters.Cursor == nil {
 		query = query.Offset(filters.Offset)
 	}
 
 	return query
 }
 
+// articleSortKey returns the column an article sort orders by, and whether it is descending
+func articleSortKey(sort string) (string, bool) {
+	switch sort {
+	case "oldest":
+		return "articles.created_at", false
+	case "popular":
+		return "articles.view_count", true
+	case "alphabetical":
+		return "articles.title", false
+	default: // newest
+		return "articles.created_at", true
+	}
+}
+
+// countListing counts the articles a listing matches. Keyset pages skip the count.
+func countListing(query *gorm.DB, filters ArticleFilters, total *int64) error {
+	if filters.Cursor != nil {
+		return nil
+	}
+	return query.Count(total).Error
+}
+
 // FindForUser finds articles personalized for a user based on followed authors and interests
 func (r *articleRepository) FindForUser(userID uuid.UUID, followingIDs, interestCategoryIDs []uuid.UUID, filters ArticleFilters) ([]models.Article, int64, error) {
 	var articles []models.Article
@@ -377,7 +482,7 @@ func (r *articleRepository) FindForUser(userID uuid.UUID, followingIDs, interest
 	query = r.applyFilters(query, filters)
 
 	// Get total count
-	if err := query.Count(&total).Error; err != nil {
+	if err := countListing(query, filters, &total); err != nil {
 		return nil, 0, err
 	}
 
@@ -394,14 +499,19 @@ func (r *articleRepository) FindForUser(userID uuid.UUID, followingIDs, interest
 	return articles, total, err
 }
 
-// FindStaffPicks finds articles marked as staff picks
+// FindStaffPicks finds staff picks within their display window, in curated
+// order: positioned picks first, then the most recently published
 func (r *articleRepository) FindStaffPicks(filters ArticleFilters) ([]models.Article, int64, error) {
 	var articles []models.Article
 	var total int64
+	now := time.Now()
 
 	query := r.db.Model(&models.Article{}).
-		Where("is_staff_pick = ?", true).
-		Where("status = ?", models.StatusPublished)
+		Joins("LEFT JOIN staff_picks ON staff_picks.article_id = articles.id").
+		Where("articles.is_staff_pick = ?", true).
+		Where("articles.status = ?", models.StatusPublished).
+		Where("(staff_picks.starts_at IS NULL OR staff_picks.starts_at <= ?)", now).
+		Where("(staff_picks.ends_at IS NULL OR staff_picks.ends_at > ?)", now)
 
 	query = r.applyFilters(query, filters)
 
@@ -409,18 +519,59 @@ func (r *articleRepository) FindStaffPicks(filters ArticleFilters) ([]models.Art
 		return nil, 0, err
 	}
 
-	query = r.applyPaginationAndSort(query, filters)
+	query = query.
+		Order("CASE WHEN staff_picks.position IS NULL THEN 1 ELSE 0 END").
+		Order("staff_picks.position ASC").
+		Order("articles.published_at DESC")
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
 
 	err := query.
 		Preload("Author").
 		Preload("Categories").
 		Preload("Tags").
+		Preload("StaffPick").
 		Find(&articles).Error
 
 	return articles, total, err
 }
 
-// SetStaffPick sets the staff pick status of an article
+// SetStaffPick sets the staff pick status of an article. Unpicking also
+// removes the pick's curation details.
 func (r *articleRepository) SetStaffPick(id uuid.UUID, isStaffPick bool) error {
-	return r.db.Model(&models.Article{}).Where("id = ?", id).Update("is_staff_pick", isStaffPick).Error
+	if err := r.db.Model(&models.Article{}).Where("id = ?", id).Update("is_staff_pick", isStaffPick).Error; err != nil {
+		return err
+	}
+	if isStaffPick {
+		return nil
+	}
+	return r.db.Delete(&models.StaffPick{}, "article_id = ?", id).Error
+}
+
+// SaveStaffPick marks an article as a staff pick, creating or replacing its curation details
+func (r *articleRepository) SaveStaffPick(pick *models.StaffPick) error {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "article_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"curator_id", "note", "position", "starts_at", "ends_at", "updated_at"}),
+	}).Create(pick).Error
+	if err != nil {
+		return err
+	}
+	return r.db.Model(&models.Article{}).Where("id = ?", pick.ArticleID).Update("is_staff_pick", true).Error
+}
+
+// UpdateCommentSettings saves an article's comment settings without touching its version
+func (r *articleRepository) UpdateCommentSettings(article *models.Article) error {
+	return r.db.Model(&models.Article{}).
+		Where("id = ?", article.ID).
+		Updates(map[string]interface{}{
+			"comments_disabled":         article.CommentsDisabled,
+			"comments_locked":           article.CommentsLocked,
+			"comments_close_after_days": article.CommentsCloseAfterDays,
+			"comment_audience":          article.CommentAudience,
+		}).Error
 }
@@ -234,6 +234,111 @@ func (suite *ArticleRepositoryTestSuite) TestFindAll_WithPagination() {
 	assert.Equal(suite.T(), int64(5), total)
 }
 
+func (suite *ArticleRepositoryTestSuite) TestFindAll_WithCursor() {
+	// Two articles share a creation time, so the page boundary falls on a tie
+	createdAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	for i := 0; i < 5; i++ {
+		suite.repo.Create(&models.Article{
+			ID:        uuid.New(),
+			Title:     "Article " + string(rune('0'+i)),
+			Slug:      "article-" + string(rune('0'+i)),
+			Content:   "Content",
+			AuthorID:  suite.testUser.ID,
+			Status:    models.StatusPublished,
+			CreatedAt: createdAt.Add(time.Duration(i/2) * time.Minute),
+		})
+	}
+
+	seen := map[uuid.UUID]bool{}
+	filters := ArticleFilters{Limit: 2}
+	for page := 0; page < 3; page++ {
+		result, total, err := suite.repo.FindAll(filters)
+		assert.NoError(suite.T(), err)
+		if page > 0 {
+			assert.Equal(suite.T(), int64(0), total) // Keyset pages aren't counted
+		}
+		for _, article := range result {
+			assert.False(suite.T(), seen[article.ID], "article listed twice")
+			seen[article.ID] = true
+		}
+		if len(result) == 0 {
+			break
+		}
+		filters.Cursor = ArticleKeyset(&result[len(result)-1], "newest")
+	}
+
+	assert.Len(suite.T(), seen, 5)
+}
+
+func (suite *ArticleRepositoryTestSuite) TestKeyset_EncodeDecode() {
+	keyset := NewTimeKeyset(time.Now().UTC(), uuid.New())
+
+	decoded, err := DecodeKeyset(EncodeKeyset(keyset))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), keyset.ID, decoded.ID)
+	assert.True(suite.T(), keyset.Time.Equal(*decoded.Time))
+	assert.True(suite.T(), decoded.Fits(""))
+	assert.False(suite.T(), decoded.Fits("popular"))
+
+	_, err = DecodeKeyset("not-a-cursor")
+	assert.ErrorIs(suite.T(), err, ErrInvalidKeyset)
+}
+
+func (suite *ArticleRepositoryTestSuite) TestFindAll_VisibilityFilter() {
+	for _, visibility := range []models.ArticleVisibility{
+		models.VisibilityPublic, models.VisibilityUnlisted, models.VisibilityFollowers, models.VisibilityMembers,
+	} {
+		suite.repo.Create(&models.Article{
+			ID:         uuid.New(),
+			Title:      string(visibility),
+			Slug:       string(visibility),
+			Content:    "Content",
+			AuthorID:   suite.testUser.ID,
+			Status:     models.StatusPublished,
+			Visibility: visibility,
+		})
+	}
+
+	follower := &models.User{ID: uuid.New(), Username: "follower", Email: "follower@example.com", PasswordHash: "hash", Role: models.RoleReader}
+	stranger := &models.User{ID: uuid.New(), Username: "stranger", Email: "stranger@example.com", PasswordHash: "hash", Role: models.RoleReader}
+	suite.userRepo.Create(follower)
+	suite.userRepo.Create(stranger)
+	suite.userRepo.FollowUser(follower.ID, suite.testUser.ID)
+
+	tests := []struct {
+		name    string
+		filters ArticleFilters
+		want    int64
+	}{
+		{name: "anonymous", filters: ArticleFilters{}, want: 2},
+		{name: "stranger", filters: ArticleFilters{ViewerID: &stranger.ID}, want: 2},
+		{name: "follower", filters: ArticleFilters{ViewerID: &follower.ID}, want: 3},
+		{name: "author", filters: ArticleFilters{ViewerID: &suite.testUser.ID}, want: 3},
+		{name: "all visibilities", filters: ArticleFilters{AllVisibilities: true}, want: 4},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, total, err := suite.repo.FindAll(tt.filters)
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, total)
+		})
+	}
+}
+
+func (suite *ArticleRepositoryTestSuite) TestFindRecent_SkipsUnlisted() {
+	now := time.Now()
+	suite.repo.Create(&models.Article{ID: uuid.New(), Title: "Public", Slug: "public", Content: "Content", AuthorID: suite.testUser.ID, Status: models.StatusPublished, PublishedAt: &now})
+	suite.repo.Create(&models.Article{ID: uuid.New(), Title: "Unlisted", Slug: "unlisted", Content: "Content", AuthorID: suite.testUser.ID, Status: models.StatusPublished, PublishedAt: &now, Visibility: models.VisibilityUnlisted})
+
+	result, err := suite.repo.FindRecent(10)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 1)
+	assert.Equal(suite.T(), "public", result[0].Slug)
+}
+
 // FindByAuthor Tests
 
 func (suite *ArticleRepositoryTestSuite) TestFindByAuthor_Success() {
@@ -301,7 +406,7 @@ func (suite *ArticleRepositoryTestSuite) TestFindTrending_Success() {
 	suite.repo.Create(lowViews)
 	suite.repo.Create(highViews)
 
-	result, err := suite.repo.FindTrending(10)
+	result, err := suite.repo.FindTrending(TrendingFilters{Window: "7d"}, 10)
 
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), result, 2)
@@ -309,6 +414,118 @@ func (suite *ArticleRepositoryTestSuite) TestFindTrending_Success() {
 	assert.Equal(suite.T(), "High Views", result[0].Title)
 }
 
+func (suite *ArticleRepositoryTestSuite) TestFindTrending_FiltersByLanguage() {
+	for _, locale := range []string{"en", "ur", "ur-PK"} {
+		suite.repo.Create(&models.Article{
+			ID:       uuid.New(),
+			Title:    "Article " + locale,
+			Slug:     "article-" + locale,
+			Content:  "Content",
+			AuthorID: suite.testUser.ID,
+			Status:   models.StatusPublished,
+			Locale:   locale,
+		})
+	}
+
+	result, err := suite.repo.FindTrending(TrendingFilters{Window: "7d", Language: "ur"}, 10)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	for _, article := range result {
+		assert.Contains(suite.T(), []string{"ur", "ur-PK"}, article.Locale)
+	}
+}
+
+func (suite *ArticleRepositoryTestSuite) TestFindTrending_ScoredFirstInWindowAndCategory() {
+	var articles []*models.Article
+	for _, slug := range []string{"popular-old", "trending-today", "trending-month"} {
+		article := &models.Article{ID: uuid.New(), Title: slug, Slug: slug, Content: "Content", AuthorID: suite.testUser.ID, Status: models.StatusPublished}
+		suite.Require().NoError(suite.repo.Create(article))
+		articles = append(articles, article)
+	}
+	suite.db.Model(articles[0]).Update("view_count", 10000)
+	suite.Require().NoError(suite.db.Create(&[]models.TrendingScore{
+		{ArticleID: articles[1].ID, Window: "24h", Score: 5, ComputedAt: time.Now()},
+		{ArticleID: articles[1].ID, Window: "30d", Score: 1, ComputedAt: time.Now()},
+		{ArticleID: articles[2].ID, Window: "30d", Score: 3, ComputedAt: time.Now()},
+	}).Error)
+
+	result, err := suite.repo.FindTrending(TrendingFilters{Window: "24h"}, 10)
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(result, 3)
+	assert.Equal(suite.T(), "trending-today", result[0].Slug)
+	assert.Equal(suite.T(), "popular-old", result[1].Slug, "unscored articles follow by views")
+
+	result, err = suite.repo.FindTrending(TrendingFilters{Window: "30d"}, 2)
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(result, 2)
+	assert.Equal(suite.T(), "trending-month", result[0].Slug)
+	assert.Equal(suite.T(), "trending-today", result[1].Slug)
+
+	category := &models.Category{Name: "Go", Slug: "go"}
+	suite.Require().NoError(suite.db.Create(category).Error)
+	suite.Require().NoError(suite.db.Model(articles[1]).Association("Categories").Append(category))
+
+	result, err = suite.repo.FindTrending(TrendingFilters{Window: "30d", CategoryID: &category.ID}, 10)
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(result, 1)
+	assert.Equal(suite.T(), "trending-today", result[0].Slug)
+}
+
+// FindTranslations Tests
+
+func (suite *ArticleRepositoryTestSuite) TestFindTranslations_Success() {
+	original := &models.Article{
+		ID:       uuid.New(),
+		Title:    "Original",
+		Slug:     "original",
+		Content:  "Content",
+		AuthorID: suite.testUser.ID,
+		Locale:   "en",
+	}
+	suite.repo.Create(original)
+	suite.repo.Create(&models.Article{
+		ID:                 uuid.New(),
+		Title:              "Translation",
+		Slug:               "translation",
+		Content:            "Content",
+		AuthorID:           suite.testUser.ID,
+		Locale:             "ur",
+		TranslationGroupID: original.ID,
+	})
+	suite.repo.Create(&models.Article{
+		ID:       uuid.New(),
+		Title:    "Unrelated",
+		Slug:     "unrelated",
+		Content:  "Content",
+		AuthorID: suite.testUser.ID,
+		Locale:   "ur",
+	})
+
+	result, err := suite.repo.FindTranslations(original.ID)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	assert.Equal(suite.T(), original.ID, original.TranslationGroupID)
+}
+
+func (suite *ArticleRepositoryTestSuite) TestCreate_DuplicateTranslationLocale() {
+	original := &models.Article{ID: uuid.New(), Title: "Original", Slug: "original", Content: "Content", AuthorID: suite.testUser.ID, Locale: "en"}
+	suite.repo.Create(original)
+
+	err := suite.repo.Create(&models.Article{
+		ID:                 uuid.New(),
+		Title:              "Copy",
+		Slug:               "copy",
+		Content:            "Content",
+		AuthorID:           suite.testUser.ID,
+		Locale:             "en",
+		TranslationGroupID: original.ID,
+	})
+
+	assert.Error(suite.T(), err)
+}
+
 // FindRecent Tests
 
 func (suite *ArticleRepositoryTestSuite) TestFindRecent_Success() {
@@ -346,6 +563,37 @@ func (suite *ArticleRepositoryTestSuite) TestFindRecent_Success() {
 	assert.Equal(suite.T(), "Newer Article", result[0].Title)
 }
 
+// FindRelated Tests
+
+func (suite *ArticleRepositoryTestSuite) TestFindRelated_SharedCategoryOrTag() {
+	category := &models.Category{ID: uuid.New(), Name: "Travel", Slug: "travel", IsActive: true}
+	tag := &models.Tag{ID: uuid.New(), Name: "Asia", Slug: "asia"}
+	other := &models.Tag{ID: uuid.New(), Name: "Food", Slug: "food"}
+	suite.categoryRepo.Create(category)
+	suite.tagRepo.Create(tag)
+	suite.tagRepo.Create(other)
+
+	newArticle := func(slug string, status models.ArticleStatus, categories []models.Category, tags []models.Tag) *models.Article {
+		article := &models.Article{ID: uuid.New(), Title: slug, Slug: slug, Content: "Content", AuthorID: suite.testUser.ID, Status: status, Categories: categories, Tags: tags}
+		suite.Require().NoError(suite.repo.Create(article))
+		return article
+	}
+	source := newArticle("source", models.StatusPublished, []models.Category{*category}, []models.Tag{*tag})
+	newArticle("same-category", models.StatusPublished, []models.Category{*category}, nil)
+	newArticle("same-tag", models.StatusPublished, nil, []models.Tag{*tag})
+	newArticle("unrelated", models.StatusPublished, nil, []models.Tag{*other})
+	newArticle("draft", models.StatusDraft, nil, []models.Tag{*tag})
+
+	result, err := suite.repo.FindRelated(source.ID, []uuid.UUID{category.ID}, []uuid.UUID{tag.ID}, 10, "")
+
+	assert.NoError(suite.T(), err)
+	slugs := make([]string, len(result))
+	for i, article := range result {
+		slugs[i] = article.Slug
+	}
+	assert.ElementsMatch(suite.T(), []string{"same-category", "same-tag"}, slugs)
+}
+
 // Update Tests
 
 func (suite *ArticleRepositoryTestSuite) TestUpdate_Success() {
@@ -370,6 +618,55 @@ func (suite *ArticleRepositoryTestSuite) TestUpdate_Success() {
 	assert.Equal(suite.T(), "Updated content", found.Content)
 }
 
+func (suite *ArticleRepositoryTestSuite) TestUpdate_BumpsVersion() {
+	article := &models.Article{
+		ID:       uuid.New(),
+		Title:    "Versioned",
+		Slug:     "versioned",
+		Content:  "Content",
+		AuthorID: suite.testUser.ID,
+		Status:   models.StatusDraft,
+	}
+	suite.repo.Create(article)
+	assert.Equal(suite.T(), 1, article.Version)
+
+	article.Title = "Versioned Again"
+	err := suite.repo.Update(article)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, article.Version)
+
+	found, _ := suite.repo.FindByID(article.ID)
+	assert.Equal(suite.T(), 2, found.Version)
+}
+
+func (suite *ArticleRepositoryTestSuite) TestUpdate_VersionConflict() {
+	article := &models.Article{
+		ID:       uuid.New(),
+		Title:    "Contended",
+		Slug:     "contended",
+		Content:  "Content",
+		AuthorID: suite.testUser.ID,
+		Status:   models.StatusDraft,
+	}
+	suite.repo.Create(article)
+
+	first, _ := suite.repo.FindByID(article.ID)
+	second, _ := suite.repo.FindByID(article.ID)
+
+	first.Title = "First Edit"
+	assert.NoError(suite.T(), suite.repo.Update(first))
+
+	second.Title = "Second Edit"
+	err := suite.repo.Update(second)
+
+	assert.ErrorIs(suite.T(), err, ErrVersionConflict)
+	assert.Equal(suite.T(), 1, second.Version)
+
+	found, _ := suite.repo.FindByID(article.ID)
+	assert.Equal(suite.T(), "First Edit", found.Title)
+}
+
 // Delete Tests
 
 func (suite *ArticleRepositoryTestSuite) TestDelete_Success() {
@@ -440,3 +737,71 @@ func (suite *ArticleRepositoryTestSuite) TestIncrementViewCount_Success() {
 	found, _ := suite.repo.FindByID(article.ID)
 	assert.Equal(suite.T(), 6, found.ViewCount)
 }
+
+// Staff Pick Tests
+
+func (suite *ArticleRepositoryTestSuite) createPublished(slug string, publishedAt time.Time) *models.Article {
+	article := &models.Article{
+		ID:          uuid.New(),
+		Title:       slug,
+		Slug:        slug,
+		Content:     "Content",
+		AuthorID:    suite.testUser.ID,
+		Status:      models.StatusPublished,
+		PublishedAt: &publishedAt,
+	}
+	suite.repo.Create(article)
+	return article
+}
+
+func (suite *ArticleRepositoryTestSuite) TestFindStaffPicks_CuratedOrderAndWindow() {
+	now := time.Now()
+	first, second := 1, 2
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	unordered := suite.createPublished("unordered", now)
+	secondPick := suite.createPublished("second", now.Add(-2*time.Hour))
+	firstPick := suite.createPublished("first", now.Add(-3*time.Hour))
+	expired := suite.createPublished("expired", now)
+	upcoming := suite.createPublished("upcoming", now)
+
+	suite.repo.SetStaffPick(unordered.ID, true)
+	suite.repo.SaveStaffPick(&models.StaffPick{ArticleID: secondPick.ID, Position: &second, Note: "Second"})
+	suite.repo.SaveStaffPick(&models.StaffPick{ArticleID: firstPick.ID, Position: &first, StartsAt: &past, EndsAt: &future})
+	suite.repo.SaveStaffPick(&models.StaffPick{ArticleID: expired.ID, Position: &first, EndsAt: &past})
+	suite.repo.SaveStaffPick(&models.StaffPick{ArticleID: upcoming.ID, Position: &first, StartsAt: &future})
+
+	result, total, err := suite.repo.FindStaffPicks(ArticleFilters{Limit: 10})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), total)
+	if assert.Len(suite.T(), result, 3) {
+		assert.Equal(suite.T(), "first", result[0].Slug)
+		assert.Equal(suite.T(), "second", result[1].Slug)
+		assert.Equal(suite.T(), "Second", result[1].StaffPick.Note)
+		assert.Equal(suite.T(), "unordered", result[2].Slug)
+		assert.Nil(suite.T(), result[2].StaffPick)
+	}
+}
+
+func (suite *ArticleRepositoryTestSuite) TestSaveStaffPick_ReplacesAndUnpickRemoves() {
+	article := suite.createPublished("picked", time.Now())
+
+	assert.NoError(suite.T(), suite.repo.SaveStaffPick(&models.StaffPick{ArticleID: article.ID, Note: "Old"}))
+	assert.NoError(suite.T(), suite.repo.SaveStaffPick(&models.StaffPick{ArticleID: article.ID, Note: "New"}))
+
+	var pick models.StaffPick
+	assert.NoError(suite.T(), suite.db.First(&pick, "article_id = ?", article.ID).Error)
+	assert.Equal(suite.T(), "New", pick.Note)
+
+	found, _ := suite.repo.FindByID(article.ID)
+	assert.True(suite.T(), found.IsStaffPick)
+
+	assert.NoError(suite.T(), suite.repo.SetStaffPick(article.ID, false))
+
+	var count int64
+	suite.db.Model(&models.StaffPick{}).Where("article_id = ?", article.ID).Count(&count)
+	assert.Zero(suite.T(), count)
+	found, _ = suite.repo.FindByID(article.ID)
+	assert.False(suite.T(), found.IsStaffPick)
+}
@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ArticleRevisionRepository defines the interface for article revision data access
+type ArticleRevisionRepository interface {
+	Create(revision *models.ArticleRevision) error
+	FindPending(articleID uuid.UUID) (*models.ArticleRevision, error)
+	FindAllPending(limit, offset int) ([]models.ArticleRevision, int64, error)
+	Update(revision *models.ArticleRevision) error
+	// WithTx returns a new repository instance using the provided transaction
+	WithTx(tx *gorm.DB) ArticleRevisionRepository
+}
+
+type articleRevisionRepository struct {
+	db *gorm.DB
+}
+
+// NewArticleRevisionRepository creates a new article revision repository
+func NewArticleRevisionRepository(db *gorm.DB) ArticleRevisionRepository {
+	return &articleRevisionRepository{db: db}
+}
+
+// WithTx returns a new repository instance using the provided transaction
+func (r *articleRevisionRepository) WithTx(tx *gorm.DB) ArticleRevisionRepository {
+	return &articleRevisionRepository{db: tx}
+}
+
+// Create creates a new revision
+func (r *articleRevisionRepository) Create(revision *models.ArticleRevision) error {
+	return r.db.Omit("Article", "Editor").Create(revision).Error
+}
+
+// FindPending finds the pending revision of an article
+func (r *articleRevisionRepository) FindPending(articleID uuid.UUID) (*models.ArticleRevision, error) {
+	var revision models.ArticleRevision
+	err := r.db.
+		Preload("Editor").
+		Where("article_id = ? AND status = ?", articleID, models.RevisionPending).
+		First(&revision).Error
+	if err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// FindAllPending finds pending revisions awaiting review, oldest first
+func (r *articleRevisionRepository) FindAllPending(limit, offset int) ([]models.ArticleRevision, int64, error) {
+	var revisions []models.ArticleRevision
+	var total int64
+
+	query := r.db.Model(&models.ArticleRevision{}).Where("status = ?", models.RevisionPending)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Editor").
+		Preload("Article").
+		Preload("Article.Author").
+		Order("updated_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&revisions).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return revisions, total, nil
+}
+
+// Update updates a revision
+func (r *articleRevisionRepository) Update(revision *models.ArticleRevision) error {
+	return r.db.Omit("Article", "Editor").Save(revision).Error
+}
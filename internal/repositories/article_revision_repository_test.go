@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ArticleRevisionRepositoryTestSuite struct {
+	suite.Suite
+	db      *gorm.DB
+	repo    ArticleRevisionRepository
+	editor  *models.User
+	article *models.Article
+}
+
+func (suite *ArticleRevisionRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewArticleRevisionRepository(suite.db)
+}
+
+func (suite *ArticleRevisionRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+
+	suite.editor = &models.User{ID: uuid.New(), Username: "editor", Email: "editor@example.com", Role: models.RoleEditor, IsActive: true}
+	suite.db.Create(suite.editor)
+
+	suite.article = &models.Article{
+		ID:       uuid.New(),
+		Title:    "Live Article",
+		Slug:     "live-article",
+		Content:  "Content",
+		AuthorID: suite.editor.ID,
+		Status:   models.StatusPublished,
+	}
+	suite.db.Create(suite.article)
+}
+
+func TestArticleRevisionRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticleRevisionRepositoryTestSuite))
+}
+
+func (suite *ArticleRevisionRepositoryTestSuite) newRevision() *models.ArticleRevision {
+	return &models.ArticleRevision{
+		ArticleID:   suite.article.ID,
+		EditorID:    suite.editor.ID,
+		Changes:     `{"title":"Edited"}`,
+		BaseVersion: 1,
+		Status:      models.RevisionPending,
+	}
+}
+
+func (suite *ArticleRevisionRepositoryTestSuite) TestFindPending() {
+	revision := suite.newRevision()
+	assert.NoError(suite.T(), suite.repo.Create(revision))
+
+	found, err := suite.repo.FindPending(suite.article.ID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), revision.ID, found.ID)
+	assert.Equal(suite.T(), "editor", found.Editor.Username)
+}
+
+func (suite *ArticleRevisionRepositoryTestSuite) TestFindPending_IgnoresReviewed() {
+	revision := suite.newRevision()
+	suite.repo.Create(revision)
+	revision.Status = models.RevisionPublished
+	assert.NoError(suite.T(), suite.repo.Update(revision))
+
+	_, err := suite.repo.FindPending(suite.article.ID)
+
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (suite *ArticleRevisionRepositoryTestSuite) TestCreate_OnePendingPerArticle() {
+	assert.NoError(suite.T(), suite.repo.Create(suite.newRevision()))
+
+	err := suite.repo.Create(suite.newRevision())
+
+	assert.Error(suite.T(), err)
+}
+
+func (suite *ArticleRevisionRepositoryTestSuite) TestFindAllPending() {
+	suite.repo.Create(suite.newRevision())
+
+	revisions, total, err := suite.repo.FindAllPending(10, 0)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	assert.Equal(suite.T(), "Live Article", revisions[0].Article.Title)
+}
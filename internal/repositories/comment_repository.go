@@ -21,6 +21,7 @@ type CommentFilters struct {
 	ParentOnly        bool
 	Limit             int
 	Offset            int
+	Cursor            *Keyset // Continue after this comment instead of skipping Offset; the total isn't counted
 }
 
 type commentRepository struct {
@@ -66,16 +67,21 @@ func (r *commentRepository) FindByArticle(articleID uuid.UUID, filters CommentFi
 	}
 
 	// Get total count
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if filters.Cursor == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
 	// Apply pagination and order
-	query = query.Order("created_at DESC")
+	query = orderKeyset(query, "comments.created_at", "comments.id", true)
+	if filters.Cursor != nil {
+		query = afterKeyset(query, "comments.created_at", "comments.id", true, filters.Cursor)
+	}
 	if filters.Limit > 0 {
 		query = query.Limit(filters.Limit)
 	}
-	if filters.Offset > 0 {
+	if filters.Offset > 0 && filters.Cursor == nil {
 		query = query.Offset(filters.Offset)
 	}
 
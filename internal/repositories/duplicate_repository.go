@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DuplicateRepository defines the interface for duplicate detection data access
+type DuplicateRepository interface {
+	SaveFingerprint(fingerprint *models.ArticleFingerprint, bands []models.FingerprintBand) error
+	DeleteFingerprint(articleID uuid.UUID) error
+	FindCandidates(articleID uuid.UUID, bands []models.FingerprintBand) ([]models.ArticleFingerprint, error)
+	SaveFlag(flag *models.DuplicateFlag) error
+	FindFlagsForArticle(articleID uuid.UUID) ([]models.DuplicateFlag, error)
+	DeleteFlag(id uuid.UUID) error
+	FindFlags(status models.DuplicateStatus, limit, offset int) ([]models.DuplicateFlag, int64, error)
+	FindFlagByID(id uuid.UUID) (*models.DuplicateFlag, error)
+	UpdateFlag(flag *models.DuplicateFlag) error
+}
+
+type duplicateRepository struct {
+	db *gorm.DB
+}
+
+// NewDuplicateRepository creates a new duplicate repository
+func NewDuplicateRepository(db *gorm.DB) DuplicateRepository {
+	return &duplicateRepository{db: db}
+}
+
+// SaveFingerprint stores an article's signature and replaces its band hashes
+func (r *duplicateRepository) SaveFingerprint(fingerprint *models.ArticleFingerprint, bands []models.FingerprintBand) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		fingerprint.UpdatedAt = time.Now()
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "article_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"signature", "updated_at"}),
+		}).Create(fingerprint).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&models.FingerprintBand{}, "article_id = ?", fingerprint.ArticleID).Error; err != nil {
+			return err
+		}
+		if len(bands) == 0 {
+			return nil
+		}
+		return tx.Create(&bands).Error
+	})
+}
+
+// DeleteFingerprint removes an article's signature and band hashes
+func (r *duplicateRepository) DeleteFingerprint(articleID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.FingerprintBand{}, "article_id = ?", articleID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.ArticleFingerprint{}, "article_id = ?", articleID).Error
+	})
+}
+
+// FindCandidates returns the fingerprints of other articles sharing at least one band hash
+func (r *duplicateRepository) FindCandidates(articleID uuid.UUID, bands []models.FingerprintBand) ([]models.ArticleFingerprint, error) {
+	var fingerprints []models.ArticleFingerprint
+	if len(bands) == 0 {
+		return fingerprints, nil
+	}
+
+	matches := r.db.Where("band = ? AND hash = ?", bands[0].Band, bands[0].Hash)
+	for _, band := range bands[1:] {
+		matches = matches.Or("band = ? AND hash = ?", band.Band, band.Hash)
+	}
+
+	err := r.db.
+		Where("article_id <> ?", articleID).
+		Where("article_id IN (?)", r.db.Model(&models.FingerprintBand{}).Select("article_id").Where(matches)).
+		Find(&fingerprints).Error
+	return fingerprints, err
+}
+
+// SaveFlag creates a flag for a pair of articles, or refreshes the scores and
+// passages of an existing one without touching its review status
+func (r *duplicateRepository) SaveFlag(flag *models.DuplicateFlag) error {
+	return r.db.Omit("Article", "MatchedArticle", "Reviewer").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "article_id"}, {Name: "matched_article_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"similarity", "overlap", "passages", "updated_at"}),
+	}).Create(flag).Error
+}
+
+// FindFlagsForArticle returns every flag the article is part of, on either side
+func (r *duplicateRepository) FindFlagsForArticle(articleID uuid.UUID) ([]models.DuplicateFlag, error) {
+	var flags []models.DuplicateFlag
+	err := r.db.Where("article_id = ? OR matched_article_id = ?", articleID, articleID).Find(&flags).Error
+	return flags, err
+}
+
+// DeleteFlag deletes a flag
+func (r *duplicateRepository) DeleteFlag(id uuid.UUID) error {
+	return r.db.Delete(&models.DuplicateFlag{}, "id = ?", id).Error
+}
+
+// FindFlags returns flags with the given status, highest overlap first.
+// Flags on deleted articles are left out.
+func (r *duplicateRepository) FindFlags(status models.DuplicateStatus, limit, offset int) ([]models.DuplicateFlag, int64, error) {
+	var flags []models.DuplicateFlag
+	var total int64
+
+	live := r.db.Model(&models.Article{}).Select("id")
+	query := r.db.Model(&models.DuplicateFlag{}).
+		Where("status = ?", status).
+		Where("article_id IN (?) AND matched_article_id IN (?)", live, live)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Article.Author").
+		Preload("MatchedArticle.Author").
+		Preload("Reviewer").
+		Order("overlap DESC").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&flags).Error
+
+	return flags, total, err
+}
+
+// FindFlagByID finds a flag by ID with both articles and the reviewer
+func (r *duplicateRepository) FindFlagByID(id uuid.UUID) (*models.DuplicateFlag, error) {
+	var flag models.DuplicateFlag
+	err := r.db.
+		Preload("Article.Author").
+		Preload("MatchedArticle.Author").
+		Preload("Reviewer").
+		First(&flag, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &flag, nil
+}
+
+// UpdateFlag saves a flag's review status
+func (r *duplicateRepository) UpdateFlag(flag *models.DuplicateFlag) error {
+	return r.db.Model(flag).Select("status", "reviewed_by", "reviewed_at", "updated_at").Updates(flag).Error
+}
@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type DuplicateRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     DuplicateRepository
+	authorID uuid.UUID
+}
+
+func (suite *DuplicateRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewDuplicateRepository(suite.db)
+}
+
+func (suite *DuplicateRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+	author := &models.User{Username: "author", Email: "author@example.com", PasswordHash: "hash", Role: models.RoleAuthor}
+	suite.Require().NoError(suite.db.Create(author).Error)
+	suite.authorID = author.ID
+}
+
+func TestDuplicateRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(DuplicateRepositoryTestSuite))
+}
+
+func (suite *DuplicateRepositoryTestSuite) newArticle(slug string) *models.Article {
+	article := &models.Article{Title: slug, Slug: slug, Content: "Content", AuthorID: suite.authorID}
+	suite.Require().NoError(suite.db.Create(article).Error)
+	return article
+}
+
+func (suite *DuplicateRepositoryTestSuite) saveFingerprint(articleID uuid.UUID, hashes ...int64) {
+	bands := make([]models.FingerprintBand, len(hashes))
+	for i, hash := range hashes {
+		bands[i] = models.FingerprintBand{ArticleID: articleID, Band: i, Hash: hash}
+	}
+	fingerprint := &models.ArticleFingerprint{ArticleID: articleID, Signature: []byte{byte(len(hashes))}}
+	suite.Require().NoError(suite.repo.SaveFingerprint(fingerprint, bands))
+}
+
+func (suite *DuplicateRepositoryTestSuite) TestFindCandidates_SharedBandOnly() {
+	source := suite.newArticle("source")
+	match := suite.newArticle("match")
+	sameHashOtherBand := suite.newArticle("other-band")
+	unrelated := suite.newArticle("unrelated")
+
+	suite.saveFingerprint(source.ID, 1, 2, 3)
+	suite.saveFingerprint(match.ID, 9, 2, 9)
+	suite.saveFingerprint(sameHashOtherBand.ID, 3, 1, 9)
+	suite.saveFingerprint(unrelated.ID, 7, 8, 9)
+
+	bands := []models.FingerprintBand{
+		{ArticleID: source.ID, Band: 0, Hash: 1},
+		{ArticleID: source.ID, Band: 1, Hash: 2},
+		{ArticleID: source.ID, Band: 2, Hash: 3},
+	}
+	candidates, err := suite.repo.FindCandidates(source.ID, bands)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), candidates, 1)
+	assert.Equal(suite.T(), match.ID, candidates[0].ArticleID)
+	assert.Equal(suite.T(), []byte{3}, candidates[0].Signature)
+}
+
+func (suite *DuplicateRepositoryTestSuite) TestSaveFingerprint_ReplacesBands() {
+	source := suite.newArticle("source")
+	other := suite.newArticle("other")
+
+	suite.saveFingerprint(other.ID, 1, 2)
+	suite.saveFingerprint(other.ID, 5, 6)
+
+	candidates, err := suite.repo.FindCandidates(source.ID, []models.FingerprintBand{{Band: 0, Hash: 1}})
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), candidates)
+
+	candidates, err = suite.repo.FindCandidates(source.ID, []models.FingerprintBand{{Band: 0, Hash: 5}})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), candidates, 1)
+
+	suite.Require().NoError(suite.repo.DeleteFingerprint(other.ID))
+	candidates, err = suite.repo.FindCandidates(source.ID, []models.FingerprintBand{{Band: 0, Hash: 5}})
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), candidates)
+}
+
+func (suite *DuplicateRepositoryTestSuite) TestSaveFlag_KeepsReviewStatus() {
+	copied := suite.newArticle("copy")
+	original := suite.newArticle("original")
+
+	flag := &models.DuplicateFlag{ArticleID: copied.ID, MatchedArticleID: original.ID, Similarity: 0.8, Overlap: 0.7, Passages: "[]"}
+	suite.Require().NoError(suite.repo.SaveFlag(flag))
+
+	reviewedAt := time.Now()
+	flag.Status = models.DuplicateStatusDismissed
+	flag.ReviewedBy = &suite.authorID
+	flag.ReviewedAt = &reviewedAt
+	suite.Require().NoError(suite.repo.UpdateFlag(flag))
+
+	refreshed := &models.DuplicateFlag{ArticleID: copied.ID, MatchedArticleID: original.ID, Similarity: 0.9, Overlap: 0.85, Passages: `["shared"]`}
+	suite.Require().NoError(suite.repo.SaveFlag(refreshed))
+
+	flags, err := suite.repo.FindFlagsForArticle(original.ID)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), flags, 1)
+	assert.Equal(suite.T(), models.DuplicateStatusDismissed, flags[0].Status)
+	assert.Equal(suite.T(), 0.85, flags[0].Overlap)
+	assert.Equal(suite.T(), `["shared"]`, flags[0].Passages)
+}
+
+func (suite *DuplicateRepositoryTestSuite) TestFindFlags_ByStatusSkippingDeletedArticles() {
+	original := suite.newArticle("original")
+	closeCopy := suite.newArticle("close-copy")
+	loose := suite.newArticle("loose-copy")
+	dismissed := suite.newArticle("dismissed")
+	deleted := suite.newArticle("deleted")
+
+	suite.Require().NoError(suite.repo.SaveFlag(&models.DuplicateFlag{ArticleID: loose.ID, MatchedArticleID: original.ID, Overlap: 0.55}))
+	suite.Require().NoError(suite.repo.SaveFlag(&models.DuplicateFlag{ArticleID: closeCopy.ID, MatchedArticleID: original.ID, Overlap: 0.95}))
+	suite.Require().NoError(suite.repo.SaveFlag(&models.DuplicateFlag{ArticleID: dismissed.ID, MatchedArticleID: original.ID, Overlap: 0.9, Status: models.DuplicateStatusDismissed}))
+	suite.Require().NoError(suite.repo.SaveFlag(&models.DuplicateFlag{ArticleID: deleted.ID, MatchedArticleID: original.ID, Overlap: 0.99}))
+	suite.Require().NoError(suite.db.Delete(deleted).Error)
+
+	flags, total, err := suite.repo.FindFlags(models.DuplicateStatusPending, 10, 0)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	assert.Len(suite.T(), flags, 2)
+	assert.Equal(suite.T(), closeCopy.ID, flags[0].ArticleID)
+	assert.Equal(suite.T(), "original", flags[0].MatchedArticle.Slug)
+	assert.Equal(suite.T(), "author", flags[0].Article.Author.Username)
+
+	found, err := suite.repo.FindFlagByID(flags[1].ID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), loose.ID, found.ArticleID)
+
+	suite.Require().NoError(suite.repo.DeleteFlag(found.ID))
+	_, err = suite.repo.FindFlagByID(found.ID)
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+}
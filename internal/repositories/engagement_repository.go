@@ -18,17 +18,29 @@ type EngagementRepository interface {
 	CreateBookmark(bookmark *models.Bookmark) error
 	DeleteBookmark(userID, articleID uuid.UUID) error
 	HasBookmarked(userID, articleID uuid.UUID) (bool, error)
-	GetBookmarkedArticles(userID uuid.UUID, limit, offset int) ([]models.Article, int64, error)
+	GetBookmarks(userID uuid.UUID, limit, offset int, after *Keyset) ([]models.Bookmark, int64, error)
 
 	// Notifications
 	CreateNotification(notification *models.Notification) error
-	GetNotifications(userID uuid.UUID, limit, offset int) ([]models.Notification, int64, error)
+	GetNotifications(userID uuid.UUID, limit, offset int, after *Keyset) ([]models.Notification, int64, error)
 	GetUnreadCount(userID uuid.UUID) (int64, error)
 	MarkAsRead(notificationID, userID uuid.UUID) error
 	MarkAllAsRead(userID uuid.UUID) error
 
 	// Comment counts
 	GetCommentsCount(articleID uuid.UUID) (int64, error)
+
+	// Lists
+	GetArticleEngagements(articleIDs []uuid.UUID, userID *uuid.UUID) (map[uuid.UUID]ArticleEngagement, error)
+}
+
+// ArticleEngagement holds an article's like and comment counts, and whether
+// the reader has liked or bookmarked it
+type ArticleEngagement struct {
+	LikesCount    int64
+	CommentsCount int64
+	Liked         bool
+	Bookmarked    bool
 }
 
 type engagementRepository struct {
@@ -93,35 +105,43 @@ func (r *engagementRepository) HasBookmarked(userID, articleID uuid.UUID) (bool,
 	return count > 0, err
 }
 
-// GetBookmarkedArticles returns articles bookmarked by a user
-func (r *engagementRepository) GetBookmarkedArticles(userID uuid.UUID, limit, offset int) ([]models.Article, int64, error) {
-	var articles []models.Article
+// GetBookmarks returns a user's bookmarks with their articles, newest first.
+// Pages continue after the given keyset when one is set, and then the total
+// isn't counted.
+func (r *engagementRepository) GetBookmarks(userID uuid.UUID, limit, offset int, after *Keyset) ([]models.Bookmark, int64, error) {
+	var bookmarks []models.Bookmark
 	var total int64
 
-	query := r.db.Model(&models.Article{}).
-		Joins("JOIN bookmarks ON bookmarks.article_id = articles.id").
+	query := r.db.Model(&models.Bookmark{}).
+		Joins("JOIN articles ON bookmarks.article_id = articles.id").
 		Where("bookmarks.user_id = ?", userID).
 		Where("articles.deleted_at IS NULL")
 
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if after == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	query = query.Order("bookmarks.created_at DESC")
+	query = orderKeyset(query, "bookmarks.created_at", "bookmarks.id", true)
+	if after != nil {
+		query = afterKeyset(query, "bookmarks.created_at", "bookmarks.id", true, after)
+	}
 	if limit > 0 {
 		query = query.Limit(limit)
 	}
-	if offset > 0 {
+	if offset > 0 && after == nil {
 		query = query.Offset(offset)
 	}
 
 	err := query.
-		Preload("Author").
-		Preload("Categories").
-		Preload("Tags").
-		Find(&articles).Error
+		Preload("Article").
+		Preload("Article.Author").
+		Preload("Article.Categories").
+		Preload("Article.Tags").
+		Find(&bookmarks).Error
 
-	return articles, total, err
+	return bookmarks, total, err
 }
 
 // --- Notifications ---
@@ -131,23 +151,30 @@ func (r *engagementRepository) CreateNotification(notification *models.Notificat
 	return r.db.Create(notification).Error
 }
 
-// GetNotifications returns notifications for a user, newest first
-func (r *engagementRepository) GetNotifications(userID uuid.UUID, limit, offset int) ([]models.Notification, int64, error) {
+// GetNotifications returns notifications for a user, newest first. Pages
+// continue after the given keyset when one is set, and then the total isn't
+// counted.
+func (r *engagementRepository) GetNotifications(userID uuid.UUID, limit, offset int, after *Keyset) ([]models.Notification, int64, error) {
 	var notifications []models.Notification
 	var total int64
 
 	query := r.db.Model(&models.Notification{}).
 		Where("user_id = ?", userID)
 
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if after == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	query = query.Order("created_at DESC")
+	query = orderKeyset(query, "notifications.created_at", "notifications.id", true)
+	if after != nil {
+		query = afterKeyset(query, "notifications.created_at", "notifications.id", true, after)
+	}
 	if limit > 0 {
 		query = query.Limit(limit)
 	}
-	if offset > 0 {
+	if offset > 0 && after == nil {
 		query = query.Offset(offset)
 	}
 
@@ -196,3 +223,79 @@ func (r *engagementRepository) GetCommentsCount(articleID uuid.UUID) (int64, err
 		Count(&count).Error
 	return count, err
 }
+
+// --- Lists ---
+
+// articleCount is a row of a per-article count
+type articleCount struct {
+	ArticleID uuid.UUID
+	Count     int64
+}
+
+// GetArticleEngagements returns the engagement of a page of articles, keyed
+// by article ID, in a fixed number of queries however long the page is. The
+// reader's likes and bookmarks are only looked up when userID is set.
+func (r *engagementRepository) GetArticleEngagements(articleIDs []uuid.UUID, userID *uuid.UUID) (map[uuid.UUID]ArticleEngagement, error) {
+	engagements := make(map[uuid.UUID]ArticleEngagement, len(articleIDs))
+	if len(articleIDs) == 0 {
+		return engagements, nil
+	}
+
+	var likes []articleCount
+	if err := r.db.Model(&models.Like{}).
+		Select("article_id, COUNT(*) AS count").
+		Where("article_id IN ?", articleIDs).
+		Group("article_id").
+		Scan(&likes).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range likes {
+		engagement := engagements[row.ArticleID]
+		engagement.LikesCount = row.Count
+		engagements[row.ArticleID] = engagement
+	}
+
+	var comments []articleCount
+	if err := r.db.Model(&models.Comment{}).
+		Select("article_id, COUNT(*) AS count").
+		Where("article_id IN ? AND deleted_at IS NULL", articleIDs).
+		Group("article_id").
+		Scan(&comments).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range comments {
+		engagement := engagements[row.ArticleID]
+		engagement.CommentsCount = row.Count
+		engagements[row.ArticleID] = engagement
+	}
+
+	if userID == nil {
+		return engagements, nil
+	}
+
+	var liked []uuid.UUID
+	if err := r.db.Model(&models.Like{}).
+		Where("user_id = ? AND article_id IN ?", *userID, articleIDs).
+		Pluck("article_id", &liked).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range liked {
+		engagement := engagements[id]
+		engagement.Liked = true
+		engagements[id] = engagement
+	}
+
+	var bookmarked []uuid.UUID
+	if err := r.db.Model(&models.Bookmark{}).
+		Where("user_id = ? AND article_id IN ?", *userID, articleIDs).
+		Pluck("article_id", &bookmarked).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range bookmarked {
+		engagement := engagements[id]
+		engagement.Bookmarked = true
+		engagements[id] = engagement
+	}
+
+	return engagements, nil
+}
@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type EngagementRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     EngagementRepository
+	readerID uuid.UUID
+	authorID uuid.UUID
+}
+
+func (suite *EngagementRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewEngagementRepository(suite.db)
+}
+
+func (suite *EngagementRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+	author := &models.User{Username: "author", Email: "author@example.com", PasswordHash: "hash", Role: models.RoleAuthor}
+	reader := &models.User{Username: "reader", Email: "reader@example.com", PasswordHash: "hash", Role: models.RoleReader}
+	suite.Require().NoError(suite.db.Create(author).Error)
+	suite.Require().NoError(suite.db.Create(reader).Error)
+	suite.authorID = author.ID
+	suite.readerID = reader.ID
+}
+
+func TestEngagementRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EngagementRepositoryTestSuite))
+}
+
+func (suite *EngagementRepositoryTestSuite) newArticle(slug string) *models.Article {
+	publishedAt := time.Now().Add(-time.Hour)
+	article := &models.Article{
+		Title:       slug,
+		Slug:        slug,
+		Content:     "Content",
+		AuthorID:    suite.authorID,
+		Status:      models.StatusPublished,
+		PublishedAt: &publishedAt,
+	}
+	suite.Require().NoError(suite.db.Create(article).Error)
+	return article
+}
+
+func (suite *EngagementRepositoryTestSuite) TestGetArticleEngagements_CountsAndReaderState() {
+	liked := suite.newArticle("liked")
+	bookmarked := suite.newArticle("bookmarked")
+	quiet := suite.newArticle("quiet")
+
+	suite.Require().NoError(suite.repo.CreateLike(&models.Like{UserID: suite.readerID, ArticleID: liked.ID}))
+	suite.Require().NoError(suite.repo.CreateLike(&models.Like{UserID: suite.authorID, ArticleID: liked.ID}))
+	suite.Require().NoError(suite.repo.CreateLike(&models.Like{UserID: suite.authorID, ArticleID: bookmarked.ID}))
+	suite.Require().NoError(suite.repo.CreateBookmark(&models.Bookmark{UserID: suite.readerID, ArticleID: bookmarked.ID}))
+
+	comment := &models.Comment{ArticleID: bookmarked.ID, UserID: suite.authorID, Content: "Nice", IsApproved: true}
+	deleted := &models.Comment{ArticleID: bookmarked.ID, UserID: suite.readerID, Content: "Gone", IsApproved: true}
+	suite.Require().NoError(suite.db.Create(comment).Error)
+	suite.Require().NoError(suite.db.Create(deleted).Error)
+	suite.Require().NoError(suite.db.Delete(deleted).Error)
+
+	ids := []uuid.UUID{liked.ID, bookmarked.ID, quiet.ID}
+	engagements, err := suite.repo.GetArticleEngagements(ids, &suite.readerID)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), ArticleEngagement{LikesCount: 2, Liked: true}, engagements[liked.ID])
+	assert.Equal(suite.T(), ArticleEngagement{LikesCount: 1, CommentsCount: 1, Bookmarked: true}, engagements[bookmarked.ID])
+	assert.Equal(suite.T(), ArticleEngagement{}, engagements[quiet.ID])
+}
+
+func (suite *EngagementRepositoryTestSuite) TestGetArticleEngagements_AnonymousReader() {
+	article := suite.newArticle("article")
+	suite.Require().NoError(suite.repo.CreateLike(&models.Like{UserID: suite.readerID, ArticleID: article.ID}))
+	suite.Require().NoError(suite.repo.CreateBookmark(&models.Bookmark{UserID: suite.readerID, ArticleID: article.ID}))
+
+	engagements, err := suite.repo.GetArticleEngagements([]uuid.UUID{article.ID}, nil)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), ArticleEngagement{LikesCount: 1}, engagements[article.ID])
+}
+
+func (suite *EngagementRepositoryTestSuite) TestGetArticleEngagements_EmptyPage() {
+	engagements, err := suite.repo.GetArticleEngagements(nil, &suite.readerID)
+
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), engagements)
+}
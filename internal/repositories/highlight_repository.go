@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// HighlightRepository defines the interface for highlight data access
+type HighlightRepository interface {
+	Create(highlight *models.Highlight) error
+	FindByID(id uuid.UUID) (*models.Highlight, error)
+	Delete(id uuid.UUID) error
+	FindByUser(userID uuid.UUID, articleID *uuid.UUID, limit, offset int) ([]models.Highlight, int64, error)
+	FindPopular(articleID uuid.UUID, limit int) ([]PopularHighlight, error)
+	CountPublicReaders(articleID uuid.UUID, quote string) (int64, error)
+}
+
+// PopularHighlight is a passage of an article with the number of readers who
+// highlighted it publicly
+type PopularHighlight struct {
+	Quote   string
+	Prefix  string
+	Suffix  string
+	Readers int64
+}
+
+type highlightRepository struct {
+	db *gorm.DB
+}
+
+// NewHighlightRepository creates a new highlight repository
+func NewHighlightRepository(db *gorm.DB) HighlightRepository {
+	return &highlightRepository{db: db}
+}
+
+// Create creates a new highlight
+func (r *highlightRepository) Create(highlight *models.Highlight) error {
+	return r.db.Omit("User", "Article").Create(highlight).Error
+}
+
+// FindByID finds a highlight by ID
+func (r *highlightRepository) FindByID(id uuid.UUID) (*models.Highlight, error) {
+	var highlight models.Highlight
+	err := r.db.First(&highlight, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &highlight, nil
+}
+
+// Delete deletes a highlight
+func (r *highlightRepository) Delete(id uuid.UUID) error {
+	return r.db.Delete(&models.Highlight{}, "id = ?", id).Error
+}
+
+// FindByUser returns a user's highlights, newest first, optionally limited to one article
+func (r *highlightRepository) FindByUser(userID uuid.UUID, articleID *uuid.UUID, limit, offset int) ([]models.Highlight, int64, error) {
+	var highlights []models.Highlight
+	var total int64
+
+	query := r.db.Model(&models.Highlight{}).Where("user_id = ?", userID)
+	if articleID != nil {
+		query = query.Where("article_id = ?", *articleID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Article").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&highlights).Error
+
+	return highlights, total, err
+}
+
+// FindPopular returns the passages of an article highlighted publicly by the most readers.
+// Highlights of the same quoted text count as one passage.
+func (r *highlightRepository) FindPopular(articleID uuid.UUID, limit int) ([]PopularHighlight, error) {
+	var popular []PopularHighlight
+	err := r.db.Model(&models.Highlight{}).
+		Select("quote, MIN(prefix) AS prefix, MIN(suffix) AS suffix, COUNT(DISTINCT user_id) AS readers").
+		Where("article_id = ? AND is_public = ?", articleID, true).
+		Group("quote").
+		Order("readers DESC, MIN(created_at) ASC").
+		Limit(limit).
+		Scan(&popular).Error
+	return popular, err
+}
+
+// CountPublicReaders counts the readers who highlighted a passage of an article publicly
+func (r *highlightRepository) CountPublicReaders(articleID uuid.UUID, quote string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Highlight{}).
+		Where("article_id = ? AND quote = ? AND is_public = ?", articleID, quote, true).
+		Distinct("user_id").
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type HighlightRepositoryTestSuite struct {
+	suite.Suite
+	db        *gorm.DB
+	repo      HighlightRepository
+	articleID uuid.UUID
+}
+
+func (suite *HighlightRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewHighlightRepository(suite.db)
+}
+
+func (suite *HighlightRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+	suite.articleID = uuid.New()
+}
+
+func TestHighlightRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(HighlightRepositoryTestSuite))
+}
+
+func (suite *HighlightRepositoryTestSuite) newHighlight(userID uuid.UUID, quote string, public bool, createdAt time.Time) *models.Highlight {
+	highlight := &models.Highlight{
+		UserID:    userID,
+		ArticleID: suite.articleID,
+		Quote:     quote,
+		IsPublic:  public,
+		CreatedAt: createdAt,
+	}
+	suite.Require().NoError(suite.repo.Create(highlight))
+	return highlight
+}
+
+func (suite *HighlightRepositoryTestSuite) TestCreate_FindByID_Delete() {
+	highlight := suite.newHighlight(uuid.New(), "a passage", false, time.Now())
+
+	found, err := suite.repo.FindByID(highlight.ID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "a passage", found.Quote)
+
+	assert.NoError(suite.T(), suite.repo.Delete(highlight.ID))
+	_, err = suite.repo.FindByID(highlight.ID)
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (suite *HighlightRepositoryTestSuite) TestFindByUser() {
+	userID := uuid.New()
+	older := suite.newHighlight(userID, "first", false, time.Now().Add(-time.Hour))
+	newer := suite.newHighlight(userID, "second", true, time.Now())
+	suite.newHighlight(uuid.New(), "someone else", true, time.Now())
+	suite.db.Create(&models.Highlight{UserID: userID, ArticleID: uuid.New(), Quote: "other article"})
+
+	highlights, total, err := suite.repo.FindByUser(userID, &suite.articleID, 10, 0)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	assert.Equal(suite.T(), newer.ID, highlights[0].ID)
+	assert.Equal(suite.T(), older.ID, highlights[1].ID)
+
+	_, total, err = suite.repo.FindByUser(userID, nil, 10, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), total)
+}
+
+func (suite *HighlightRepositoryTestSuite) TestFindPopular_CountsPublicReaders() {
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+	suite.newHighlight(first, "popular", true, now)
+	suite.newHighlight(first, "popular", true, now) // same reader twice
+	suite.newHighlight(second, "popular", true, now)
+	suite.newHighlight(third, "popular", false, now)
+	suite.newHighlight(third, "once", true, now)
+	suite.newHighlight(first, "private", false, now)
+
+	popular, err := suite.repo.FindPopular(suite.articleID, 10)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), popular, 2)
+	assert.Equal(suite.T(), "popular", popular[0].Quote)
+	assert.Equal(suite.T(), int64(2), popular[0].Readers)
+	assert.Equal(suite.T(), "once", popular[1].Quote)
+
+	readers, err := suite.repo.CountPublicReaders(suite.articleID, "popular")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), readers)
+}
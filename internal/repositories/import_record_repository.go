@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ImportRecordRepository defines the interface for import record data access
+type ImportRecordRepository interface {
+	Create(entityType models.ImportEntityType, sourceKey string, entityID uuid.UUID) error
+	Find(entityType models.ImportEntityType, sourceKey string) (*models.ImportRecord, error)
+	// WithTx returns a new repository instance using the provided transaction
+	WithTx(tx *gorm.DB) ImportRecordRepository
+}
+
+type importRecordRepository struct {
+	db *gorm.DB
+}
+
+// NewImportRecordRepository creates a new import record repository
+func NewImportRecordRepository(db *gorm.DB) ImportRecordRepository {
+	return &importRecordRepository{db: db}
+}
+
+// WithTx returns a new repository instance using the provided transaction
+func (r *importRecordRepository) WithTx(tx *gorm.DB) ImportRecordRepository {
+	return &importRecordRepository{db: tx}
+}
+
+// Create records the resource created for an imported item
+func (r *importRecordRepository) Create(entityType models.ImportEntityType, sourceKey string, entityID uuid.UUID) error {
+	return r.db.Create(&models.ImportRecord{
+		EntityType: entityType,
+		SourceKey:  sourceKey,
+		EntityID:   entityID,
+	}).Error
+}
+
+// Find finds the record of a previously imported item
+func (r *importRecordRepository) Find(entityType models.ImportEntityType, sourceKey string) (*models.ImportRecord, error) {
+	var record models.ImportRecord
+	err := r.db.Where("entity_type = ? AND source_key = ?", entityType, sourceKey).First(&record).Error
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ImportRecordRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo ImportRecordRepository
+}
+
+func (suite *ImportRecordRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewImportRecordRepository(suite.db)
+}
+
+func (suite *ImportRecordRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+}
+
+func TestImportRecordRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportRecordRepositoryTestSuite))
+}
+
+func (suite *ImportRecordRepositoryTestSuite) TestCreate_AndFind() {
+	articleID := uuid.New()
+
+	err := suite.repo.Create(models.ImportEntityArticle, "wxr:https://old.example.com/?p=10", articleID)
+	assert.NoError(suite.T(), err)
+
+	record, err := suite.repo.Find(models.ImportEntityArticle, "wxr:https://old.example.com/?p=10")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), articleID, record.EntityID)
+}
+
+func (suite *ImportRecordRepositoryTestSuite) TestCreate_Duplicate() {
+	assert.NoError(suite.T(), suite.repo.Create(models.ImportEntityMedia, "media:abc", uuid.New()))
+
+	err := suite.repo.Create(models.ImportEntityMedia, "media:abc", uuid.New())
+	assert.Error(suite.T(), err)
+}
+
+func (suite *ImportRecordRepositoryTestSuite) TestFind_ScopedByEntityType() {
+	assert.NoError(suite.T(), suite.repo.Create(models.ImportEntityMedia, "shared-key", uuid.New()))
+
+	_, err := suite.repo.Find(models.ImportEntityArticle, "shared-key")
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+}
@@ -13,6 +13,8 @@ type MediaRepository interface {
 	FindAll(filters MediaFilters) ([]models.Media, int64, error)
 	FindByUploader(uploaderID uuid.UUID, filters MediaFilters) ([]models.Media, int64, error)
 	Delete(id uuid.UUID) error
+	// WithTx returns a new repository instance using the provided transaction
+	WithTx(tx *gorm.DB) MediaRepository
 }
 
 // MediaFilters contains filter options for querying media
@@ -31,6 +33,11 @@ func NewMediaRepository(db *gorm.DB) MediaRepository {
 	return &mediaRepository{db: db}
 }
 
+// WithTx returns a new repository instance using the provided transaction
+func (r *mediaRepository) WithTx(tx *gorm.DB) MediaRepository {
+	return &mediaRepository{db: tx}
+}
+
 // Create creates a new media record
 func (r *mediaRepository) Create(media *models.Media) error {
 	return r.db.Create(media).Error
@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Keyset is a position in a listing paged by keyset rather than by offset:
+// the sort key of the last item seen and its ID, which breaks ties between
+// equal keys. Only the key the listing is sorted by is set. Sort names the
+// sort order of article listings, so a position can't be reused with another.
+type Keyset struct {
+	Sort string     `json:"o,omitempty"`
+	Time *time.Time `json:"t,omitempty"`
+	Int  *int64     `json:"n,omitempty"`
+	Text *string    `json:"s,omitempty"`
+	ID   uuid.UUID  `json:"i"`
+}
+
+// ErrInvalidKeyset is returned when a cursor doesn't decode to a keyset
+var ErrInvalidKeyset = errors.New("invalid keyset cursor")
+
+// EncodeKeyset encodes a keyset as an opaque, URL-safe cursor
+func EncodeKeyset(keyset *Keyset) string {
+	data, _ := json.Marshal(keyset)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeKeyset decodes a cursor made by EncodeKeyset
+func DecodeKeyset(cursor string) (*Keyset, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidKeyset
+	}
+	var keyset Keyset
+	if err := json.Unmarshal(data, &keyset); err != nil || keyset.ID == uuid.Nil || keyset.key() == nil {
+		return nil, ErrInvalidKeyset
+	}
+	return &keyset, nil
+}
+
+// NewTimeKeyset returns the position of an item in a listing sorted by time
+func NewTimeKeyset(t time.Time, id uuid.UUID) *Keyset {
+	return &Keyset{Time: &t, ID: id}
+}
+
+// ArticleKeyset returns the position of an article in a listing in the given sort order
+func ArticleKeyset(article *models.Article, sort string) *Keyset {
+	keyset := &Keyset{Sort: sort, ID: article.ID}
+	switch sort {
+	case "popular":
+		views := int64(article.ViewCount)
+		keyset.Int = &views
+	case "alphabetical":
+		title := article.Title
+		keyset.Text = &title
+	default:
+		createdAt := article.CreatedAt
+		keyset.Time = &createdAt
+	}
+	return keyset
+}
+
+// Fits reports whether the keyset is a position in a listing in the given
+// sort order. Listings sorted by time alone pass an empty sort.
+func (k *Keyset) Fits(sort string) bool {
+	if k.Sort != sort {
+		return false
+	}
+	switch sort {
+	case "popular":
+		return k.Int != nil
+	case "alphabetical":
+		return k.Text != nil
+	default:
+		return k.Time != nil
+	}
+}
+
+// key returns the sort key that is set
+func (k *Keyset) key() interface{} {
+	switch {
+	case k.Time != nil:
+		return *k.Time
+	case k.Int != nil:
+		return *k.Int
+	case k.Text != nil:
+		return *k.Text
+	}
+	return nil
+}
+
+// afterKeyset limits a query ordered by column and then idColumn, both
+// descending when desc is set, to the items after the keyset
+func afterKeyset(query *gorm.DB, column, idColumn string, desc bool, after *Keyset) *gorm.DB {
+	op := ">"
+	if desc {
+		op = "<"
+	}
+	key := after.key()
+	return query.Where("("+column+" "+op+" ? OR ("+column+" = ? AND "+idColumn+" "+op+" ?))", key, key, after.ID)
+}
+
+// orderKeyset orders a query by column and then idColumn, both descending when desc is set
+func orderKeyset(query *gorm.DB, column, idColumn string, desc bool) *gorm.DB {
+	direction := " ASC"
+	if desc {
+		direction = " DESC"
+	}
+	return query.Order(column + direction).Order(idColumn + direction)
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReadingRepository defines the interface for reading history data access
+type ReadingRepository interface {
+	FindProgress(userID, articleID uuid.UUID) (*models.ReadingProgress, error)
+	SaveProgress(progress *models.ReadingProgress) error
+	CountRead(userID, articleID uuid.UUID) (bool, error)
+	FindHistory(userID uuid.UUID, completed *bool, limit, offset int) ([]models.ReadingProgress, int64, error)
+	DeleteEntry(userID, id uuid.UUID) (bool, error)
+	DeleteHistory(userID uuid.UUID) error
+}
+
+type readingRepository struct {
+	db *gorm.DB
+}
+
+// NewReadingRepository creates a new reading repository
+func NewReadingRepository(db *gorm.DB) ReadingRepository {
+	return &readingRepository{db: db}
+}
+
+// FindProgress finds a user's progress on an article
+func (r *readingRepository) FindProgress(userID, articleID uuid.UUID) (*models.ReadingProgress, error) {
+	var progress models.ReadingProgress
+	err := r.db.Where("user_id = ? AND article_id = ?", userID, articleID).First(&progress).Error
+	if err != nil {
+		return nil, err
+	}
+	return &progress, nil
+}
+
+// SaveProgress creates or updates a progress entry
+func (r *readingRepository) SaveProgress(progress *models.ReadingProgress) error {
+	if progress.ID == uuid.Nil {
+		return r.db.Omit("User", "Article").Create(progress).Error
+	}
+	return r.db.Omit("User", "Article").Save(progress).Error
+}
+
+// CountRead adds the user's read to the article's read count, unless it was
+// counted before, and reports whether it was counted now. Counted reads are
+// kept apart from the history, so clearing the history can't count them again.
+func (r *readingRepository) CountRead(userID, articleID uuid.UUID) (bool, error) {
+	counted := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.ArticleRead{UserID: userID, ArticleID: articleID})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		counted = true
+		return tx.Model(&models.Article{}).Where("id = ?", articleID).
+			Update("read_count", gorm.Expr("read_count + 1")).Error
+	})
+	return counted, err
+}
+
+// FindHistory returns a user's reading history, most recently read first.
+// completed limits it to finished (true) or unfinished (false) articles.
+// Entries for deleted or unpublished articles are left out.
+func (r *readingRepository) FindHistory(userID uuid.UUID, completed *bool, limit, offset int) ([]models.ReadingProgress, int64, error) {
+	var history []models.ReadingProgress
+	var total int64
+
+	readable := r.db.Model(&models.Article{}).Select("id").Where("status = ?", models.StatusPublished)
+	query := r.db.Model(&models.ReadingProgress{}).
+		Where("user_id = ?", userID).
+		Where("article_id IN (?)", readable)
+	if completed != nil {
+		if *completed {
+			query = query.Where("completed_at IS NOT NULL")
+		} else {
+			query = query.Where("completed_at IS NULL")
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Article.Author").
+		Order("last_read_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&history).Error
+
+	return history, total, err
+}
+
+// DeleteEntry removes one entry from a user's history, reporting whether it existed
+func (r *readingRepository) DeleteEntry(userID, id uuid.UUID) (bool, error) {
+	result := r.db.Delete(&models.ReadingProgress{}, "id = ? AND user_id = ?", id, userID)
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteHistory removes a user's whole reading history
+func (r *readingRepository) DeleteHistory(userID uuid.UUID) error {
+	return r.db.Delete(&models.ReadingProgress{}, "user_id = ?", userID).Error
+}
@@ -0,0 +1,164 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ReadingRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     ReadingRepository
+	readerID uuid.UUID
+	authorID uuid.UUID
+}
+
+func (suite *ReadingRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewReadingRepository(suite.db)
+}
+
+func (suite *ReadingRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+	author := &models.User{Username: "author", Email: "author@example.com", PasswordHash: "hash", Role: models.RoleAuthor}
+	reader := &models.User{Username: "reader", Email: "reader@example.com", PasswordHash: "hash", Role: models.RoleReader}
+	suite.Require().NoError(suite.db.Create(author).Error)
+	suite.Require().NoError(suite.db.Create(reader).Error)
+	suite.authorID = author.ID
+	suite.readerID = reader.ID
+}
+
+func TestReadingRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ReadingRepositoryTestSuite))
+}
+
+func (suite *ReadingRepositoryTestSuite) newArticle(slug string, status models.ArticleStatus) *models.Article {
+	publishedAt := time.Now().Add(-time.Hour)
+	article := &models.Article{
+		Title:       slug,
+		Slug:        slug,
+		Content:     "Content",
+		AuthorID:    suite.authorID,
+		Status:      status,
+		PublishedAt: &publishedAt,
+	}
+	suite.Require().NoError(suite.db.Create(article).Error)
+	return article
+}
+
+func (suite *ReadingRepositoryTestSuite) read(article *models.Article, lastReadAt time.Time, completed bool) *models.ReadingProgress {
+	progress := &models.ReadingProgress{UserID: suite.readerID, ArticleID: article.ID, Progress: 40, LastReadAt: lastReadAt}
+	if completed {
+		progress.CompletedAt = &lastReadAt
+	}
+	suite.Require().NoError(suite.repo.SaveProgress(progress))
+	return progress
+}
+
+func (suite *ReadingRepositoryTestSuite) TestSaveProgress_UpdatesExistingEntry() {
+	article := suite.newArticle("article", models.StatusPublished)
+	progress := suite.read(article, time.Now(), false)
+
+	progress.Progress = 75
+	progress.TimeSpentSeconds = 120
+	suite.Require().NoError(suite.repo.SaveProgress(progress))
+
+	found, err := suite.repo.FindProgress(suite.readerID, article.ID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), progress.ID, found.ID)
+	assert.Equal(suite.T(), 75, found.Progress)
+	assert.Equal(suite.T(), 120, found.TimeSpentSeconds)
+}
+
+func (suite *ReadingRepositoryTestSuite) TestFindHistory_RecentFirstAndFiltered() {
+	now := time.Now()
+	older := suite.newArticle("older", models.StatusPublished)
+	newer := suite.newArticle("newer", models.StatusPublished)
+	finished := suite.newArticle("finished", models.StatusPublished)
+	unpublished := suite.newArticle("unpublished", models.StatusArchived)
+	suite.read(older, now.Add(-2*time.Hour), false)
+	suite.read(newer, now.Add(-time.Hour), false)
+	suite.read(finished, now, true)
+	suite.read(unpublished, now, false)
+
+	history, total, err := suite.repo.FindHistory(suite.readerID, nil, 10, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), total)
+	assert.Equal(suite.T(), "finished", history[0].Article.Slug)
+	assert.Equal(suite.T(), "author", history[0].Article.Author.Username)
+
+	reading := false
+	history, total, err = suite.repo.FindHistory(suite.readerID, &reading, 10, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	assert.Equal(suite.T(), "newer", history[0].Article.Slug)
+	assert.Equal(suite.T(), "older", history[1].Article.Slug)
+}
+
+func (suite *ReadingRepositoryTestSuite) TestDeleteEntryAndHistory() {
+	first := suite.read(suite.newArticle("first", models.StatusPublished), time.Now(), false)
+	suite.read(suite.newArticle("second", models.StatusPublished), time.Now(), false)
+
+	deleted, err := suite.repo.DeleteEntry(suite.authorID, first.ID)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), deleted, "only the owner can delete an entry")
+
+	deleted, err = suite.repo.DeleteEntry(suite.readerID, first.ID)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), deleted)
+
+	suite.Require().NoError(suite.repo.DeleteHistory(suite.readerID))
+	_, total, err := suite.repo.FindHistory(suite.readerID, nil, 10, 0)
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+}
+
+func (suite *ReadingRepositoryTestSuite) TestArticleFilters_ExcludeReadBy() {
+	articles := NewArticleRepository(suite.db)
+	unread := suite.newArticle("unread", models.StatusPublished)
+	started := suite.newArticle("started", models.StatusPublished)
+	finished := suite.newArticle("finished", models.StatusPublished)
+	suite.read(started, time.Now(), false)
+	suite.read(finished, time.Now(), true)
+
+	found, total, err := articles.FindForUser(suite.readerID, []uuid.UUID{suite.authorID}, nil, ArticleFilters{
+		Status:        string(models.StatusPublished),
+		ExcludeReadBy: &suite.readerID,
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), total)
+	slugs := []string{found[0].Slug, found[1].Slug}
+	assert.ElementsMatch(suite.T(), []string{unread.Slug, started.Slug}, slugs)
+}
+
+func (suite *ReadingRepositoryTestSuite) TestCountRead_OncePerReader() {
+	articles := NewArticleRepository(suite.db)
+	article := suite.newArticle("counted", models.StatusPublished)
+	suite.read(article, time.Now(), true)
+
+	counted, err := suite.repo.CountRead(suite.readerID, article.ID)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), counted)
+
+	// Clearing the history doesn't let the same read be counted again
+	suite.Require().NoError(suite.repo.DeleteHistory(suite.readerID))
+	counted, err = suite.repo.CountRead(suite.readerID, article.ID)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), counted)
+
+	counted, err = suite.repo.CountRead(suite.authorID, article.ID)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), counted)
+
+	reloaded, err := articles.FindByID(article.ID)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, reloaded.ReadCount)
+}
@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RelatedArticleRepository defines the interface for precomputed related article data access
+type RelatedArticleRepository interface {
+	FindRankingSources() ([]models.Article, error)
+	CountLikes() (map[uuid.UUID]int, error)
+	ReplaceAll(related []models.RelatedArticle) error
+	FindRelated(articleID uuid.UUID, limit int) ([]models.Article, error)
+}
+
+type relatedArticleRepository struct {
+	db *gorm.DB
+}
+
+// NewRelatedArticleRepository creates a new related article repository
+func NewRelatedArticleRepository(db *gorm.DB) RelatedArticleRepository {
+	return &relatedArticleRepository{db: db}
+}
+
+// FindRankingSources returns every article that is live now, with its tags and categories
+func (r *relatedArticleRepository) FindRankingSources() ([]models.Article, error) {
+	var articles []models.Article
+	err := r.db.
+		Select("id", "title", "content", "content_html", "locale", "visibility", "published_at", "view_count").
+		Where("status = ? AND published_at <= ?", models.StatusPublished, time.Now()).
+		Preload("Tags").
+		Preload("Categories").
+		Find(&articles).Error
+	return articles, err
+}
+
+// CountLikes returns the number of likes of every liked article
+func (r *relatedArticleRepository) CountLikes() (map[uuid.UUID]int, error) {
+	var rows []struct {
+		ArticleID uuid.UUID
+		Likes     int
+	}
+	err := r.db.Model(&models.Like{}).
+		Select("article_id, COUNT(*) AS likes").
+		Group("article_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	likes := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		likes[row.ArticleID] = row.Likes
+	}
+	return likes, nil
+}
+
+// ReplaceAll replaces every stored suggestion in one transaction, so readers
+// never see a half-written ranking
+func (r *relatedArticleRepository) ReplaceAll(related []models.RelatedArticle) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&models.RelatedArticle{}).Error; err != nil {
+			return err
+		}
+		if len(related) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(related, 500).Error
+	})
+}
+
+// FindRelated returns the stored suggestions for an article, best first.
+// Suggestions that have since been unpublished or unlisted are skipped.
+func (r *relatedArticleRepository) FindRelated(articleID uuid.UUID, limit int) ([]models.Article, error) {
+	var articles []models.Article
+
+	query := r.db.Model(&models.Article{}).
+		Joins("JOIN related_articles ON related_articles.related_id = articles.id").
+		Where("related_articles.article_id = ?", articleID).
+		Where("articles.status = ?", models.StatusPublished)
+	query = whereListed(query, nil)
+
+	err := query.
+		Order("related_articles.rank ASC").
+		Limit(limit).
+		Preload("Author").
+		Preload("Categories").
+		Preload("Tags").
+		Find(&articles).Error
+
+	return articles, err
+}
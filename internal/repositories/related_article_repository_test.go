@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type RelatedArticleRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     RelatedArticleRepository
+	authorID uuid.UUID
+}
+
+func (suite *RelatedArticleRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewRelatedArticleRepository(suite.db)
+}
+
+func (suite *RelatedArticleRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+	author := &models.User{Username: "author", Email: "author@example.com", PasswordHash: "hash", Role: models.RoleAuthor}
+	suite.Require().NoError(suite.db.Create(author).Error)
+	suite.authorID = author.ID
+}
+
+func TestRelatedArticleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RelatedArticleRepositoryTestSuite))
+}
+
+func (suite *RelatedArticleRepositoryTestSuite) newArticle(slug string, status models.ArticleStatus, visibility models.ArticleVisibility, publishedAt time.Time) *models.Article {
+	article := &models.Article{
+		Title:       slug,
+		Slug:        slug,
+		Content:     "Content",
+		AuthorID:    suite.authorID,
+		Status:      status,
+		Visibility:  visibility,
+		PublishedAt: &publishedAt,
+	}
+	suite.Require().NoError(suite.db.Create(article).Error)
+	return article
+}
+
+func (suite *RelatedArticleRepositoryTestSuite) TestFindRankingSources_LiveArticlesOnly() {
+	past := time.Now().Add(-time.Hour)
+	suite.newArticle("live", models.StatusPublished, models.VisibilityUnlisted, past)
+	suite.newArticle("draft", models.StatusDraft, models.VisibilityPublic, past)
+	suite.newArticle("scheduled", models.StatusPublished, models.VisibilityPublic, time.Now().Add(time.Hour))
+
+	articles, err := suite.repo.FindRankingSources()
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), articles, 1)
+	assert.Equal(suite.T(), "live", articles[0].Title)
+}
+
+func (suite *RelatedArticleRepositoryTestSuite) TestReplaceAll_FindRelatedInRankOrder() {
+	past := time.Now().Add(-time.Hour)
+	source := suite.newArticle("source", models.StatusPublished, models.VisibilityPublic, past)
+	first := suite.newArticle("first", models.StatusPublished, models.VisibilityPublic, past)
+	second := suite.newArticle("second", models.StatusPublished, models.VisibilityMembers, past)
+	unlisted := suite.newArticle("unlisted", models.StatusPublished, models.VisibilityUnlisted, past)
+	stale := suite.newArticle("stale", models.StatusPublished, models.VisibilityPublic, past)
+
+	suite.Require().NoError(suite.repo.ReplaceAll([]models.RelatedArticle{
+		{ArticleID: source.ID, RelatedID: stale.ID, Rank: 1, Score: 0.9, ComputedAt: past},
+	}))
+	suite.Require().NoError(suite.repo.ReplaceAll([]models.RelatedArticle{
+		{ArticleID: source.ID, RelatedID: second.ID, Rank: 2, Score: 0.5, ComputedAt: past},
+		{ArticleID: source.ID, RelatedID: unlisted.ID, Rank: 3, Score: 0.4, ComputedAt: past},
+		{ArticleID: source.ID, RelatedID: first.ID, Rank: 1, Score: 0.8, ComputedAt: past},
+	}))
+
+	articles, err := suite.repo.FindRelated(source.ID, 10)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), articles, 2)
+	assert.Equal(suite.T(), "first", articles[0].Slug)
+	assert.Equal(suite.T(), "second", articles[1].Slug)
+}
+
+func (suite *RelatedArticleRepositoryTestSuite) TestCountLikes() {
+	articleID := uuid.New()
+	suite.db.Create(&models.Like{UserID: uuid.New(), ArticleID: articleID})
+	suite.db.Create(&models.Like{UserID: uuid.New(), ArticleID: articleID})
+
+	likes, err := suite.repo.CountLikes()
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, likes[articleID])
+}
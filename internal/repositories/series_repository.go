@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SeriesRepository defines the interface for series data access
+type SeriesRepository interface {
+	Create(series *models.Series) error
+	FindByID(id uuid.UUID) (*models.Series, error)
+	FindBySlug(slug string) (*models.Series, error)
+	FindByArticle(articleID uuid.UUID) (*models.Series, error)
+	FindAll(filters SeriesFilters) ([]models.Series, int64, error)
+	Update(series *models.Series) error
+	Delete(id uuid.UUID) error
+	ExistsBySlug(slug string) (bool, error)
+	SetArticles(seriesID uuid.UUID, articleIDs []uuid.UUID) error
+	// WithTx returns a new repository instance using the provided transaction
+	WithTx(tx *gorm.DB) SeriesRepository
+}
+
+// SeriesFilters contains filter options for querying series
+type SeriesFilters struct {
+	AuthorID *uuid.UUID
+	Limit    int
+	Offset   int
+}
+
+type seriesRepository struct {
+	db *gorm.DB
+}
+
+// NewSeriesRepository creates a new series repository
+func NewSeriesRepository(db *gorm.DB) SeriesRepository {
+	return &seriesRepository{db: db}
+}
+
+// WithTx returns a new repository instance using the provided transaction
+func (r *seriesRepository) WithTx(tx *gorm.DB) SeriesRepository {
+	return &seriesRepository{db: tx}
+}
+
+// Create creates a new series
+func (r *seriesRepository) Create(series *models.Series) error {
+	return r.db.Create(series).Error
+}
+
+// FindByID finds a series by ID with its ordered parts
+func (r *seriesRepository) FindByID(id uuid.UUID) (*models.Series, error) {
+	var series models.Series
+	err := r.withParts(r.db).First(&series, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// FindBySlug finds a series by slug with its ordered parts
+func (r *seriesRepository) FindBySlug(slug string) (*models.Series, error) {
+	var series models.Series
+	err := r.withParts(r.db).First(&series, "slug = ?", slug).Error
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// FindByArticle finds the series an article belongs to
+func (r *seriesRepository) FindByArticle(articleID uuid.UUID) (*models.Series, error) {
+	var series models.Series
+	err := r.withParts(r.db).
+		Joins("JOIN series_articles ON series_articles.series_id = series.id").
+		Where("series_articles.article_id = ?", articleID).
+		First(&series).Error
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// FindAll finds all series with filters
+func (r *seriesRepository) FindAll(filters SeriesFilters) ([]models.Series, int64, error) {
+	var series []models.Series
+	var total int64
+
+	query := r.db.Model(&models.Series{})
+	if filters.AuthorID != nil {
+		query = query.Where("author_id = ?", *filters.AuthorID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	err := r.withParts(query).Order("created_at DESC").Find(&series).Error
+	return series, total, err
+}
+
+// Update updates a series
+func (r *seriesRepository) Update(series *models.Series) error {
+	return r.db.Omit("Parts", "Author").Save(series).Error
+}
+
+// Delete removes a series' parts and soft deletes the series
+func (r *seriesRepository) Delete(id uuid.UUID) error {
+	if err := r.db.Where("series_id = ?", id).Delete(&models.SeriesArticle{}).Error; err != nil {
+		return err
+	}
+	return r.db.Delete(&models.Series{}, "id = ?", id).Error
+}
+
+// ExistsBySlug checks if a series exists with the given slug, including deleted ones
+func (r *seriesRepository) ExistsBySlug(slug string) (bool, error) {
+	var count int64
+	err := r.db.Unscoped().Model(&models.Series{}).Where("slug = ?", slug).Count(&count).Error
+	return count > 0, err
+}
+
+// SetArticles replaces the ordered list of articles in a series
+func (r *seriesRepository) SetArticles(seriesID uuid.UUID, articleIDs []uuid.UUID) error {
+	if err := r.db.Where("series_id = ?", seriesID).Delete(&models.SeriesArticle{}).Error; err != nil {
+		return err
+	}
+	if len(articleIDs) == 0 {
+		return nil
+	}
+
+	parts := make([]models.SeriesArticle, len(articleIDs))
+	for i, articleID := range articleIDs {
+		parts[i] = models.SeriesArticle{
+			SeriesID:  seriesID,
+			ArticleID: articleID,
+			Position:  i + 1,
+		}
+	}
+	return r.db.Create(&parts).Error
+}
+
+// withParts preloads the author and the ordered parts of a series
+func (r *seriesRepository) withParts(query *gorm.DB) *gorm.DB {
+	return query.
+		Preload("Author").
+		Preload("Parts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("series_articles.position ASC")
+		}).
+		Preload("Parts.Article")
+}
@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SlugHistoryRepository defines the interface for slug history data access
+type SlugHistoryRepository interface {
+	Record(entityType models.SlugEntityType, entityID uuid.UUID, oldSlug string) error
+	FindByOldSlug(entityType models.SlugEntityType, slug string) (*models.SlugHistory, error)
+	// WithTx returns a new repository instance using the provided transaction
+	WithTx(tx *gorm.DB) SlugHistoryRepository
+}
+
+type slugHistoryRepository struct {
+	db *gorm.DB
+}
+
+// NewSlugHistoryRepository creates a new slug history repository
+func NewSlugHistoryRepository(db *gorm.DB) SlugHistoryRepository {
+	return &slugHistoryRepository{db: db}
+}
+
+// WithTx returns a new repository instance using the provided transaction
+func (r *slugHistoryRepository) WithTx(tx *gorm.DB) SlugHistoryRepository {
+	return &slugHistoryRepository{db: tx}
+}
+
+// Record stores a retired slug. If another resource of the same type used the
+// slug before, the entry is reassigned to the most recent owner.
+func (r *slugHistoryRepository) Record(entityType models.SlugEntityType, entityID uuid.UUID, oldSlug string) error {
+	entry := &models.SlugHistory{
+		EntityType: entityType,
+		EntityID:   entityID,
+		OldSlug:    oldSlug,
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity_type"}, {Name: "old_slug"}},
+		DoUpdates: clause.AssignmentColumns([]string{"entity_id", "created_at"}),
+	}).Create(entry).Error
+}
+
+// FindByOldSlug finds the history entry for a retired slug
+func (r *slugHistoryRepository) FindByOldSlug(entityType models.SlugEntityType, slug string) (*models.SlugHistory, error) {
+	var entry models.SlugHistory
+	err := r.db.Where("entity_type = ? AND old_slug = ?", entityType, slug).First(&entry).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
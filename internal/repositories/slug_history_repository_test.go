@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type SlugHistoryRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo SlugHistoryRepository
+}
+
+func (suite *SlugHistoryRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewSlugHistoryRepository(suite.db)
+}
+
+func (suite *SlugHistoryRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+}
+
+func TestSlugHistoryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(SlugHistoryRepositoryTestSuite))
+}
+
+func (suite *SlugHistoryRepositoryTestSuite) TestRecord_AndFind() {
+	articleID := uuid.New()
+
+	err := suite.repo.Record(models.SlugEntityArticle, articleID, "old-title")
+	assert.NoError(suite.T(), err)
+
+	entry, err := suite.repo.FindByOldSlug(models.SlugEntityArticle, "old-title")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), articleID, entry.EntityID)
+}
+
+func (suite *SlugHistoryRepositoryTestSuite) TestRecord_ReassignsToLatestOwner() {
+	first := uuid.New()
+	second := uuid.New()
+
+	assert.NoError(suite.T(), suite.repo.Record(models.SlugEntityTag, first, "golang"))
+	assert.NoError(suite.T(), suite.repo.Record(models.SlugEntityTag, second, "golang"))
+
+	entry, err := suite.repo.FindByOldSlug(models.SlugEntityTag, "golang")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), second, entry.EntityID)
+}
+
+func (suite *SlugHistoryRepositoryTestSuite) TestFindByOldSlug_ScopedByEntityType() {
+	assert.NoError(suite.T(), suite.repo.Record(models.SlugEntityCategory, uuid.New(), "news"))
+
+	_, err := suite.repo.FindByOldSlug(models.SlugEntityTag, "news")
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+}
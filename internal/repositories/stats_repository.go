@@ -0,0 +1,274 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StatsRepository defines the interface for view and daily statistics data access
+type StatsRepository interface {
+	RecordVisit(articleID uuid.UUID, visitorKey string, now time.Time, window time.Duration) (bool, error)
+	RollupDay(day time.Time) (int, error)
+	RecordReferrer(articleID uuid.UUID, source string, now time.Time) error
+	PruneVisits(before time.Time) (int64, error)
+	FindDailyTotals(scope StatsScope, from, to time.Time) ([]models.ArticleDailyStat, error)
+	FindTopArticles(authorID uuid.UUID, from, to time.Time, limit int) ([]ArticleStatsTotal, error)
+	FindTopReferrers(scope StatsScope, from, to time.Time, limit int) ([]ReferrerCount, error)
+	CountFollowers(userID uuid.UUID, before time.Time) (int64, error)
+	FindFollowDates(userID uuid.UUID, from time.Time) ([]time.Time, error)
+}
+
+// StatsScope limits statistics to one article or to all of an author's articles
+type StatsScope struct {
+	ArticleID *uuid.UUID
+	AuthorID  *uuid.UUID
+}
+
+// ArticleStatsTotal holds an article's totals over a range of days
+type ArticleStatsTotal struct {
+	ArticleID      uuid.UUID
+	Title          string
+	Slug           string
+	Views          int
+	UniqueVisitors int
+	Reads          int
+	Likes          int
+	Comments       int
+	Bookmarks      int
+}
+
+// ReferrerCount holds the views an article or author got from one referring site
+type ReferrerCount struct {
+	Source string
+	Views  int
+}
+
+type statsRepository struct {
+	db *gorm.DB
+}
+
+// NewStatsRepository creates a new stats repository
+func NewStatsRepository(db *gorm.DB) StatsRepository {
+	return &statsRepository{db: db}
+}
+
+// RecordVisit records a view by a visitor and reports whether it counts.
+// The visitor's first view of the day counts, and so does each later view
+// made at least window after the last counted one.
+func (r *statsRepository) RecordVisit(articleID uuid.UUID, visitorKey string, now time.Time, window time.Duration) (bool, error) {
+	day := models.StatsDay(now)
+	visit := &models.ArticleVisit{
+		ArticleID:    articleID,
+		VisitorKey:   visitorKey,
+		Day:          day,
+		Views:        1,
+		LastViewedAt: now,
+	}
+
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(visit)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return true, nil
+	}
+
+	result = r.db.Model(&models.ArticleVisit{}).
+		Where("article_id = ? AND visitor_key = ? AND day = ?", articleID, visitorKey, day).
+		Where("last_viewed_at <= ?", now.Add(-window)).
+		Updates(map[string]interface{}{
+			"views":          gorm.Expr("views + 1"),
+			"last_viewed_at": now,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// countRow is one article's count in an aggregate query
+type countRow struct {
+	ArticleID uuid.UUID
+	Count     int
+}
+
+// RollupDay recomputes every article's totals for a day from the visits,
+// finished reads, likes, comments and bookmarks made that day, replacing the stored
+// totals. It returns the number of articles with activity that day.
+func (r *statsRepository) RollupDay(day time.Time) (int, error) {
+	day = models.StatsDay(day)
+	end := day.AddDate(0, 0, 1)
+
+	stats := make(map[uuid.UUID]*models.ArticleDailyStat)
+	stat := func(articleID uuid.UUID) *models.ArticleDailyStat {
+		if stats[articleID] == nil {
+			stats[articleID] = &models.ArticleDailyStat{ArticleID: articleID, Day: day}
+		}
+		return stats[articleID]
+	}
+
+	var visits []struct {
+		ArticleID uuid.UUID
+		Views     int
+		Visitors  int
+	}
+	err := r.db.Model(&models.ArticleVisit{}).
+		Select("article_id, SUM(views) AS views, COUNT(*) AS visitors").
+		Where("day = ?", day).
+		Group("article_id").
+		Scan(&visits).Error
+	if err != nil {
+		return 0, err
+	}
+	for _, visit := range visits {
+		s := stat(visit.ArticleID)
+		s.Views = visit.Views
+		s.UniqueVisitors = visit.Visitors
+	}
+
+	counts := []struct {
+		model  interface{}
+		column string
+		apply  func(s *models.ArticleDailyStat, count int)
+	}{
+		{&models.ArticleRead{}, "created_at", func(s *models.ArticleDailyStat, n int) { s.Reads = n }},
+		{&models.Like{}, "created_at", func(s *models.ArticleDailyStat, n int) { s.Likes = n }},
+		{&models.Comment{}, "created_at", func(s *models.ArticleDailyStat, n int) { s.Comments = n }},
+		{&models.Bookmark{}, "created_at", func(s *models.ArticleDailyStat, n int) { s.Bookmarks = n }},
+	}
+	for _, c := range counts {
+		var rows []countRow
+		err := r.db.Model(c.model).
+			Select("article_id, COUNT(*) AS count").
+			Where(c.column+" >= ? AND "+c.column+" < ?", day, end).
+			Group("article_id").
+			Scan(&rows).Error
+		if err != nil {
+			return 0, err
+		}
+		for _, row := range rows {
+			c.apply(stat(row.ArticleID), row.Count)
+		}
+	}
+
+	rows := make([]models.ArticleDailyStat, 0, len(stats))
+	for _, s := range stats {
+		rows = append(rows, *s)
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("day = ?", day).Delete(&models.ArticleDailyStat{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(rows, 500).Error
+	})
+	return len(rows), err
+}
+
+// PruneVisits deletes visits from before the given day, returning how many were deleted
+func (r *statsRepository) PruneVisits(before time.Time) (int64, error) {
+	result := r.db.Where("day < ?", models.StatsDay(before)).Delete(&models.ArticleVisit{})
+	return result.RowsAffected, result.Error
+}
+
+// RecordReferrer adds a view from another site to the article's referrers for the day
+func (r *statsRepository) RecordReferrer(articleID uuid.UUID, source string, now time.Time) error {
+	referrer := &models.ArticleReferrer{
+		ArticleID: articleID,
+		Day:       models.StatsDay(now),
+		Source:    source,
+		Views:     1,
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "article_id"}, {Name: "day"}, {Name: "source"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"views": gorm.Expr("article_referrers.views + 1")}),
+	}).Create(referrer).Error
+}
+
+// scoped limits a query on a table with article_id and day columns to the scope and range of days
+func (r *statsRepository) scoped(table string, scope StatsScope, from, to time.Time) *gorm.DB {
+	query := r.db.Table(table).
+		Where(table+".day >= ? AND "+table+".day <= ?", models.StatsDay(from), models.StatsDay(to))
+	if scope.ArticleID != nil {
+		query = query.Where(table+".article_id = ?", *scope.ArticleID)
+	}
+	if scope.AuthorID != nil {
+		query = query.
+			Joins("JOIN articles ON articles.id = "+table+".article_id").
+			Where("articles.author_id = ? AND articles.deleted_at IS NULL", *scope.AuthorID)
+	}
+	return query
+}
+
+// FindDailyTotals returns the totals for each day between two days, inclusive,
+// summed over the articles in scope, oldest first. Days without activity have no row.
+func (r *statsRepository) FindDailyTotals(scope StatsScope, from, to time.Time) ([]models.ArticleDailyStat, error) {
+	var stats []models.ArticleDailyStat
+	err := r.scoped("article_daily_stats", scope, from, to).
+		Select(`article_daily_stats.day,
+			SUM(article_daily_stats.views) AS views,
+			SUM(article_daily_stats.unique_visitors) AS unique_visitors,
+			SUM(article_daily_stats.reads) AS reads,
+			SUM(article_daily_stats.likes) AS likes,
+			SUM(article_daily_stats.comments) AS comments,
+			SUM(article_daily_stats.bookmarks) AS bookmarks`).
+		Group("article_daily_stats.day").
+		Order("article_daily_stats.day ASC").
+		Scan(&stats).Error
+	return stats, err
+}
+
+// FindTopArticles returns an author's most viewed articles between two days, inclusive
+func (r *statsRepository) FindTopArticles(authorID uuid.UUID, from, to time.Time, limit int) ([]ArticleStatsTotal, error) {
+	var totals []ArticleStatsTotal
+	err := r.scoped("article_daily_stats", StatsScope{AuthorID: &authorID}, from, to).
+		Select(`article_daily_stats.article_id, articles.title, articles.slug,
+			SUM(article_daily_stats.views) AS views,
+			SUM(article_daily_stats.unique_visitors) AS unique_visitors,
+			SUM(article_daily_stats.reads) AS reads,
+			SUM(article_daily_stats.likes) AS likes,
+			SUM(article_daily_stats.comments) AS comments,
+			SUM(article_daily_stats.bookmarks) AS bookmarks`).
+		Group("article_daily_stats.article_id, articles.title, articles.slug").
+		Order("views DESC, reads DESC").
+		Limit(limit).
+		Scan(&totals).Error
+	return totals, err
+}
+
+// FindTopReferrers returns the sites that sent the most views to the articles
+// in scope between two days, inclusive
+func (r *statsRepository) FindTopReferrers(scope StatsScope, from, to time.Time, limit int) ([]ReferrerCount, error) {
+	var referrers []ReferrerCount
+	err := r.scoped("article_referrers", scope, from, to).
+		Select("article_referrers.source, SUM(article_referrers.views) AS views").
+		Group("article_referrers.source").
+		Order("views DESC, article_referrers.source ASC").
+		Limit(limit).
+		Scan(&referrers).Error
+	return referrers, err
+}
+
+// CountFollowers counts the user's followers who followed them before the given time
+func (r *statsRepository) CountFollowers(userID uuid.UUID, before time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.UserFollow{}).
+		Where("following_id = ? AND created_at < ?", userID, before).
+		Count(&count).Error
+	return count, err
+}
+
+// FindFollowDates returns when each of the user's current followers followed
+// them, for follows since the given time, oldest first
+func (r *statsRepository) FindFollowDates(userID uuid.UUID, from time.Time) ([]time.Time, error) {
+	var dates []time.Time
+	err := r.db.Model(&models.UserFollow{}).
+		Where("following_id = ? AND created_at >= ?", userID, from).
+		Order("created_at ASC").
+		Pluck("created_at", &dates).Error
+	return dates, err
+}
@@ -0,0 +1,200 @@
+package repositories
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type StatsRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     StatsRepository
+	authorID uuid.UUID
+	readerID uuid.UUID
+	article  *models.Article
+}
+
+func (suite *StatsRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewStatsRepository(suite.db)
+}
+
+func (suite *StatsRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+	author := &models.User{Username: "author", Email: "author@example.com", PasswordHash: "hash", Role: models.RoleAuthor}
+	reader := &models.User{Username: "reader", Email: "reader@example.com", PasswordHash: "hash", Role: models.RoleReader}
+	suite.Require().NoError(suite.db.Create(author).Error)
+	suite.Require().NoError(suite.db.Create(reader).Error)
+	suite.authorID = author.ID
+	suite.readerID = reader.ID
+
+	suite.article = &models.Article{Title: "Article", Slug: "article", Content: "Content", AuthorID: author.ID, Status: models.StatusPublished}
+	suite.Require().NoError(suite.db.Create(suite.article).Error)
+}
+
+func TestStatsRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(StatsRepositoryTestSuite))
+}
+
+func (suite *StatsRepositoryTestSuite) TestRecordVisit_CountsOncePerWindow() {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	window := 30 * time.Minute
+
+	counted, err := suite.repo.RecordVisit(suite.article.ID, "visitor", now, window)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), counted)
+
+	counted, err = suite.repo.RecordVisit(suite.article.ID, "visitor", now.Add(10*time.Minute), window)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), counted, "repeat view inside the window should not count")
+
+	counted, err = suite.repo.RecordVisit(suite.article.ID, "visitor", now.Add(45*time.Minute), window)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), counted, "view after the window should count")
+
+	counted, err = suite.repo.RecordVisit(suite.article.ID, "other", now.Add(46*time.Minute), window)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), counted)
+
+	var visit models.ArticleVisit
+	suite.Require().NoError(suite.db.Where("visitor_key = ?", "visitor").First(&visit).Error)
+	assert.Equal(suite.T(), 2, visit.Views)
+}
+
+func (suite *StatsRepositoryTestSuite) TestRollupDay_AggregatesActivity() {
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	window := 30 * time.Minute
+
+	_, err := suite.repo.RecordVisit(suite.article.ID, "a", day.Add(time.Hour), window)
+	suite.Require().NoError(err)
+	_, err = suite.repo.RecordVisit(suite.article.ID, "a", day.Add(3*time.Hour), window)
+	suite.Require().NoError(err)
+	_, err = suite.repo.RecordVisit(suite.article.ID, "b", day.Add(4*time.Hour), window)
+	suite.Require().NoError(err)
+	// A visit on the next day belongs to a different rollup
+	_, err = suite.repo.RecordVisit(suite.article.ID, "c", day.AddDate(0, 0, 1).Add(time.Hour), window)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.db.Create(&models.ArticleRead{
+		UserID: suite.readerID, ArticleID: suite.article.ID, CreatedAt: day.Add(5 * time.Hour),
+	}).Error)
+	suite.Require().NoError(suite.db.Create(&models.Like{
+		UserID: suite.readerID, ArticleID: suite.article.ID, CreatedAt: day.Add(6 * time.Hour),
+	}).Error)
+	suite.Require().NoError(suite.db.Create(&models.Bookmark{
+		UserID: suite.readerID, ArticleID: suite.article.ID, CreatedAt: day.Add(6 * time.Hour),
+	}).Error)
+	suite.Require().NoError(suite.db.Create(&models.Comment{
+		Content: "Nice", UserID: suite.readerID, ArticleID: suite.article.ID, CreatedAt: day.Add(7 * time.Hour),
+	}).Error)
+	suite.Require().NoError(suite.db.Create(&models.Comment{
+		Content: "Old", UserID: suite.readerID, ArticleID: suite.article.ID, CreatedAt: day.AddDate(0, 0, -1),
+	}).Error)
+
+	count, err := suite.repo.RollupDay(day.Add(12 * time.Hour))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, count)
+
+	// Rolling up again replaces rather than adds to the totals
+	_, err = suite.repo.RollupDay(day)
+	suite.Require().NoError(err)
+
+	stats, err := suite.repo.FindDailyTotals(StatsScope{ArticleID: &suite.article.ID}, day.AddDate(0, 0, -7), day)
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(stats, 1)
+	assert.Equal(suite.T(), 3, stats[0].Views)
+	assert.Equal(suite.T(), 2, stats[0].UniqueVisitors)
+	assert.Equal(suite.T(), 1, stats[0].Reads)
+	assert.Equal(suite.T(), 1, stats[0].Likes)
+	assert.Equal(suite.T(), 1, stats[0].Comments)
+	assert.Equal(suite.T(), 1, stats[0].Bookmarks)
+}
+
+func (suite *StatsRepositoryTestSuite) TestPruneVisits_DeletesOlderDays() {
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	_, err := suite.repo.RecordVisit(suite.article.ID, "old", day.AddDate(0, 0, -8), time.Minute)
+	suite.Require().NoError(err)
+	_, err = suite.repo.RecordVisit(suite.article.ID, "new", day, time.Minute)
+	suite.Require().NoError(err)
+
+	deleted, err := suite.repo.PruneVisits(day.AddDate(0, 0, -7))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), deleted)
+
+	var remaining int64
+	suite.db.Model(&models.ArticleVisit{}).Count(&remaining)
+	assert.Equal(suite.T(), int64(1), remaining)
+}
+
+func (suite *StatsRepositoryTestSuite) TestAuthorTotals_SumOverArticles() {
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	second := &models.Article{Title: "Second", Slug: "second", Content: "Content", AuthorID: suite.authorID, Status: models.StatusPublished}
+	other := &models.Article{Title: "Other", Slug: "other", Content: "Content", AuthorID: suite.readerID, Status: models.StatusPublished}
+	suite.Require().NoError(suite.db.Create(second).Error)
+	suite.Require().NoError(suite.db.Create(other).Error)
+
+	suite.Require().NoError(suite.db.Create(&[]models.ArticleDailyStat{
+		{ArticleID: suite.article.ID, Day: day, Views: 5, Reads: 2},
+		{ArticleID: suite.article.ID, Day: day.AddDate(0, 0, 1), Views: 1},
+		{ArticleID: second.ID, Day: day, Views: 8, Reads: 1},
+		{ArticleID: other.ID, Day: day, Views: 100},
+	}).Error)
+
+	totals, err := suite.repo.FindDailyTotals(StatsScope{AuthorID: &suite.authorID}, day, day.AddDate(0, 0, 1))
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(totals, 2)
+	assert.True(suite.T(), day.Equal(totals[0].Day.UTC()))
+	assert.Equal(suite.T(), 13, totals[0].Views)
+	assert.Equal(suite.T(), 3, totals[0].Reads)
+	assert.Equal(suite.T(), 1, totals[1].Views)
+
+	top, err := suite.repo.FindTopArticles(suite.authorID, day, day.AddDate(0, 0, 1), 10)
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(top, 2)
+	assert.Equal(suite.T(), second.ID, top[0].ArticleID)
+	assert.Equal(suite.T(), "second", top[0].Slug)
+	assert.Equal(suite.T(), 8, top[0].Views)
+	assert.Equal(suite.T(), 6, top[1].Views)
+}
+
+func (suite *StatsRepositoryTestSuite) TestReferrers_CountedAndRanked() {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	suite.Require().NoError(suite.repo.RecordReferrer(suite.article.ID, "google.com", now))
+	suite.Require().NoError(suite.repo.RecordReferrer(suite.article.ID, "news.ycombinator.com", now))
+	suite.Require().NoError(suite.repo.RecordReferrer(suite.article.ID, "news.ycombinator.com", now.Add(time.Hour)))
+	suite.Require().NoError(suite.repo.RecordReferrer(suite.article.ID, "news.ycombinator.com", now.AddDate(0, 0, 1)))
+
+	referrers, err := suite.repo.FindTopReferrers(StatsScope{AuthorID: &suite.authorID}, now, now.AddDate(0, 0, 1), 10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []ReferrerCount{{Source: "news.ycombinator.com", Views: 3}, {Source: "google.com", Views: 1}}, referrers)
+
+	referrers, err = suite.repo.FindTopReferrers(StatsScope{ArticleID: &suite.article.ID}, now, now, 1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []ReferrerCount{{Source: "news.ycombinator.com", Views: 2}}, referrers)
+}
+
+func (suite *StatsRepositoryTestSuite) TestFollowers_CountedBeforeAndListedAfter() {
+	from := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	for i, offset := range []time.Duration{-48 * time.Hour, 2 * time.Hour, 30 * time.Hour} {
+		follower := &models.User{Username: fmt.Sprintf("follower%d", i), Email: fmt.Sprintf("follower%d@example.com", i), PasswordHash: "hash", Role: models.RoleReader}
+		suite.Require().NoError(suite.db.Create(follower).Error)
+		suite.Require().NoError(suite.db.Create(&models.UserFollow{FollowerID: follower.ID, FollowingID: suite.authorID, CreatedAt: from.Add(offset)}).Error)
+	}
+
+	before, err := suite.repo.CountFollowers(suite.authorID, from)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), before)
+
+	dates, err := suite.repo.FindFollowDates(suite.authorID, from)
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(dates, 2)
+	assert.True(suite.T(), from.Add(2*time.Hour).Equal(dates[0]))
+}
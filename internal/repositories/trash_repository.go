@@ -0,0 +1,300 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TrashType identifies a kind of soft-deleted resource
+type TrashType string
+
+const (
+	TrashArticles TrashType = "articles"
+	TrashComments TrashType = "comments"
+	TrashUsers    TrashType = "users"
+)
+
+// IsValid checks if the trash type is known
+func (t TrashType) IsValid() bool {
+	switch t {
+	case TrashArticles, TrashComments, TrashUsers:
+		return true
+	}
+	return false
+}
+
+var (
+	// ErrCommentHasReplies is returned when purging a comment that still has live replies
+	ErrCommentHasReplies = errors.New("comment has replies that are not deleted")
+	// ErrUserHasContent is returned when purging a user who still owns articles, comments, media, series or revisions
+	ErrUserHasContent = errors.New("user still has content")
+)
+
+// TrashRepository defines the interface for finding, restoring and purging
+// soft-deleted articles, comments and users
+type TrashRepository interface {
+	FindArticles(limit, offset int) ([]models.Article, int64, error)
+	FindComments(limit, offset int) ([]models.Comment, int64, error)
+	FindUsers(limit, offset int) ([]models.User, int64, error)
+	FindArticle(id uuid.UUID) (*models.Article, error)
+	FindComment(id uuid.UUID) (*models.Comment, error)
+	FindUser(id uuid.UUID) (*models.User, error)
+	FindDeletedBefore(trashType TrashType, before time.Time, limit, offset int) ([]uuid.UUID, error)
+	RestoreArticle(id uuid.UUID, slug string) error
+	Restore(trashType TrashType, id uuid.UUID) error
+	PurgeArticle(id uuid.UUID) error
+	PurgeComment(id uuid.UUID) error
+	PurgeUser(id uuid.UUID) error
+	// WithTx returns a new repository instance using the provided transaction
+	WithTx(tx *gorm.DB) TrashRepository
+}
+
+type trashRepository struct {
+	db *gorm.DB
+}
+
+// NewTrashRepository creates a new trash repository
+func NewTrashRepository(db *gorm.DB) TrashRepository {
+	return &trashRepository{db: db}
+}
+
+// WithTx returns a new repository instance using the provided transaction
+func (r *trashRepository) WithTx(tx *gorm.DB) TrashRepository {
+	return &trashRepository{db: tx}
+}
+
+// deleted scopes a query to soft-deleted rows
+func (r *trashRepository) deleted() *gorm.DB {
+	return r.db.Unscoped().Where("deleted_at IS NOT NULL")
+}
+
+// unscoped preloads a relation including soft-deleted rows
+func unscoped(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
+// FindArticles finds soft-deleted articles, most recently deleted first
+func (r *trashRepository) FindArticles(limit, offset int) ([]models.Article, int64, error) {
+	var articles []models.Article
+	var total int64
+
+	if err := r.deleted().Model(&models.Article{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := r.deleted().
+		Preload("Author", unscoped).
+		Order("deleted_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&articles).Error
+
+	return articles, total, err
+}
+
+// FindComments finds soft-deleted comments, most recently deleted first
+func (r *trashRepository) FindComments(limit, offset int) ([]models.Comment, int64, error) {
+	var comments []models.Comment
+	var total int64
+
+	if err := r.deleted().Model(&models.Comment{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := r.deleted().
+		Preload("User", unscoped).
+		Order("deleted_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&comments).Error
+
+	return comments, total, err
+}
+
+// FindUsers finds soft-deleted users, most recently deleted first
+func (r *trashRepository) FindUsers(limit, offset int) ([]models.User, int64, error) {
+	var users []models.User
+	var total int64
+
+	if err := r.deleted().Model(&models.User{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := r.deleted().
+		Order("deleted_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&users).Error
+
+	return users, total, err
+}
+
+// FindArticle finds a soft-deleted article with its author and tags
+func (r *trashRepository) FindArticle(id uuid.UUID) (*models.Article, error) {
+	var article models.Article
+	err := r.deleted().
+		Preload("Author", unscoped).
+		Preload("Tags").
+		First(&article, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &article, nil
+}
+
+// FindComment finds a soft-deleted comment
+func (r *trashRepository) FindComment(id uuid.UUID) (*models.Comment, error) {
+	var comment models.Comment
+	err := r.deleted().
+		Preload("User", unscoped).
+		Preload("Article", unscoped).
+		Preload("Parent", unscoped).
+		First(&comment, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// FindUser finds a soft-deleted user
+func (r *trashRepository) FindUser(id uuid.UUID) (*models.User, error) {
+	var user models.User
+	if err := r.deleted().First(&user, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// FindDeletedBefore finds the IDs of resources deleted before a time, oldest first
+func (r *trashRepository) FindDeletedBefore(trashType TrashType, before time.Time, limit, offset int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.deleted().
+		Table(string(trashType)).
+		Where("deleted_at < ?", before).
+		Order("deleted_at ASC, id ASC").
+		Limit(limit).
+		Offset(offset).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// RestoreArticle undeletes an article under the given slug
+func (r *trashRepository) RestoreArticle(id uuid.UUID, slug string) error {
+	return r.deleted().
+		Model(&models.Article{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"deleted_at": nil, "slug": slug}).Error
+}
+
+// Restore undeletes a comment or user
+func (r *trashRepository) Restore(trashType TrashType, id uuid.UUID) error {
+	return r.deleted().
+		Table(string(trashType)).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error
+}
+
+// PurgeArticle permanently deletes a soft-deleted article along with its
+// comments, engagement, tag and category links and slug history
+func (r *trashRepository) PurgeArticle(id uuid.UUID) error {
+	queries := []string{
+		"DELETE FROM article_categories WHERE article_id = ?",
+		"DELETE FROM article_tags WHERE article_id = ?",
+		"DELETE FROM likes WHERE article_id = ?",
+		"DELETE FROM bookmarks WHERE article_id = ?",
+		"DELETE FROM highlights WHERE article_id = ?",
+		"DELETE FROM reading_progress WHERE article_id = ?",
+		"DELETE FROM article_reads WHERE article_id = ?",
+		"DELETE FROM article_visits WHERE article_id = ?",
+		"DELETE FROM article_daily_stats WHERE article_id = ?",
+		"DELETE FROM article_referrers WHERE article_id = ?",
+		"DELETE FROM article_trending WHERE article_id = ?",
+		"DELETE FROM related_articles WHERE article_id = ?",
+		"DELETE FROM related_articles WHERE related_id = ?",
+		"DELETE FROM article_fingerprints WHERE article_id = ?",
+		"DELETE FROM fingerprint_bands WHERE article_id = ?",
+		"DELETE FROM duplicate_flags WHERE article_id = ?",
+		"DELETE FROM duplicate_flags WHERE matched_article_id = ?",
+		"DELETE FROM notifications WHERE article_id = ?",
+		"DELETE FROM comments WHERE article_id = ?",
+		"DELETE FROM slug_history WHERE entity_id = ? AND entity_type = 'article'",
+		"DELETE FROM import_records WHERE entity_id = ? AND entity_type = 'article'",
+	}
+	for _, query := range queries {
+		if err := r.db.Exec(query, id).Error; err != nil {
+			return err
+		}
+	}
+
+	return r.deleted().Delete(&models.Article{}, "id = ?", id).Error
+}
+
+// PurgeComment permanently deletes a soft-deleted comment together with its
+// replies, which must all be deleted too
+func (r *trashRepository) PurgeComment(id uuid.UUID) error {
+	// Collect the whole reply tree, one level at a time
+	ids := []uuid.UUID{id}
+	for parents := ids; len(parents) > 0; {
+		var replies []models.Comment
+		if err := r.db.Unscoped().Select("id", "deleted_at").Where("parent_id IN ?", parents).Find(&replies).Error; err != nil {
+			return err
+		}
+
+		parents = nil
+		for _, reply := range replies {
+			if !reply.DeletedAt.Valid {
+				return ErrCommentHasReplies
+			}
+			parents = append(parents, reply.ID)
+		}
+		ids = append(ids, parents...)
+	}
+
+	return r.deleted().Delete(&models.Comment{}, "id IN ?", ids).Error
+}
+
+// PurgeUser permanently deletes a soft-deleted user with their follows,
+// interests, likes, bookmarks, highlights, reading history and notifications. Users who still own
+// articles, comments, media, series or revisions are not purged.
+func (r *trashRepository) PurgeUser(id uuid.UUID) error {
+	owned := map[string]string{
+		"articles":          "author_id = @id",
+		"comments":          "user_id = @id",
+		"media":             "uploaded_by = @id",
+		"series":            "author_id = @id",
+		"article_revisions": "editor_id = @id OR reviewed_by = @id",
+	}
+	for table, where := range owned {
+		var count int64
+		if err := r.db.Table(table).Where(where, sql.Named("id", id)).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrUserHasContent
+		}
+	}
+
+	queries := []string{
+		"DELETE FROM user_follows WHERE follower_id = @id OR following_id = @id",
+		"DELETE FROM user_interests WHERE user_id = @id",
+		"DELETE FROM likes WHERE user_id = @id",
+		"DELETE FROM bookmarks WHERE user_id = @id",
+		"DELETE FROM highlights WHERE user_id = @id",
+		"DELETE FROM reading_progress WHERE user_id = @id",
+		"DELETE FROM article_reads WHERE user_id = @id",
+		"DELETE FROM notifications WHERE user_id = @id OR actor_id = @id",
+		"UPDATE duplicate_flags SET reviewed_by = NULL WHERE reviewed_by = @id",
+	}
+	for _, query := range queries {
+		if err := r.db.Exec(query, sql.Named("id", id)).Error; err != nil {
+			return err
+		}
+	}
+
+	return r.deleted().Delete(&models.User{}, "id = ?", id).Error
+}
@@ -0,0 +1,189 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TrashRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     TrashRepository
+	testUser *models.User
+}
+
+func (suite *TrashRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewTrashRepository(suite.db)
+}
+
+func (suite *TrashRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+	suite.testUser = suite.createUser("author")
+}
+
+func TestTrashRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TrashRepositoryTestSuite))
+}
+
+func (suite *TrashRepositoryTestSuite) createUser(username string) *models.User {
+	user := &models.User{
+		ID:           uuid.New(),
+		Username:     username,
+		Email:        username + "@example.com",
+		PasswordHash: "hashedpassword",
+		Role:         models.RoleAuthor,
+		IsActive:     true,
+	}
+	require.NoError(suite.T(), suite.db.Create(user).Error)
+	return user
+}
+
+func (suite *TrashRepositoryTestSuite) createArticle(slug string) *models.Article {
+	article := &models.Article{
+		ID:       uuid.New(),
+		Title:    "Article " + slug,
+		Slug:     slug,
+		Content:  "Content",
+		AuthorID: suite.testUser.ID,
+		Status:   models.StatusPublished,
+	}
+	require.NoError(suite.T(), suite.db.Create(article).Error)
+	return article
+}
+
+func (suite *TrashRepositoryTestSuite) createComment(articleID uuid.UUID, parentID *uuid.UUID) *models.Comment {
+	comment := &models.Comment{
+		ID:        uuid.New(),
+		ArticleID: articleID,
+		UserID:    suite.testUser.ID,
+		ParentID:  parentID,
+		Content:   "A comment",
+	}
+	require.NoError(suite.T(), suite.db.Create(comment).Error)
+	return comment
+}
+
+func (suite *TrashRepositoryTestSuite) TestFindArticles_OnlyDeleted() {
+	live := suite.createArticle("live")
+	deleted := suite.createArticle("deleted")
+	require.NoError(suite.T(), suite.db.Delete(deleted).Error)
+
+	articles, total, err := suite.repo.FindArticles(10, 0)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	require.Len(suite.T(), articles, 1)
+	assert.Equal(suite.T(), deleted.ID, articles[0].ID)
+	assert.Equal(suite.T(), suite.testUser.ID, articles[0].Author.ID)
+
+	_, err = suite.repo.FindArticle(live.ID)
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TrashRepositoryTestSuite) TestDeletedSlugCanBeReused() {
+	deleted := suite.createArticle("shared-slug")
+	require.NoError(suite.T(), suite.db.Delete(deleted).Error)
+
+	suite.createArticle("shared-slug")
+
+	// Restoring under the same slug would clash with the live article
+	assert.Error(suite.T(), suite.repo.RestoreArticle(deleted.ID, "shared-slug"))
+	assert.NoError(suite.T(), suite.repo.RestoreArticle(deleted.ID, "shared-slug-1"))
+
+	var restored models.Article
+	require.NoError(suite.T(), suite.db.First(&restored, "id = ?", deleted.ID).Error)
+	assert.Equal(suite.T(), "shared-slug-1", restored.Slug)
+}
+
+func (suite *TrashRepositoryTestSuite) TestPurgeArticle_RemovesDependents() {
+	article := suite.createArticle("purged")
+	tag := &models.Tag{ID: uuid.New(), Name: "Go", Slug: "go"}
+	require.NoError(suite.T(), suite.db.Create(tag).Error)
+	require.NoError(suite.T(), suite.db.Model(article).Association("Tags").Append([]models.Tag{*tag}))
+	suite.createComment(article.ID, nil)
+	require.NoError(suite.T(), suite.db.Create(&models.Like{UserID: suite.testUser.ID, ArticleID: article.ID}).Error)
+	require.NoError(suite.T(), suite.db.Delete(article).Error)
+
+	require.NoError(suite.T(), suite.repo.PurgeArticle(article.ID))
+
+	for _, table := range []string{"articles", "article_tags", "comments", "likes"} {
+		var count int64
+		suite.db.Table(table).Count(&count)
+		assert.Zero(suite.T(), count, table)
+	}
+}
+
+func (suite *TrashRepositoryTestSuite) TestPurgeComment_WithLiveReply() {
+	article := suite.createArticle("commented")
+	parent := suite.createComment(article.ID, nil)
+	suite.createComment(article.ID, &parent.ID)
+	require.NoError(suite.T(), suite.db.Delete(parent).Error)
+
+	err := suite.repo.PurgeComment(parent.ID)
+
+	assert.ErrorIs(suite.T(), err, ErrCommentHasReplies)
+}
+
+func (suite *TrashRepositoryTestSuite) TestPurgeComment_WithDeletedReplies() {
+	article := suite.createArticle("commented")
+	parent := suite.createComment(article.ID, nil)
+	reply := suite.createComment(article.ID, &parent.ID)
+	nested := suite.createComment(article.ID, &reply.ID)
+	require.NoError(suite.T(), suite.db.Delete(&models.Comment{}, "id IN ?", []uuid.UUID{parent.ID, reply.ID, nested.ID}).Error)
+
+	require.NoError(suite.T(), suite.repo.PurgeComment(parent.ID))
+
+	var count int64
+	suite.db.Unscoped().Model(&models.Comment{}).Count(&count)
+	assert.Zero(suite.T(), count)
+}
+
+func (suite *TrashRepositoryTestSuite) TestPurgeUser() {
+	reader := suite.createUser("reader")
+	require.NoError(suite.T(), suite.db.Exec("INSERT INTO user_follows (follower_id, following_id) VALUES (?, ?)", reader.ID, suite.testUser.ID).Error)
+	require.NoError(suite.T(), suite.db.Delete(reader).Error)
+	require.NoError(suite.T(), suite.db.Delete(suite.testUser).Error)
+	suite.createArticle("owned")
+
+	assert.ErrorIs(suite.T(), suite.repo.PurgeUser(suite.testUser.ID), ErrUserHasContent)
+	assert.NoError(suite.T(), suite.repo.PurgeUser(reader.ID))
+
+	var follows int64
+	suite.db.Table("user_follows").Count(&follows)
+	assert.Zero(suite.T(), follows)
+	_, err := suite.repo.FindUser(reader.ID)
+	assert.ErrorIs(suite.T(), err, gorm.ErrRecordNotFound)
+}
+
+func (suite *TrashRepositoryTestSuite) TestFindDeletedBefore() {
+	old := suite.createArticle("old")
+	recent := suite.createArticle("recent")
+	suite.createArticle("live")
+	require.NoError(suite.T(), suite.db.Unscoped().Model(old).Update("deleted_at", time.Now().Add(-48*time.Hour)).Error)
+	require.NoError(suite.T(), suite.db.Delete(recent).Error)
+
+	ids, err := suite.repo.FindDeletedBefore(TrashArticles, time.Now().Add(-24*time.Hour), 10, 0)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []uuid.UUID{old.ID}, ids)
+}
+
+func (suite *TrashRepositoryTestSuite) TestRestore_Comment() {
+	article := suite.createArticle("commented")
+	comment := suite.createComment(article.ID, nil)
+	require.NoError(suite.T(), suite.db.Delete(comment).Error)
+
+	require.NoError(suite.T(), suite.repo.Restore(TrashComments, comment.ID))
+
+	var restored models.Comment
+	assert.NoError(suite.T(), suite.db.First(&restored, "id = ?", comment.ID).Error)
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"gorm.io/gorm"
+)
+
+// TrendingRepository defines the interface for trending score data access
+type TrendingRepository interface {
+	FindActivity(since time.Time) ([]models.ArticleDailyStat, error)
+	ReplaceAll(scores []models.TrendingScore) error
+}
+
+type trendingRepository struct {
+	db *gorm.DB
+}
+
+// NewTrendingRepository creates a new trending repository
+func NewTrendingRepository(db *gorm.DB) TrendingRepository {
+	return &trendingRepository{db: db}
+}
+
+// FindActivity returns the daily totals of published articles since the given day
+func (r *trendingRepository) FindActivity(since time.Time) ([]models.ArticleDailyStat, error) {
+	var stats []models.ArticleDailyStat
+	err := r.db.Model(&models.ArticleDailyStat{}).
+		Select("article_daily_stats.*").
+		Joins("JOIN articles ON articles.id = article_daily_stats.article_id").
+		Where("article_daily_stats.day >= ?", models.StatsDay(since)).
+		Where("articles.status = ? AND articles.published_at <= ? AND articles.deleted_at IS NULL", models.StatusPublished, time.Now()).
+		Find(&stats).Error
+	return stats, err
+}
+
+// ReplaceAll replaces every stored score in one transaction, so readers
+// never see a half-written ranking
+func (r *trendingRepository) ReplaceAll(scores []models.TrendingScore) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&models.TrendingScore{}).Error; err != nil {
+			return err
+		}
+		if len(scores) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(scores, 500).Error
+	})
+}
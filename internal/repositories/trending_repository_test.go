@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TrendingRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     TrendingRepository
+	authorID uuid.UUID
+}
+
+func (suite *TrendingRepositoryTestSuite) SetupSuite() {
+	suite.db = helpers.SetupTestDB()
+	suite.repo = NewTrendingRepository(suite.db)
+}
+
+func (suite *TrendingRepositoryTestSuite) SetupTest() {
+	helpers.CleanupTestDB(suite.db)
+	author := &models.User{Username: "author", Email: "author@example.com", PasswordHash: "hash", Role: models.RoleAuthor}
+	suite.Require().NoError(suite.db.Create(author).Error)
+	suite.authorID = author.ID
+}
+
+func TestTrendingRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TrendingRepositoryTestSuite))
+}
+
+func (suite *TrendingRepositoryTestSuite) newArticle(slug string, status models.ArticleStatus) *models.Article {
+	publishedAt := time.Now().Add(-time.Hour)
+	article := &models.Article{Title: slug, Slug: slug, Content: "Content", AuthorID: suite.authorID, Status: status, PublishedAt: &publishedAt}
+	suite.Require().NoError(suite.db.Create(article).Error)
+	return article
+}
+
+func (suite *TrendingRepositoryTestSuite) TestFindActivity_PublishedSince() {
+	published := suite.newArticle("published", models.StatusPublished)
+	draft := suite.newArticle("draft", models.StatusDraft)
+	today := models.StatsDay(time.Now())
+
+	suite.Require().NoError(suite.db.Create(&[]models.ArticleDailyStat{
+		{ArticleID: published.ID, Day: today, Views: 3},
+		{ArticleID: published.ID, Day: today.AddDate(0, 0, -40), Views: 9},
+		{ArticleID: draft.ID, Day: today, Views: 5},
+	}).Error)
+
+	stats, err := suite.repo.FindActivity(today.AddDate(0, 0, -30))
+
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(stats, 1)
+	assert.Equal(suite.T(), published.ID, stats[0].ArticleID)
+	assert.Equal(suite.T(), 3, stats[0].Views)
+}
+
+func (suite *TrendingRepositoryTestSuite) TestReplaceAll_ReplacesScores() {
+	article := suite.newArticle("article", models.StatusPublished)
+	now := time.Now()
+
+	suite.Require().NoError(suite.repo.ReplaceAll([]models.TrendingScore{
+		{ArticleID: article.ID, Window: "24h", Score: 1, ComputedAt: now},
+		{ArticleID: article.ID, Window: "7d", Score: 2, ComputedAt: now},
+	}))
+	suite.Require().NoError(suite.repo.ReplaceAll([]models.TrendingScore{
+		{ArticleID: article.ID, Window: "7d", Score: 4, ComputedAt: now},
+	}))
+
+	var scores []models.TrendingScore
+	suite.Require().NoError(suite.db.Find(&scores).Error)
+	suite.Require().Len(scores, 1)
+	assert.Equal(suite.T(), "7d", scores[0].Window)
+	assert.Equal(suite.T(), 4.0, scores[0].Score)
+}
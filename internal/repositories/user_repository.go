@@ -24,8 +24,8 @@ type UserRepository interface {
 	FollowUser(followerID, followingID uuid.UUID) error
 	UnfollowUser(followerID, followingID uuid.UUID) error
 	IsFollowing(followerID, followingID uuid.UUID) (bool, error)
-	GetFollowers(userID uuid.UUID, limit, offset int) ([]models.User, int64, error)
-	GetFollowing(userID uuid.UUID, limit, offset int) ([]models.User, int64, error)
+	GetFollowers(userID uuid.UUID, limit, offset int, after *Keyset) ([]models.UserFollow, int64, error)
+	GetFollowing(userID uuid.UUID, limit, offset int, after *Keyset) ([]models.UserFollow, int64, error)
 	GetFollowingIDs(userID uuid.UUID) ([]uuid.UUID, error)
 	// Interest methods
 	SetInterests(userID uuid.UUID, categoryIDs []uuid.UUID) error
@@ -204,60 +204,55 @@ func (r *userRepository) IsFollowing(followerID, followingID uuid.UUID) (bool, e
 	return count > 0, err
 }
 
-// GetFollowers returns users who follow the given user
-func (r *userRepository) GetFollowers(userID uuid.UUID, limit, offset int) ([]models.User, int64, error) {
-	var users []models.User
-	var total int64
-
-	query := r.db.Model(&models.User{}).
-		Joins("JOIN user_follows ON user_follows.follower_id = users.id").
+// GetFollowers returns the follows of the given user, newest first, with
+// the followers preloaded. Pages continue after the given keyset when one is
+// set, and then the total isn't counted.
+func (r *userRepository) GetFollowers(userID uuid.UUID, limit, offset int, after *Keyset) ([]models.UserFollow, int64, error) {
+	query := r.db.Model(&models.UserFollow{}).
+		Joins("JOIN users ON user_follows.follower_id = users.id AND users.deleted_at IS NULL").
 		Where("user_follows.following_id = ?", userID)
 
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	query = query.Order("user_follows.created_at DESC")
-	if limit > 0 {
-		query = query.Limit(limit)
-	}
-	if offset > 0 {
-		query = query.Offset(offset)
-	}
+	return r.findFollows(query.Preload("Follower"), "user_follows.follower_id", limit, offset, after)
+}
 
-	if err := query.Find(&users).Error; err != nil {
-		return nil, 0, err
-	}
+// GetFollowing returns the follows made by the given user, newest first,
+// with the followed users preloaded. Pages continue after the given keyset
+// when one is set, and then the total isn't counted.
+func (r *userRepository) GetFollowing(userID uuid.UUID, limit, offset int, after *Keyset) ([]models.UserFollow, int64, error) {
+	query := r.db.Model(&models.UserFollow{}).
+		Joins("JOIN users ON user_follows.following_id = users.id AND users.deleted_at IS NULL").
+		Where("user_follows.follower_id = ?", userID)
 
-	return users, total, nil
+	return r.findFollows(query.Preload("Following"), "user_follows.following_id", limit, offset, after)
 }
 
-// GetFollowing returns users that the given user follows
-func (r *userRepository) GetFollowing(userID uuid.UUID, limit, offset int) ([]models.User, int64, error) {
-	var users []models.User
+// findFollows pages a follow query by follow time, breaking ties by idColumn
+func (r *userRepository) findFollows(query *gorm.DB, idColumn string, limit, offset int, after *Keyset) ([]models.UserFollow, int64, error) {
+	var follows []models.UserFollow
 	var total int64
 
-	query := r.db.Model(&models.User{}).
-		Joins("JOIN user_follows ON user_follows.following_id = users.id").
-		Where("user_follows.follower_id = ?", userID)
-
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if after == nil {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	query = query.Order("user_follows.created_at DESC")
+	query = orderKeyset(query, "user_follows.created_at", idColumn, true)
+	if after != nil {
+		query = afterKeyset(query, "user_follows.created_at", idColumn, true, after)
+	}
 	if limit > 0 {
 		query = query.Limit(limit)
 	}
-	if offset > 0 {
+	if offset > 0 && after == nil {
 		query = query.Offset(offset)
 	}
 
-	if err := query.Find(&users).Error; err != nil {
+	if err := query.Find(&follows).Error; err != nil {
 		return nil, 0, err
 	}
 
-	return users, total, nil
+	return follows, total, nil
 }
 
 // GetFollowingIDs returns the IDs of users that the given user follows
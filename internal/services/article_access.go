@@ -0,0 +1,59 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// findReadableArticle finds a live article by slug that the reader may open,
+// and reports any other article as not found. Followers-only articles are
+// limited to the author and their followers. readerID is nil for anonymous readers.
+func findReadableArticle(
+	articleRepo repositories.ArticleRepository,
+	userRepo repositories.UserRepository,
+	slug string,
+	readerID *uuid.UUID,
+) (*models.Article, error) {
+	article, err := articleRepo.FindBySlug(slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, utils.WrapError(err, "failed to find article")
+	}
+
+	if !article.IsPubliclyVisible() {
+		return nil, utils.ErrNotFound
+	}
+
+	if article.Visibility == models.VisibilityFollowers {
+		if readerID == nil || userRepo == nil {
+			return nil, utils.ErrNotFound
+		}
+		if article.AuthorID != *readerID {
+			following, err := userRepo.IsFollowing(*readerID, article.AuthorID)
+			if err != nil {
+				return nil, utils.WrapError(err, "failed to check follow status")
+			}
+			if !following {
+				return nil, utils.ErrNotFound
+			}
+		}
+	}
+
+	return article, nil
+}
+
+// optionalUserID parses the ID of a reader who may be anonymous
+func optionalUserID(userID string) *uuid.UUID {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -1,33 +1,105 @@
 package services
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/alfafaa/alfafaa-blog/internal/cache"
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/models"
 	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/trending"
 	"github.com/alfafaa/alfafaa-blog/internal/utils"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/text/language"
 	"gorm.io/gorm"
 )
 
 // ArticleService defines the interface for article operations
 type ArticleService interface {
 	CreateArticle(req *dto.CreateArticleRequest, authorID string) (*dto.ArticleDetailResponse, error)
-	GetArticle(slug string, incrementView bool) (*dto.ArticleDetailResponse, error)
-	GetArticles(query *dto.ArticleListQuery, includeUnpublished bool) ([]dto.ArticleListItemResponse, int64, error)
+	GetArticle(slug string, viewer Viewer, incrementView bool) (*dto.ArticleDetailResponse, error)
+	GetArticles(query *dto.ArticleListQuery, viewer Viewer) ([]dto.ArticleListItemResponse, int64, string, error)
 	UpdateArticle(id string, req *dto.UpdateArticleRequest, userID string, isEditor bool) (*dto.ArticleDetailResponse, error)
 	DeleteArticle(id string, userID string, isEditor bool) error
 	PublishArticle(id string) (*dto.ArticleDetailResponse, error)
 	UnpublishArticle(id string) (*dto.ArticleDetailResponse, error)
-	GetTrendingArticles(limit int) ([]dto.ArticleListItemResponse, error)
+	PickArticle(id string, req *dto.StaffPickRequest, curatorID string) (*dto.StaffPickResponse, error)
+	UnpickArticle(id string) error
+	UpdateCommentSettings(id string, req *dto.CommentSettingsRequest, userID string, isEditor bool) (*dto.CommentSettingsResponse, error)
+	BulkUpdateArticles(req *dto.BulkArticleRequest) (*dto.BulkArticleReport, error)
+	GetTrendingArticles(query *dto.TrendingQuery) ([]dto.ArticleListItemResponse, error)
 	GetRecentArticles(limit int) ([]dto.ArticleListItemResponse, error)
 	GetRelatedArticles(slug string, limit int) ([]dto.ArticleListItemResponse, error)
 	SearchArticles(query string, filters *dto.ArticleListQuery) ([]dto.ArticleListItemResponse, int64, error)
+	GetEditLock(id string, userID string, isEditor bool) (*dto.EditLockResponse, error)
+	AcquireEditLock(id string, userID string, isEditor bool) (*dto.EditLockResponse, error)
+	ReleaseEditLock(id string, userID string, isEditor bool) error
+	GetPendingRevision(id string, userID string, isEditor bool) (*dto.ArticleRevisionResponse, error)
+	GetPendingRevisions(query *dto.PaginationQuery) ([]dto.ArticleRevisionResponse, int64, error)
+	PublishRevision(id string, reviewerID string) (*dto.ArticleDetailResponse, error)
+	DiscardRevision(id string, userID string, isEditor bool) error
+	CreatePreviewLink(id string, req *dto.CreatePreviewRequest, userID string, isEditor bool) (*dto.PreviewLinkResponse, error)
+	GetPreviewLinks(id string, userID string, isEditor bool) ([]dto.PreviewLinkResponse, error)
+	RevokePreviewLink(id string, previewID string, userID string, isEditor bool) error
+	GetPreview(token string) (*dto.ArticleDetailResponse, error)
 }
 
+// Viewer identifies who is reading an article. The zero value is an anonymous reader
+// with no language preference.
+type Viewer struct {
+	UserID    string
+	IsEditor  bool
+	Locales   []language.Tag // Preferred locales, most preferred first
+	ClientIP  string         // Used with UserAgent to tell anonymous readers apart when counting views
+	UserAgent string
+	Referrer  string // Referer header, for referral statistics
+}
+
+// canView checks if the viewer may read the article. Drafts and scheduled
+// articles are only visible to their author and editors.
+func (v Viewer) canView(article *models.Article) bool {
+	return article.IsPubliclyVisible() || v.canManage(article)
+}
+
+// canManage checks if the viewer is the article's author or an editor
+func (v Viewer) canManage(article *models.Article) bool {
+	return v.IsEditor || (v.UserID != "" && article.AuthorID.String() == v.UserID)
+}
+
+// seesPreview checks if the viewer only gets a preview of the article:
+// members-only articles are cut short for anonymous readers
+func (v Viewer) seesPreview(article *models.Article) bool {
+	return v.UserID == "" && article.Visibility == models.VisibilityMembers
+}
+
+// listingFilters returns the repository filters that limit a listing to what the viewer may find
+func (v Viewer) listingFilters() repositories.ArticleFilters {
+	var filters repositories.ArticleFilters
+	if viewerID, err := uuid.Parse(v.UserID); err == nil {
+		filters.ViewerID = &viewerID
+	}
+	return filters
+}
+
+// editLockTTL is how long an edit lock lasts without being refreshed
+const editLockTTL = 5 * time.Minute
+
+// defaultPreviewTTLHours is how long a preview link lasts when no expiry is given
+const defaultPreviewTTLHours = 72
+
+// membersPreviewLength is how many characters of a members-only article anonymous readers see
+const membersPreviewLength = 600
+
+// maxBulkArticles is the most articles a single bulk action may change
+const maxBulkArticles = 1000
+
+// bulkChunkSize is the number of articles changed per transaction in a bulk action
+const bulkChunkSize = 50
+
 type articleService struct {
 	db             *gorm.DB
 	articleRepo    repositories.ArticleRepository
@@ -35,6 +107,16 @@ type articleService struct {
 	tagRepo        repositories.TagRepository
 	engagementRepo repositories.EngagementRepository
 	userRepo       repositories.UserRepository
+	seriesRepo     repositories.SeriesRepository
+	slugRepo       repositories.SlugHistoryRepository
+	lockRepo       repositories.ArticleLockRepository
+	revisionRepo   repositories.ArticleRevisionRepository
+	previewRepo    repositories.ArticlePreviewRepository
+	previewSecret  string
+	relatedRepo    repositories.RelatedArticleRepository
+	duplicates     DuplicateChecker
+	views          ViewRecorder
+	readCache      *cache.ReadCache
 }
 
 // NewArticleService creates a new article service
@@ -74,6 +156,76 @@ func WithUserRepo(repo repositories.UserRepository) ArticleServiceOption {
 	}
 }
 
+// WithSeriesRepo sets the series repository on the article service (for series navigation)
+func WithSeriesRepo(repo repositories.SeriesRepository) ArticleServiceOption {
+	return func(s *articleService) {
+		s.seriesRepo = repo
+	}
+}
+
+// WithSlugHistoryRepo sets the slug history repository on the article service (for redirects)
+func WithSlugHistoryRepo(repo repositories.SlugHistoryRepository) ArticleServiceOption {
+	return func(s *articleService) {
+		s.slugRepo = repo
+	}
+}
+
+// WithArticleLockRepo sets the edit lock repository on the article service
+func WithArticleLockRepo(repo repositories.ArticleLockRepository) ArticleServiceOption {
+	return func(s *articleService) {
+		s.lockRepo = repo
+	}
+}
+
+// WithRevisionRepo sets the revision repository on the article service.
+// Without it, edits to published articles go live immediately.
+func WithRevisionRepo(repo repositories.ArticleRevisionRepository) ArticleServiceOption {
+	return func(s *articleService) {
+		s.revisionRepo = repo
+	}
+}
+
+// WithPreviewLinks enables signed preview links for unpublished articles.
+// The secret signs the link tokens.
+func WithPreviewLinks(repo repositories.ArticlePreviewRepository, secret string) ArticleServiceOption {
+	return func(s *articleService) {
+		s.previewRepo = repo
+		s.previewSecret = secret
+	}
+}
+
+// WithRelatedRepo makes related articles come from the precomputed ranking.
+// Without it, or for articles not ranked yet, they are the newest articles
+// sharing a category or tag.
+func WithRelatedRepo(repo repositories.RelatedArticleRepository) ArticleServiceOption {
+	return func(s *articleService) {
+		s.relatedRepo = repo
+	}
+}
+
+// WithDuplicateChecker checks articles for near-duplicates as they are saved
+func WithDuplicateChecker(checker DuplicateChecker) ArticleServiceOption {
+	return func(s *articleService) {
+		s.duplicates = checker
+	}
+}
+
+// WithViewRecorder deduplicates article views and leaves bots out of the count.
+// Without it, every counted request adds a view.
+func WithViewRecorder(recorder ViewRecorder) ArticleServiceOption {
+	return func(s *articleService) {
+		s.views = recorder
+	}
+}
+
+// WithReadCache caches trending and recent articles, and drops them when
+// articles change
+func WithReadCache(readCache *cache.ReadCache) ArticleServiceOption {
+	return func(s *articleService) {
+		s.readCache = readCache
+	}
+}
+
 // CreateArticle creates a new article
 func (s *articleService) CreateArticle(req *dto.CreateArticleRequest, authorID string) (*dto.ArticleDetailResponse, error) {
 	authorUUID, err := uuid.Parse(authorID)
@@ -81,6 +233,20 @@ func (s *articleService) CreateArticle(req *dto.CreateArticleRequest, authorID s
 		return nil, utils.ErrBadRequest
 	}
 
+	locale, err := normalizeLocale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	// Join the translation group of the article being translated
+	var translationGroupID uuid.UUID
+	if req.TranslationOf != "" {
+		translationGroupID, err = s.translationGroup(req.TranslationOf, locale)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Generate slug from title
 	slug := utils.GenerateSlug(req.Title)
 	slug = utils.TruncateSlug(slug, 200)
@@ -149,25 +315,47 @@ func (s *articleService) CreateArticle(req *dto.CreateArticleRequest, authorID s
 		excerpt = req.Content[:200] + "..."
 	}
 
+	visibility := models.VisibilityPublic
+	if req.Visibility != "" {
+		visibility = models.ArticleVisibility(req.Visibility)
+	}
+
+	// Content is HTML unless the client opts into Markdown
+	contentFormat := models.ContentFormatHTML
+	if req.ContentFormat != "" {
+		contentFormat = models.ContentFormat(req.ContentFormat)
+	}
+
 	article := &models.Article{
-		Title:            req.Title,
-		Slug:             slug,
-		Content:          req.Content,
-		Excerpt:          excerpt,
-		FeaturedImageURL: req.FeaturedImageURL,
-		AuthorID:         authorUUID,
-		Status:           status,
-		MetaTitle:        req.MetaTitle,
-		MetaDescription:  req.MetaDescription,
-		MetaKeywords:     req.MetaKeywords,
-		Categories:       categories,
-		Tags:             tags,
+		Title:              req.Title,
+		Slug:               slug,
+		Content:            req.Content,
+		ContentFormat:      contentFormat,
+		Excerpt:            excerpt,
+		FeaturedImageURL:   req.FeaturedImageURL,
+		AuthorID:           authorUUID,
+		Status:             status,
+		Visibility:         visibility,
+		MetaTitle:          req.MetaTitle,
+		MetaDescription:    req.MetaDescription,
+		MetaKeywords:       req.MetaKeywords,
+		Locale:             locale,
+		TranslationGroupID: translationGroupID,
+		Categories:         categories,
+		Tags:               tags,
 	}
 
 	if status == models.StatusPublished {
 		now := time.Now()
 		article.PublishedAt = &now
 	}
+	if req.Comments != nil {
+		applyCommentSettings(article, req.Comments)
+	}
+
+	if err := renderContent(article); err != nil {
+		return nil, utils.WrapError(err, "failed to render content")
+	}
 
 	// Use transaction to ensure atomicity of article creation and tag updates
 	if s.db != nil {
@@ -210,56 +398,159 @@ func (s *articleService) CreateArticle(req *dto.CreateArticleRequest, authorID s
 	if err != nil {
 		return nil, utils.WrapError(err, "failed to fetch created article")
 	}
+	s.checkDuplicates(createdArticle)
 
+	s.readCache.Invalidate(cache.ArticleChanged)
 	return s.toDetailResponse(createdArticle), nil
 }
 
-// GetArticle retrieves an article by slug
-func (s *articleService) GetArticle(slug string, incrementView bool) (*dto.ArticleDetailResponse, error) {
+// GetArticle retrieves an article by slug. Unpublished articles are reported
+// as not found unless the viewer is their author or an editor.
+func (s *articleService) GetArticle(slug string, viewer Viewer, incrementView bool) (*dto.ArticleDetailResponse, error) {
 	article, err := s.articleRepo.FindBySlug(slug)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, utils.ErrNotFound
+			return nil, s.redirectFromSlug(slug)
 		}
 		return nil, utils.WrapError(err, "failed to find article")
 	}
 
-	if incrementView {
-		_ = s.articleRepo.IncrementViewCount(article.ID)
+	canRead, err := s.canRead(article, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if !canRead {
+		return nil, utils.ErrNotFound
+	}
+
+	translations, err := s.visibleTranslations(article, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Serve a translation when the reader doesn't accept the requested article's locale
+	if len(viewer.Locales) > 0 && len(translations) > 0 && !utils.AcceptsLocale(viewer.Locales, article.Locale) {
+		available := []string{article.Locale}
+		for _, translation := range translations {
+			available = append(available, translation.Locale)
+		}
+		if i := utils.MatchLocale(viewer.Locales, available); i > 0 {
+			translated, err := s.articleRepo.FindByID(translations[i-1].ID)
+			if err != nil {
+				return nil, utils.WrapError(err, "failed to find translation")
+			}
+			translations[i-1] = *article
+			article = translated
+		}
+	}
+
+	if incrementView && s.countView(article, viewer) {
 		article.ViewCount++
 	}
 
-	return s.toDetailResponse(article), nil
+	response := s.toDetailResponse(article)
+	s.attachSeries(response, article, viewer)
+	response.Translations = toTranslationResponses(translations)
+	if viewer.seesPreview(article) {
+		truncateToPreview(response)
+	}
+
+	return response, nil
 }
 
-// GetArticles retrieves articles with filters
-func (s *articleService) GetArticles(query *dto.ArticleListQuery, includeUnpublished bool) ([]dto.ArticleListItemResponse, int64, error) {
-	filters := repositories.ArticleFilters{
-		Limit:  query.GetPerPage(),
-		Offset: query.GetOffset(),
-		Sort:   query.GetSort(),
+// canRead checks if the viewer may read the article. On top of canView,
+// followers-only articles are limited to the author's followers; unlisted
+// and members-only articles can be read by anyone with the link.
+func (s *articleService) canRead(article *models.Article, viewer Viewer) (bool, error) {
+	if !viewer.canView(article) {
+		return false, nil
+	}
+	if article.Visibility != models.VisibilityFollowers || viewer.canManage(article) {
+		return true, nil
+	}
+	if s.userRepo == nil {
+		return false, nil
+	}
+
+	viewerID, err := uuid.Parse(viewer.UserID)
+	if err != nil {
+		return false, nil
+	}
+
+	following, err := s.userRepo.IsFollowing(viewerID, article.AuthorID)
+	if err != nil {
+		return false, utils.WrapError(err, "failed to check follow status")
+	}
+	return following, nil
+}
+
+// truncateToPreview replaces the content with the opening of the rendered
+// article. Previews are always HTML, so the Markdown source is not exposed.
+func truncateToPreview(response *dto.ArticleDetailResponse) {
+	preview, truncated := utils.TruncateHTML(response.ContentHTML, membersPreviewLength)
+	response.Content = preview
+	response.ContentFormat = string(models.ContentFormatHTML)
+	response.ContentHTML = preview
+	response.TableOfContents = nil
+	response.IsTruncated = truncated
+}
+
+// GetArticles retrieves articles with filters. Editors see every article;
+// other readers only see published articles that are listed for them. It
+// also returns the cursor of the next page, if there may be one.
+func (s *articleService) GetArticles(query *dto.ArticleListQuery, viewer Viewer) ([]dto.ArticleListItemResponse, int64, string, error) {
+	filters := viewer.listingFilters()
+	filters.Limit = query.GetPerPage()
+	filters.Offset = query.GetOffset()
+	filters.Sort = query.GetSort()
+
+	cursor, err := queryKeyset(&query.PaginationQuery, filters.Sort)
+	if err != nil {
+		return nil, 0, "", err
 	}
+	filters.Cursor = cursor
 
 	// Only show published articles unless user can see unpublished
-	if !includeUnpublished {
+	if !viewer.IsEditor {
 		filters.Status = string(models.StatusPublished)
-	} else if query.Status != "" {
+	} else {
 		filters.Status = query.Status
+		filters.AllVisibilities = true
 	}
 
 	if query.AuthorID != "" {
 		authorID, err := uuid.Parse(query.AuthorID)
 		if err != nil {
-			return nil, 0, utils.NewAppError("INVALID_AUTHOR_ID", "Invalid author ID", 400)
+			return nil, 0, "", utils.NewAppError("INVALID_AUTHOR_ID", "Invalid author ID", 400)
 		}
 		filters.AuthorID = &authorID
 	}
 
-	// Handle category filter
+	articles, total, err := s.findArticles(query, filters)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	responses := make([]dto.ArticleListItemResponse, len(articles))
+	for i, article := range articles {
+		responses[i] = toArticleListItemResponse(&article)
+	}
+
+	next := nextCursor(&query.PaginationQuery, len(articles), func() *repositories.Keyset {
+		return repositories.ArticleKeyset(&articles[len(articles)-1], filters.Sort)
+	})
+
+	return responses, total, next, nil
+}
+
+// findArticles runs a list query's category, tag or search filter on top of
+// the repository filters
+func (s *articleService) findArticles(query *dto.ArticleListQuery, filters repositories.ArticleFilters) ([]models.Article, int64, error) {
 	var articles []models.Article
 	var total int64
 	var err error
 
+	// Handle category filter
 	if query.CategorySlug != "" {
 		category, err := s.categoryRepo.FindBySlug(query.CategorySlug)
 		if err != nil {
@@ -296,12 +587,7 @@ func (s *articleService) GetArticles(query *dto.ArticleListQuery, includeUnpubli
 		}
 	}
 
-	responses := make([]dto.ArticleListItemResponse, len(articles))
-	for i, article := range articles {
-		responses[i] = toArticleListItemResponse(&article)
-	}
-
-	return responses, total, nil
+	return articles, total, nil
 }
 
 // UpdateArticle updates an article
@@ -324,172 +610,583 @@ func (s *articleService) UpdateArticle(id string, req *dto.UpdateArticleRequest,
 		return nil, utils.ErrForbidden
 	}
 
-	// Update fields
-	if req.Title != nil {
-		article.Title = *req.Title
-		// Update slug if title changed
-		newSlug := utils.GenerateSlug(*req.Title)
-		newSlug = utils.TruncateSlug(newSlug, 200)
-		if newSlug != article.Slug {
-			exists, err := s.articleRepo.ExistsBySlug(newSlug)
-			if err != nil {
-				return nil, utils.WrapError(err, "failed to check slug")
-			}
-			if !exists {
-				article.Slug = newSlug
-			}
-		}
-	}
-	if req.Content != nil {
-		article.Content = *req.Content
-	}
-	if req.Excerpt != nil {
-		article.Excerpt = *req.Excerpt
+	// Reject edits based on a stale copy before touching anything
+	if req.Version != nil && *req.Version != article.Version {
+		return nil, &utils.VersionConflictError{CurrentVersion: article.Version}
 	}
-	if req.FeaturedImageURL != nil {
-		article.FeaturedImageURL = req.FeaturedImageURL
+
+	// Edits to a live article are held as a pending revision until an editor publishes them
+	if article.Status == models.StatusPublished && s.revisionRepo != nil {
+		return s.saveRevision(article, req, userID)
 	}
-	if req.MetaTitle != nil {
-		article.MetaTitle = *req.MetaTitle
+
+	if err := s.applyUpdate(article, req); err != nil {
+		return nil, err
 	}
-	if req.MetaDescription != nil {
-		article.MetaDescription = *req.MetaDescription
+
+	// Fetch updated article
+	updatedArticle, err := s.articleRepo.FindByID(article.ID)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch updated article")
 	}
-	if req.MetaKeywords != nil {
-		article.MetaKeywords = *req.MetaKeywords
+	s.checkDuplicates(updatedArticle)
+
+	s.readCache.Invalidate(cache.ArticleChanged)
+	return s.toDetailResponse(updatedArticle), nil
+}
+
+// saveRevision stores edits to a published article in its pending revision,
+// merging them into any changes already waiting for review
+func (s *articleService) saveRevision(article *models.Article, req *dto.UpdateArticleRequest, userID string) (*dto.ArticleDetailResponse, error) {
+	editorID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
 	}
 
-	// Update categories
-	if len(req.CategoryIDs) > 0 {
-		categoryIDs := make([]uuid.UUID, len(req.CategoryIDs))
-		for i, id := range req.CategoryIDs {
-			catID, err := uuid.Parse(id)
-			if err != nil {
-				return nil, utils.NewAppError("INVALID_CATEGORY_ID", "Invalid category ID: "+id, 400)
-			}
-			categoryIDs[i] = catID
-		}
+	changes := *req
+	changes.Version = nil
 
-		categories, err := s.categoryRepo.FindByIDs(categoryIDs)
-		if err != nil {
-			return nil, utils.WrapError(err, "failed to find categories")
-		}
-		if len(categories) != len(categoryIDs) {
-			return nil, utils.NewAppError("CATEGORY_NOT_FOUND", "One or more categories not found", 404)
+	revision, err := s.revisionRepo.FindPending(article.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, utils.WrapError(err, "failed to find pending revision")
+	}
+	if revision == nil {
+		revision = &models.ArticleRevision{
+			ArticleID:   article.ID,
+			BaseVersion: article.Version,
+			Status:      models.RevisionPending,
 		}
-
-		if err := s.articleRepo.UpdateCategories(article, categories); err != nil {
-			return nil, utils.WrapError(err, "failed to update categories")
+	} else {
+		var existing dto.UpdateArticleRequest
+		if err := json.Unmarshal([]byte(revision.Changes), &existing); err != nil {
+			return nil, utils.WrapError(err, "failed to decode pending revision")
 		}
+		changes = mergeRevisionChanges(existing, changes)
 	}
 
-	// Update tags within a transaction
-	if req.TagIDs != nil {
-		var newTags []models.Tag
-		if len(req.TagIDs) > 0 {
-			tagIDs := make([]uuid.UUID, len(req.TagIDs))
-			for i, id := range req.TagIDs {
-				tagID, err := uuid.Parse(id)
-				if err != nil {
-					return nil, utils.NewAppError("INVALID_TAG_ID", "Invalid tag ID: "+id, 400)
-				}
-				tagIDs[i] = tagID
-			}
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to encode revision")
+	}
+	revision.Changes = string(encoded)
+	revision.EditorID = editorID
 
-			newTags, err = s.tagRepo.FindByIDs(tagIDs)
-			if err != nil {
-				return nil, utils.WrapError(err, "failed to find tags")
-			}
+	// The article version is bumped (content untouched) so the working copy is
+	// protected by the same If-Match check as the live article. The revision
+	// applies to that version; any other change to the article supersedes it.
+	save := func(articleRepo repositories.ArticleRepository, revisionRepo repositories.ArticleRevisionRepository) error {
+		if err := articleRepo.Update(article); err != nil {
+			return err
 		}
-
-		oldTags := article.Tags
-
-		// Use transaction for tag updates
-		if s.db != nil {
-			err = s.db.Transaction(func(tx *gorm.DB) error {
-				txArticleRepo := s.articleRepo.WithTx(tx)
-				txTagRepo := s.tagRepo.WithTx(tx)
-
-				// Decrement old tag usage
-				for _, tag := range oldTags {
-					if err := txTagRepo.DecrementUsage(tag.ID); err != nil {
-						return err
-					}
-				}
-
-				// Update article tags
-				if err := txArticleRepo.UpdateTags(article, newTags); err != nil {
-					return err
-				}
-
-				// Increment new tag usage
-				for _, tag := range newTags {
-					if err := txTagRepo.IncrementUsage(tag.ID); err != nil {
-						return err
-					}
-				}
-
-				return nil
-			})
-		} else {
-			// Fallback for unit tests without db - run without transaction
-			for _, tag := range oldTags {
-				if err := s.tagRepo.DecrementUsage(tag.ID); err != nil {
-					return nil, utils.WrapError(err, "failed to decrement tag usage")
-				}
-			}
-			if err := s.articleRepo.UpdateTags(article, newTags); err != nil {
-				return nil, utils.WrapError(err, "failed to update tags")
-			}
-			for _, tag := range newTags {
-				if err := s.tagRepo.IncrementUsage(tag.ID); err != nil {
-					return nil, utils.WrapError(err, "failed to increment tag usage")
-				}
-			}
+		revision.BaseVersion = article.Version
+		if revision.ID == uuid.Nil {
+			return revisionRepo.Create(revision)
 		}
+		return revisionRepo.Update(revision)
+	}
 
-		if err != nil {
-			return nil, utils.WrapError(err, "failed to update tags")
+	if s.db != nil {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			return save(s.articleRepo.WithTx(tx), s.revisionRepo.WithTx(tx))
+		})
+	} else {
+		// Fallback for unit tests without db - run without transaction
+		err = save(s.articleRepo, s.revisionRepo)
+	}
+	if err != nil {
+		if errors.Is(err, repositories.ErrVersionConflict) {
+			return nil, s.versionConflict(article.ID)
 		}
+		return nil, utils.WrapError(err, "failed to save revision")
 	}
 
-	if err := s.articleRepo.Update(article); err != nil {
-		return nil, utils.WrapError(err, "failed to update article")
+	response := s.toDetailResponse(article)
+	response.PendingRevision = toRevisionResponse(revision, changes)
+	return response, nil
+}
+
+// GetPendingRevision returns the changes waiting for review on an article
+func (s *articleService) GetPendingRevision(id string, userID string, isEditor bool) (*dto.ArticleRevisionResponse, error) {
+	article, err := s.findEditable(id, userID, isEditor)
+	if err != nil {
+		return nil, err
 	}
 
-	// Fetch updated article
-	updatedArticle, err := s.articleRepo.FindByID(article.ID)
+	revision, changes, err := s.findPendingRevision(article.ID)
 	if err != nil {
-		return nil, utils.WrapError(err, "failed to fetch updated article")
+		return nil, err
 	}
 
-	return s.toDetailResponse(updatedArticle), nil
+	revision.Article = article
+	return toRevisionResponse(revision, changes), nil
 }
 
-// DeleteArticle deletes an article
-func (s *articleService) DeleteArticle(id string, userID string, isEditor bool) error {
+// GetPendingRevisions lists revisions awaiting editor review
+func (s *articleService) GetPendingRevisions(query *dto.PaginationQuery) ([]dto.ArticleRevisionResponse, int64, error) {
+	revisions, total, err := s.revisionRepo.FindAllPending(query.GetPerPage(), query.GetOffset())
+	if err != nil {
+		return nil, 0, utils.WrapError(err, "failed to find pending revisions")
+	}
+
+	responses := make([]dto.ArticleRevisionResponse, len(revisions))
+	for i := range revisions {
+		var changes dto.UpdateArticleRequest
+		if err := json.Unmarshal([]byte(revisions[i].Changes), &changes); err != nil {
+			return nil, 0, utils.WrapError(err, "failed to decode revision "+revisions[i].ID.String())
+		}
+		responses[i] = *toRevisionResponse(&revisions[i], changes)
+	}
+
+	return responses, total, nil
+}
+
+// PublishRevision applies an article's pending changes to the live article
+func (s *articleService) PublishRevision(id string, reviewerID string) (*dto.ArticleDetailResponse, error) {
 	articleID, err := uuid.Parse(id)
 	if err != nil {
-		return utils.ErrBadRequest
+		return nil, utils.ErrBadRequest
+	}
+	reviewerUUID, err := uuid.Parse(reviewerID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
 	}
 
 	article, err := s.articleRepo.FindByID(articleID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return utils.ErrNotFound
+			return nil, utils.ErrNotFound
 		}
-		return utils.WrapError(err, "failed to find article")
+		return nil, utils.WrapError(err, "failed to find article")
 	}
 
-	// Check permissions
-	if article.AuthorID.String() != userID && !isEditor {
-		return utils.ErrForbidden
+	revision, changes, err := s.findPendingRevision(article.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Use transaction for deletion and tag updates
-	if s.db != nil {
-		err = s.db.Transaction(func(tx *gorm.DB) error {
-			txArticleRepo := s.articleRepo.WithTx(tx)
+	// Changes made to the article since the revision was saved would be lost
+	if revision.BaseVersion != article.Version {
+		return nil, utils.NewAppError("VERSION_CONFLICT", "The article changed after these edits were made; discard them or edit the article again", 409)
+	}
+
+	update, err := s.prepareUpdate(article, &changes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	revision.Status = models.RevisionPublished
+	revision.ReviewedBy = &reviewerUUID
+	revision.ReviewedAt = &now
+
+	// The edits go live and the revision is closed together, so it can't be applied twice
+	err = s.inTransaction(func(tx *gorm.DB) error {
+		if err := s.writeUpdate(tx, update); err != nil {
+			return err
+		}
+		revisionRepo := s.revisionRepo
+		if tx != nil {
+			revisionRepo = revisionRepo.WithTx(tx)
+		}
+		return revisionRepo.Update(revision)
+	})
+	if err != nil {
+		return nil, s.updateError(article.ID, err)
+	}
+
+	updatedArticle, err := s.articleRepo.FindByID(article.ID)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch updated article")
+	}
+	s.checkDuplicates(updatedArticle)
+
+	s.readCache.Invalidate(cache.ArticleChanged)
+	return s.toDetailResponse(updatedArticle), nil
+}
+
+// DiscardRevision throws away an article's pending changes
+func (s *articleService) DiscardRevision(id string, userID string, isEditor bool) error {
+	article, err := s.findEditable(id, userID, isEditor)
+	if err != nil {
+		return err
+	}
+
+	revision, _, err := s.findPendingRevision(article.ID)
+	if err != nil {
+		return err
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	now := time.Now()
+	revision.Status = models.RevisionDiscarded
+	revision.ReviewedBy = &userUUID
+	revision.ReviewedAt = &now
+	if err := s.revisionRepo.Update(revision); err != nil {
+		return utils.WrapError(err, "failed to discard revision")
+	}
+
+	return nil
+}
+
+// checkDuplicates runs the duplicate check on a saved article. A failed check
+// is logged and never fails the save.
+func (s *articleService) checkDuplicates(article *models.Article) {
+	if s.duplicates == nil {
+		return
+	}
+	if err := s.duplicates.CheckArticle(article); err != nil {
+		utils.Warn("Duplicate check failed", zap.String("article_id", article.ID.String()), zap.Error(err))
+	}
+}
+
+// countView records a view of the article and reports whether it was counted.
+// Authors reading their own articles are not counted.
+func (s *articleService) countView(article *models.Article, viewer Viewer) bool {
+	if viewer.UserID != "" && article.AuthorID.String() == viewer.UserID {
+		return false
+	}
+
+	if s.views != nil {
+		counted, err := s.views.RecordView(article.ID, viewer)
+		if err != nil {
+			utils.Warn("Failed to record view", zap.String("article_id", article.ID.String()), zap.Error(err))
+			return false
+		}
+		if !counted {
+			return false
+		}
+	}
+
+	if err := s.articleRepo.IncrementViewCount(article.ID); err != nil {
+		utils.Warn("Failed to increment view count", zap.String("article_id", article.ID.String()), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// findPendingRevision loads an article's pending revision and decodes its changes
+func (s *articleService) findPendingRevision(articleID uuid.UUID) (*models.ArticleRevision, dto.UpdateArticleRequest, error) {
+	var changes dto.UpdateArticleRequest
+
+	revision, err := s.revisionRepo.FindPending(articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, changes, utils.NewAppError("NO_PENDING_REVISION", "Article has no pending changes", 404)
+		}
+		return nil, changes, utils.WrapError(err, "failed to find pending revision")
+	}
+
+	if err := json.Unmarshal([]byte(revision.Changes), &changes); err != nil {
+		return nil, changes, utils.WrapError(err, "failed to decode pending revision")
+	}
+
+	return revision, changes, nil
+}
+
+// mergeRevisionChanges overlays newer edits on top of changes already pending
+func mergeRevisionChanges(base, next dto.UpdateArticleRequest) dto.UpdateArticleRequest {
+	if next.Title != nil {
+		base.Title = next.Title
+	}
+	if next.Content != nil {
+		base.Content = next.Content
+	}
+	if next.ContentFormat != nil {
+		base.ContentFormat = next.ContentFormat
+	}
+	if next.Excerpt != nil {
+		base.Excerpt = next.Excerpt
+	}
+	if next.FeaturedImageURL != nil {
+		base.FeaturedImageURL = next.FeaturedImageURL
+	}
+	if len(next.CategoryIDs) > 0 {
+		base.CategoryIDs = next.CategoryIDs
+	}
+	if next.TagIDs != nil {
+		base.TagIDs = next.TagIDs
+	}
+	if next.Visibility != nil {
+		base.Visibility = next.Visibility
+	}
+	if next.MetaTitle != nil {
+		base.MetaTitle = next.MetaTitle
+	}
+	if next.MetaDescription != nil {
+		base.MetaDescription = next.MetaDescription
+	}
+	if next.MetaKeywords != nil {
+		base.MetaKeywords = next.MetaKeywords
+	}
+	if next.Locale != nil {
+		base.Locale = next.Locale
+	}
+	return base
+}
+
+// toRevisionResponse converts a revision to a response DTO
+func toRevisionResponse(revision *models.ArticleRevision, changes dto.UpdateArticleRequest) *dto.ArticleRevisionResponse {
+	response := &dto.ArticleRevisionResponse{
+		ID:          revision.ID.String(),
+		ArticleID:   revision.ArticleID.String(),
+		Changes:     changes,
+		BaseVersion: revision.BaseVersion,
+		Status:      string(revision.Status),
+		ReviewedAt:  revision.ReviewedAt,
+		CreatedAt:   revision.CreatedAt,
+		UpdatedAt:   revision.UpdatedAt,
+	}
+
+	if revision.Article != nil {
+		response.ArticleTitle = revision.Article.Title
+		response.ArticleSlug = revision.Article.Slug
+	}
+
+	if revision.Editor != nil {
+		response.Editor = dto.PublicUserResponse{
+			ID:              revision.Editor.ID.String(),
+			Username:        revision.Editor.Username,
+			FirstName:       revision.Editor.FirstName,
+			LastName:        revision.Editor.LastName,
+			Bio:             revision.Editor.Bio,
+			ProfileImageURL: revision.Editor.ProfileImageURL,
+		}
+	} else {
+		response.Editor.ID = revision.EditorID.String()
+	}
+
+	return response
+}
+
+// applyUpdate writes the requested changes to the live article. Everything is
+// checked before the first write, so a bad category or tag leaves the article
+// untouched.
+func (s *articleService) applyUpdate(article *models.Article, req *dto.UpdateArticleRequest) error {
+	update, err := s.prepareUpdate(article, req)
+	if err != nil {
+		return err
+	}
+
+	err = s.inTransaction(func(tx *gorm.DB) error {
+		return s.writeUpdate(tx, update)
+	})
+	return s.updateError(article.ID, err)
+}
+
+// articleUpdate holds the checked changes of an article update, ready to be
+// written
+type articleUpdate struct {
+	article    *models.Article
+	oldSlug    string
+	categories []models.Category // Replace the article's categories when set
+	tags       []models.Tag      // Replace the article's tags when setTags is true
+	setTags    bool
+}
+
+// prepareUpdate applies the requested changes to the article in memory and
+// loads the categories and tags it is to have, without writing anything
+func (s *articleService) prepareUpdate(article *models.Article, req *dto.UpdateArticleRequest) (*articleUpdate, error) {
+	update := &articleUpdate{article: article, oldSlug: article.Slug}
+
+	// Update fields
+	if req.Title != nil {
+		article.Title = *req.Title
+		// Update slug if title changed
+		newSlug := utils.GenerateSlug(*req.Title)
+		newSlug = utils.TruncateSlug(newSlug, 200)
+		if newSlug != article.Slug {
+			exists, err := s.articleRepo.ExistsBySlug(newSlug)
+			if err != nil {
+				return nil, utils.WrapError(err, "failed to check slug")
+			}
+			if !exists {
+				article.Slug = newSlug
+			}
+		}
+	}
+	if req.Content != nil {
+		article.Content = *req.Content
+	}
+	if req.ContentFormat != nil {
+		article.ContentFormat = models.ContentFormat(*req.ContentFormat)
+	}
+	if req.Content != nil || req.ContentFormat != nil {
+		if err := renderContent(article); err != nil {
+			return nil, utils.WrapError(err, "failed to render content")
+		}
+	}
+	if req.Excerpt != nil {
+		article.Excerpt = *req.Excerpt
+	}
+	if req.FeaturedImageURL != nil {
+		article.FeaturedImageURL = req.FeaturedImageURL
+	}
+	if req.Visibility != nil {
+		article.Visibility = models.ArticleVisibility(*req.Visibility)
+	}
+	if req.MetaTitle != nil {
+		article.MetaTitle = *req.MetaTitle
+	}
+	if req.MetaDescription != nil {
+		article.MetaDescription = *req.MetaDescription
+	}
+	if req.MetaKeywords != nil {
+		article.MetaKeywords = *req.MetaKeywords
+	}
+	if req.Locale != nil {
+		locale, err := normalizeLocale(*req.Locale)
+		if err != nil {
+			return nil, err
+		}
+		if locale != article.Locale {
+			if err := s.checkTranslationLocale(article.TranslationGroupID, article.ID, locale); err != nil {
+				return nil, err
+			}
+			article.Locale = locale
+		}
+	}
+
+	// Load categories
+	if len(req.CategoryIDs) > 0 {
+		categoryIDs := make([]uuid.UUID, len(req.CategoryIDs))
+		for i, id := range req.CategoryIDs {
+			catID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, utils.NewAppError("INVALID_CATEGORY_ID", "Invalid category ID: "+id, 400)
+			}
+			categoryIDs[i] = catID
+		}
+
+		categories, err := s.categoryRepo.FindByIDs(categoryIDs)
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to find categories")
+		}
+		if len(categories) != len(categoryIDs) {
+			return nil, utils.NewAppError("CATEGORY_NOT_FOUND", "One or more categories not found", 404)
+		}
+		update.categories = categories
+	}
+
+	// Load tags
+	if req.TagIDs != nil {
+		update.setTags = true
+		if len(req.TagIDs) > 0 {
+			tagIDs := make([]uuid.UUID, len(req.TagIDs))
+			for i, id := range req.TagIDs {
+				tagID, err := uuid.Parse(id)
+				if err != nil {
+					return nil, utils.NewAppError("INVALID_TAG_ID", "Invalid tag ID: "+id, 400)
+				}
+				tagIDs[i] = tagID
+			}
+
+			tags, err := s.tagRepo.FindByIDs(tagIDs)
+			if err != nil {
+				return nil, utils.WrapError(err, "failed to find tags")
+			}
+			update.tags = tags
+		}
+	}
+
+	return update, nil
+}
+
+// writeUpdate writes a prepared update: the article, its categories and
+// tags, tag usage counts and slug history. Repositories are scoped to tx when
+// it is set.
+func (s *articleService) writeUpdate(tx *gorm.DB, update *articleUpdate) error {
+	articleRepo, tagRepo, slugRepo := s.articleRepo, s.tagRepo, s.slugRepo
+	if tx != nil {
+		articleRepo = articleRepo.WithTx(tx)
+		tagRepo = tagRepo.WithTx(tx)
+		if slugRepo != nil {
+			slugRepo = slugRepo.WithTx(tx)
+		}
+	}
+	article := update.article
+
+	// Save the article first so a version conflict aborts before relations change
+	if err := articleRepo.Update(article); err != nil {
+		return err
+	}
+
+	if err := recordSlugChange(slugRepo, models.SlugEntityArticle, article.ID, update.oldSlug, article.Slug); err != nil {
+		return err
+	}
+
+	if update.categories != nil {
+		if err := articleRepo.UpdateCategories(article, update.categories); err != nil {
+			return err
+		}
+	}
+
+	if update.setTags {
+		for _, tag := range article.Tags {
+			if err := tagRepo.DecrementUsage(tag.ID); err != nil {
+				return err
+			}
+		}
+		if err := articleRepo.UpdateTags(article, update.tags); err != nil {
+			return err
+		}
+		for _, tag := range update.tags {
+			if err := tagRepo.IncrementUsage(tag.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// inTransaction runs fn in a database transaction, or with a nil tx in unit
+// tests without a database
+func (s *articleService) inTransaction(fn func(tx *gorm.DB) error) error {
+	if s.db == nil {
+		return fn(nil)
+	}
+	return s.db.Transaction(fn)
+}
+
+// updateError maps an error from writing an article update to the API error
+func (s *articleService) updateError(articleID uuid.UUID, err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, repositories.ErrVersionConflict) {
+		return s.versionConflict(articleID)
+	}
+	return utils.WrapError(err, "failed to update article")
+}
+
+// DeleteArticle deletes an article
+func (s *articleService) DeleteArticle(id string, userID string, isEditor bool) error {
+	articleID, err := uuid.Parse(id)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	article, err := s.articleRepo.FindByID(articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrNotFound
+		}
+		return utils.WrapError(err, "failed to find article")
+	}
+
+	// Check permissions
+	if article.AuthorID.String() != userID && !isEditor {
+		return utils.ErrForbidden
+	}
+
+	// Use transaction for deletion and tag updates
+	if s.db != nil {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			txArticleRepo := s.articleRepo.WithTx(tx)
 			txTagRepo := s.tagRepo.WithTx(tx)
 
 			// Decrement tag usage
@@ -521,6 +1218,7 @@ func (s *articleService) DeleteArticle(id string, userID string, isEditor bool)
 		return utils.WrapError(err, "failed to delete article")
 	}
 
+	s.readCache.Invalidate(cache.ArticleChanged)
 	return nil
 }
 
@@ -546,6 +1244,9 @@ func (s *articleService) PublishArticle(id string) (*dto.ArticleDetailResponse,
 	article.Publish()
 
 	if err := s.articleRepo.Update(article); err != nil {
+		if errors.Is(err, repositories.ErrVersionConflict) {
+			return nil, s.versionConflict(article.ID)
+		}
 		return nil, utils.WrapError(err, "failed to publish article")
 	}
 
@@ -553,11 +1254,11 @@ func (s *articleService) PublishArticle(id string) (*dto.ArticleDetailResponse,
 	if s.engagementRepo != nil && s.userRepo != nil && article.Author != nil {
 		actorName := article.Author.GetFullName()
 		message := fmt.Sprintf("%s published a new article", actorName)
-		followers, _, _ := s.userRepo.GetFollowers(article.AuthorID, 0, 0)
-		for _, follower := range followers {
-			if follower.ID != article.AuthorID {
+		follows, _, _ := s.userRepo.GetFollowers(article.AuthorID, 0, 0, nil)
+		for _, follow := range follows {
+			if follow.FollowerID != article.AuthorID {
 				notification := &models.Notification{
-					UserID:    follower.ID,
+					UserID:    follow.FollowerID,
 					ActorID:   article.AuthorID,
 					Type:      models.NotificationTypeArticle,
 					Message:   message,
@@ -568,6 +1269,7 @@ func (s *articleService) PublishArticle(id string) (*dto.ArticleDetailResponse,
 		}
 	}
 
+	s.readCache.Invalidate(cache.ArticleChanged)
 	return s.toDetailResponse(article), nil
 }
 
@@ -593,79 +1295,677 @@ func (s *articleService) UnpublishArticle(id string) (*dto.ArticleDetailResponse
 	article.Unpublish()
 
 	if err := s.articleRepo.Update(article); err != nil {
+		if errors.Is(err, repositories.ErrVersionConflict) {
+			return nil, s.versionConflict(article.ID)
+		}
 		return nil, utils.WrapError(err, "failed to unpublish article")
 	}
 
+	s.readCache.Invalidate(cache.ArticleChanged)
 	return s.toDetailResponse(article), nil
 }
 
-// GetTrendingArticles retrieves trending articles
-func (s *articleService) GetTrendingArticles(limit int) ([]dto.ArticleListItemResponse, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 50 {
-		limit = 50
+// PickArticle features a published article as a staff pick, or replaces the
+// note, position and display window of an existing pick
+func (s *articleService) PickArticle(id string, req *dto.StaffPickRequest, curatorID string) (*dto.StaffPickResponse, error) {
+	articleID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, utils.ErrBadRequest
 	}
 
-	articles, err := s.articleRepo.FindTrending(limit)
+	curatorUUID, err := uuid.Parse(curatorID)
 	if err != nil {
-		return nil, utils.WrapError(err, "failed to find trending articles")
+		return nil, utils.ErrBadRequest
 	}
 
-	responses := make([]dto.ArticleListItemResponse, len(articles))
-	for i, article := range articles {
-		responses[i] = toArticleListItemResponse(&article)
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		return nil, utils.NewAppError("INVALID_SCHEDULE", "ends_at must be after starts_at", 400)
 	}
 
-	return responses, nil
-}
-
-// GetRecentArticles retrieves recent articles
-func (s *articleService) GetRecentArticles(limit int) ([]dto.ArticleListItemResponse, error) {
-	if limit <= 0 {
-		limit = 10
+	article, err := s.articleRepo.FindByID(articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, utils.WrapError(err, "failed to find article")
 	}
-	if limit > 50 {
-		limit = 50
+
+	if article.Status != models.StatusPublished {
+		return nil, utils.NewAppError("NOT_PUBLISHED", "Only published articles can be staff picks", 400)
 	}
 
-	articles, err := s.articleRepo.FindRecent(limit)
-	if err != nil {
-		return nil, utils.WrapError(err, "failed to find recent articles")
+	pick := &models.StaffPick{
+		ArticleID: article.ID,
+		CuratorID: &curatorUUID,
+		Note:      req.Note,
+		Position:  req.Position,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
 	}
 
-	responses := make([]dto.ArticleListItemResponse, len(articles))
+	if s.db != nil {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			return s.articleRepo.WithTx(tx).SaveStaffPick(pick)
+		})
+	} else {
+		// Fallback for unit tests without db - run without transaction
+		err = s.articleRepo.SaveStaffPick(pick)
+	}
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to save staff pick")
+	}
+
+	s.readCache.Invalidate(cache.ArticleChanged)
+	return toStaffPickResponse(pick, article.Slug), nil
+}
+
+// UnpickArticle removes an article from the staff picks
+func (s *articleService) UnpickArticle(id string) error {
+	articleID, err := uuid.Parse(id)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	article, err := s.articleRepo.FindByID(articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrNotFound
+		}
+		return utils.WrapError(err, "failed to find article")
+	}
+
+	if !article.IsStaffPick {
+		return utils.NewAppError("NOT_STAFF_PICK", "Article is not a staff pick", 400)
+	}
+
+	if s.db != nil {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			return s.articleRepo.WithTx(tx).SetStaffPick(article.ID, false)
+		})
+	} else {
+		// Fallback for unit tests without db - run without transaction
+		err = s.articleRepo.SetStaffPick(article.ID, false)
+	}
+	if err != nil {
+		return utils.WrapError(err, "failed to remove staff pick")
+	}
+
+	s.readCache.Invalidate(cache.ArticleChanged)
+	return nil
+}
+
+// UpdateCommentSettings changes who may comment on an article and whether it
+// takes comments. Settings apply at once, even on published articles.
+func (s *articleService) UpdateCommentSettings(id string, req *dto.CommentSettingsRequest, userID string, isEditor bool) (*dto.CommentSettingsResponse, error) {
+	article, err := s.findEditable(id, userID, isEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	applyCommentSettings(article, req)
+
+	if err := s.articleRepo.UpdateCommentSettings(article); err != nil {
+		return nil, utils.WrapError(err, "failed to update comment settings")
+	}
+
+	response := toCommentSettingsResponse(article)
+	return &response, nil
+}
+
+// applyCommentSettings copies the settings in a request onto an article
+func applyCommentSettings(article *models.Article, req *dto.CommentSettingsRequest) {
+	if req.Enabled != nil {
+		article.CommentsDisabled = !*req.Enabled
+	}
+	if req.Locked != nil {
+		article.CommentsLocked = *req.Locked
+	}
+	if req.CloseAfterDays != nil {
+		article.CommentsCloseAfterDays = nil
+		if *req.CloseAfterDays > 0 {
+			days := *req.CloseAfterDays
+			article.CommentsCloseAfterDays = &days
+		}
+	}
+	if req.Audience != nil {
+		article.CommentAudience = models.CommentAudience(*req.Audience)
+	}
+}
+
+// toCommentSettingsResponse converts an article's comment settings to a response DTO
+func toCommentSettingsResponse(article *models.Article) dto.CommentSettingsResponse {
+	audience := article.CommentAudience
+	if audience == "" {
+		audience = models.CommentAudienceEveryone
+	}
+	return dto.CommentSettingsResponse{
+		Enabled:        !article.CommentsDisabled,
+		Locked:         article.CommentsLocked,
+		CloseAfterDays: article.CommentsCloseAfterDays,
+		ClosesAt:       article.CommentsClosesAt(),
+		Audience:       string(audience),
+		State:          string(article.CommentState(time.Now())),
+	}
+}
+
+// toStaffPickResponse converts a staff pick to a response DTO
+func toStaffPickResponse(pick *models.StaffPick, slug string) *dto.StaffPickResponse {
+	response := &dto.StaffPickResponse{
+		ArticleID:   pick.ArticleID.String(),
+		ArticleSlug: slug,
+		Note:        pick.Note,
+		Position:    pick.Position,
+		StartsAt:    pick.StartsAt,
+		EndsAt:      pick.EndsAt,
+		IsActive:    pick.IsActive(time.Now()),
+		UpdatedAt:   pick.UpdatedAt,
+	}
+	if pick.CuratorID != nil {
+		curatorID := pick.CuratorID.String()
+		response.CuratorID = &curatorID
+	}
+	return response
+}
+
+// BulkUpdateArticles applies one action to the articles picked by ID or by a
+// list filter. Articles are updated in chunks, each chunk in one transaction,
+// and a failure on one article is reported without undoing the others.
+// Bulk publishing does not notify followers.
+func (s *articleService) BulkUpdateArticles(req *dto.BulkArticleRequest) (*dto.BulkArticleReport, error) {
+	if len(req.IDs) > 0 && req.Filter != nil {
+		return nil, utils.NewAppError("INVALID_SELECTION", "Provide either article IDs or a filter, not both", 400)
+	}
+	if len(req.IDs) == 0 && req.Filter == nil {
+		return nil, utils.NewAppError("INVALID_SELECTION", "Provide article IDs or a filter", 400)
+	}
+
+	action := bulkAction{name: req.Action}
+	var err error
+
+	switch req.Action {
+	case dto.BulkActionAddTags, dto.BulkActionRemoveTags, dto.BulkActionSetTags:
+		if len(req.TagIDs) == 0 && req.Action != dto.BulkActionSetTags {
+			return nil, utils.NewAppError("TAG_IDS_REQUIRED", "tag_ids is required for "+req.Action, 400)
+		}
+		if action.tags, err = s.findBulkTags(req.TagIDs); err != nil {
+			return nil, err
+		}
+	case dto.BulkActionSetCategories:
+		if len(req.CategoryIDs) == 0 {
+			return nil, utils.NewAppError("CATEGORY_IDS_REQUIRED", "category_ids is required for "+req.Action, 400)
+		}
+		if action.categories, err = s.findBulkCategories(req.CategoryIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	var ids []uuid.UUID
+	if req.Filter != nil {
+		ids, err = s.findBulkArticleIDs(req.Filter)
+	} else {
+		ids, err = parseBulkArticleIDs(req.IDs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.BulkArticleReport{
+		Action:  req.Action,
+		Total:   len(ids),
+		Results: make([]dto.BulkArticleItemResult, 0, len(ids)),
+	}
+
+	for start := 0; start < len(ids); start += bulkChunkSize {
+		end := min(start+bulkChunkSize, len(ids))
+		for _, result := range s.applyBulkChunk(ids[start:end], action) {
+			switch result.Status {
+			case dto.BulkResultOK:
+				report.Succeeded++
+			case dto.BulkResultSkipped:
+				report.Skipped++
+			default:
+				report.Failed++
+			}
+			report.Results = append(report.Results, result)
+		}
+	}
+
+	s.readCache.Invalidate(cache.ArticleChanged)
+	return report, nil
+}
+
+// bulkAction is a validated bulk action with the tags or categories it applies
+type bulkAction struct {
+	name       string
+	tags       []models.Tag
+	categories []models.Category
+}
+
+// errBulkSkipped marks an article a bulk action leaves unchanged
+type errBulkSkipped struct {
+	reason string
+}
+
+func (e *errBulkSkipped) Error() string {
+	return e.reason
+}
+
+// applyBulkChunk applies a bulk action to a chunk of articles in one
+// transaction. Each article gets a savepoint so that a failed article is
+// rolled back on its own.
+func (s *articleService) applyBulkChunk(ids []uuid.UUID, action bulkAction) []dto.BulkArticleItemResult {
+	results := make([]dto.BulkArticleItemResult, 0, len(ids))
+
+	if s.db == nil {
+		// Fallback for unit tests without db - run without transaction
+		for _, id := range ids {
+			slug, err := s.applyBulkAction(s.articleRepo, s.tagRepo, id, action)
+			results = append(results, newBulkResult(id, slug, err))
+		}
+		return results
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for _, id := range ids {
+			var slug string
+			err := tx.Transaction(func(itemTx *gorm.DB) error {
+				var err error
+				slug, err = s.applyBulkAction(s.articleRepo.WithTx(itemTx), s.tagRepo.WithTx(itemTx), id, action)
+				return err
+			})
+			results = append(results, newBulkResult(id, slug, err))
+		}
+		return nil
+	})
+	if err != nil {
+		// The chunk was rolled back as a whole, so none of its changes were kept
+		results = results[:0]
+		for _, id := range ids {
+			results = append(results, newBulkResult(id, "", utils.WrapError(err, "failed to commit changes")))
+		}
+	}
+
+	return results
+}
+
+// newBulkResult converts the outcome of a bulk action on one article to a result
+func newBulkResult(id uuid.UUID, slug string, err error) dto.BulkArticleItemResult {
+	result := dto.BulkArticleItemResult{ID: id.String(), Slug: slug, Status: dto.BulkResultOK}
+
+	var skipped *errBulkSkipped
+	switch {
+	case err == nil:
+	case errors.As(err, &skipped):
+		result.Status = dto.BulkResultSkipped
+		result.Message = skipped.reason
+	default:
+		result.Status = dto.BulkResultError
+		result.Message = err.Error()
+	}
+
+	return result
+}
+
+// applyBulkAction applies a bulk action to one article using the given
+// repositories, keeping tag usage counts in step with the tags changed
+func (s *articleService) applyBulkAction(articleRepo repositories.ArticleRepository, tagRepo repositories.TagRepository, id uuid.UUID, action bulkAction) (string, error) {
+	article, err := articleRepo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", utils.NewAppError("NOT_FOUND", "Article not found", 404)
+		}
+		return "", utils.WrapError(err, "failed to find article")
+	}
+
+	switch action.name {
+	case dto.BulkActionPublish, dto.BulkActionUnpublish:
+		publish := action.name == dto.BulkActionPublish
+		if publish == (article.Status == models.StatusPublished) {
+			if publish {
+				return article.Slug, &errBulkSkipped{reason: "Article is already published"}
+			}
+			return article.Slug, &errBulkSkipped{reason: "Article is not published"}
+		}
+		if publish {
+			article.Publish()
+		} else {
+			article.Unpublish()
+		}
+		if err := articleRepo.Update(article); err != nil {
+			if errors.Is(err, repositories.ErrVersionConflict) {
+				return article.Slug, utils.NewAppError("VERSION_CONFLICT", "Article was modified by another request", 409)
+			}
+			return article.Slug, utils.WrapError(err, "failed to "+action.name+" article")
+		}
+
+	case dto.BulkActionDelete:
+		for _, tag := range article.Tags {
+			if err := tagRepo.DecrementUsage(tag.ID); err != nil {
+				return article.Slug, utils.WrapError(err, "failed to decrement tag usage")
+			}
+		}
+		if err := articleRepo.Delete(article.ID); err != nil {
+			return article.Slug, utils.WrapError(err, "failed to delete article")
+		}
+
+	case dto.BulkActionStaffPick, dto.BulkActionUnstaffPick:
+		isStaffPick := action.name == dto.BulkActionStaffPick
+		if article.IsStaffPick == isStaffPick {
+			return article.Slug, &errBulkSkipped{reason: "Staff pick is already set"}
+		}
+		if err := articleRepo.SetStaffPick(article.ID, isStaffPick); err != nil {
+			return article.Slug, utils.WrapError(err, "failed to update staff pick")
+		}
+
+	case dto.BulkActionAddTags, dto.BulkActionRemoveTags, dto.BulkActionSetTags:
+		newTags := bulkTags(article.Tags, action)
+		added, removed := diffTags(article.Tags, newTags)
+		if len(added) == 0 && len(removed) == 0 {
+			return article.Slug, &errBulkSkipped{reason: "Tags are unchanged"}
+		}
+		for _, tag := range removed {
+			if err := tagRepo.DecrementUsage(tag.ID); err != nil {
+				return article.Slug, utils.WrapError(err, "failed to decrement tag usage")
+			}
+		}
+		if err := articleRepo.UpdateTags(article, newTags); err != nil {
+			return article.Slug, utils.WrapError(err, "failed to update tags")
+		}
+		for _, tag := range added {
+			if err := tagRepo.IncrementUsage(tag.ID); err != nil {
+				return article.Slug, utils.WrapError(err, "failed to increment tag usage")
+			}
+		}
+
+	case dto.BulkActionSetCategories:
+		if sameCategories(article.Categories, action.categories) {
+			return article.Slug, &errBulkSkipped{reason: "Categories are unchanged"}
+		}
+		if err := articleRepo.UpdateCategories(article, action.categories); err != nil {
+			return article.Slug, utils.WrapError(err, "failed to update categories")
+		}
+	}
+
+	return article.Slug, nil
+}
+
+// findBulkArticleIDs returns the IDs of the articles matching a list filter.
+// Filters may match unpublished articles, and pagination is ignored.
+func (s *articleService) findBulkArticleIDs(query *dto.ArticleListQuery) ([]uuid.UUID, error) {
+	filters := repositories.ArticleFilters{
+		Status:          query.Status,
+		AllVisibilities: true,
+		Limit:           maxBulkArticles,
+		Sort:            "oldest",
+	}
+
+	if query.AuthorID != "" {
+		authorID, err := uuid.Parse(query.AuthorID)
+		if err != nil {
+			return nil, utils.NewAppError("INVALID_AUTHOR_ID", "Invalid author ID", 400)
+		}
+		filters.AuthorID = &authorID
+	}
+
+	articles, total, err := s.findArticles(query, filters)
+	if err != nil {
+		return nil, err
+	}
+	if total > maxBulkArticles {
+		return nil, utils.NewAppError("TOO_MANY_ARTICLES", fmt.Sprintf("Filter matches %d articles, at most %d can be updated at once", total, maxBulkArticles), 400)
+	}
+
+	ids := make([]uuid.UUID, len(articles))
 	for i, article := range articles {
-		responses[i] = toArticleListItemResponse(&article)
+		ids[i] = article.ID
 	}
 
-	return responses, nil
+	return ids, nil
+}
+
+// parseBulkArticleIDs parses article IDs, dropping duplicates
+func parseBulkArticleIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	seen := make(map[uuid.UUID]bool, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, utils.NewAppError("INVALID_ARTICLE_ID", "Invalid article ID: "+value, 400)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// findBulkTags loads the tags for a bulk tag action
+func (s *articleService) findBulkTags(values []string) ([]models.Tag, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	tagIDs := make([]uuid.UUID, len(values))
+	for i, id := range values {
+		tagID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, utils.NewAppError("INVALID_TAG_ID", "Invalid tag ID: "+id, 400)
+		}
+		tagIDs[i] = tagID
+	}
+
+	tags, err := s.tagRepo.FindByIDs(tagIDs)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to find tags")
+	}
+	if len(tags) != len(tagIDs) {
+		return nil, utils.NewAppError("TAG_NOT_FOUND", "One or more tags not found", 404)
+	}
+
+	return tags, nil
+}
+
+// findBulkCategories loads the categories for a bulk category action
+func (s *articleService) findBulkCategories(values []string) ([]models.Category, error) {
+	categoryIDs := make([]uuid.UUID, len(values))
+	for i, id := range values {
+		catID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, utils.NewAppError("INVALID_CATEGORY_ID", "Invalid category ID: "+id, 400)
+		}
+		categoryIDs[i] = catID
+	}
+
+	categories, err := s.categoryRepo.FindByIDs(categoryIDs)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to find categories")
+	}
+	if len(categories) != len(categoryIDs) {
+		return nil, utils.NewAppError("CATEGORY_NOT_FOUND", "One or more categories not found", 404)
+	}
+
+	return categories, nil
+}
+
+// bulkTags returns an article's tags after a bulk tag action
+func bulkTags(current []models.Tag, action bulkAction) []models.Tag {
+	switch action.name {
+	case dto.BulkActionSetTags:
+		return action.tags
+	case dto.BulkActionRemoveTags:
+		tags := make([]models.Tag, 0, len(current))
+		for _, tag := range current {
+			if !containsTag(action.tags, tag.ID) {
+				tags = append(tags, tag)
+			}
+		}
+		return tags
+	default:
+		tags := append([]models.Tag{}, current...)
+		for _, tag := range action.tags {
+			if !containsTag(tags, tag.ID) {
+				tags = append(tags, tag)
+			}
+		}
+		return tags
+	}
+}
+
+// diffTags returns the tags added and removed going from old to new
+func diffTags(old, new []models.Tag) (added, removed []models.Tag) {
+	for _, tag := range new {
+		if !containsTag(old, tag.ID) {
+			added = append(added, tag)
+		}
+	}
+	for _, tag := range old {
+		if !containsTag(new, tag.ID) {
+			removed = append(removed, tag)
+		}
+	}
+	return added, removed
+}
+
+// containsTag checks if a tag is in a list
+func containsTag(tags []models.Tag, id uuid.UUID) bool {
+	for _, tag := range tags {
+		if tag.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// sameCategories checks if two category lists hold the same categories
+func sameCategories(a, b []models.Category) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ids := make(map[uuid.UUID]bool, len(a))
+	for _, category := range a {
+		ids[category.ID] = true
+	}
+	for _, category := range b {
+		if !ids[category.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+// GetTrendingArticles retrieves the articles trending over a window, optionally
+// limited to a category and to a language
+func (s *articleService) GetTrendingArticles(query *dto.TrendingQuery) ([]dto.ArticleListItemResponse, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	filters := repositories.TrendingFilters{Window: query.Window}
+	if filters.Window == "" {
+		filters.Window = trending.DefaultWindow
+	}
+
+	if query.Lang != "" {
+		locale, err := normalizeLocale(query.Lang)
+		if err != nil {
+			return nil, err
+		}
+		filters.Language = utils.BaseLanguage(locale)
+	}
+
+	if query.Category != "" {
+		category, err := s.categoryRepo.FindBySlug(query.Category)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, utils.ErrNotFound
+			}
+			return nil, utils.WrapError(err, "failed to find category")
+		}
+		filters.CategoryID = &category.ID
+	}
+
+	key := fmt.Sprintf("%strending:%s:%s:%s:%d", cache.ArticleReads, filters.Window, query.Category, filters.Language, limit)
+	return cache.Remember(s.readCache, key, func() ([]dto.ArticleListItemResponse, error) {
+		articles, err := s.articleRepo.FindTrending(filters, limit)
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to find trending articles")
+		}
+
+		responses := make([]dto.ArticleListItemResponse, len(articles))
+		for i, article := range articles {
+			responses[i] = toArticleListItemResponse(&article)
+		}
+		return responses, nil
+	})
+}
+
+// GetRecentArticles retrieves recent articles
+func (s *articleService) GetRecentArticles(limit int) ([]dto.ArticleListItemResponse, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	key := fmt.Sprintf("%srecent:%d", cache.ArticleReads, limit)
+	return cache.Remember(s.readCache, key, func() ([]dto.ArticleListItemResponse, error) {
+		articles, err := s.articleRepo.FindRecent(limit)
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to find recent articles")
+		}
+
+		responses := make([]dto.ArticleListItemResponse, len(articles))
+		for i, article := range articles {
+			responses[i] = toArticleListItemResponse(&article)
+		}
+		return responses, nil
+	})
 }
 
-// GetRelatedArticles retrieves related articles
+// GetRelatedArticles retrieves related articles in the same language
 func (s *articleService) GetRelatedArticles(slug string, limit int) ([]dto.ArticleListItemResponse, error) {
 	if limit <= 0 {
 		limit = 5
 	}
-	if limit > 20 {
-		limit = 20
+	if limit > maxRelatedArticles {
+		limit = maxRelatedArticles
 	}
 
 	article, err := s.articleRepo.FindBySlug(slug)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, utils.ErrNotFound
+			return nil, s.redirectFromSlug(slug)
 		}
 		return nil, utils.WrapError(err, "failed to find article")
 	}
 
-	categoryIDs := article.GetCategoryIDs()
-	tagIDs := article.GetTagIDs()
+	if !article.IsPubliclyVisible() {
+		return nil, utils.ErrNotFound
+	}
 
-	articles, err := s.articleRepo.FindRelated(article.ID, categoryIDs, tagIDs, limit)
-	if err != nil {
-		return nil, utils.WrapError(err, "failed to find related articles")
+	var articles []models.Article
+	if s.relatedRepo != nil {
+		articles, err = s.relatedRepo.FindRelated(article.ID, limit)
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to find related articles")
+		}
+	}
+
+	if len(articles) == 0 {
+		categoryIDs := article.GetCategoryIDs()
+		tagIDs := article.GetTagIDs()
+
+		// Related articles are in the same language as the one being read
+		articles, err = s.articleRepo.FindRelated(article.ID, categoryIDs, tagIDs, limit, utils.BaseLanguage(article.Locale))
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to find related articles")
+		}
 	}
 
 	responses := make([]dto.ArticleListItemResponse, len(articles))
@@ -698,6 +1998,23 @@ func (s *articleService) SearchArticles(query string, filters *dto.ArticleListQu
 	return responses, total, nil
 }
 
+// attachSeries adds series navigation to the response when the article is part of a series.
+// The navigation only links to the parts the viewer may find.
+func (s *articleService) attachSeries(response *dto.ArticleDetailResponse, article *models.Article, viewer Viewer) {
+	if s.seriesRepo == nil {
+		return
+	}
+	series, err := s.seriesRepo.FindByArticle(article.ID)
+	if err != nil {
+		return
+	}
+	audience, err := newSeriesAudience(s.userRepo, series, viewer)
+	if err != nil {
+		return
+	}
+	response.Series = toArticleSeriesResponse(series, article.ID, audience)
+}
+
 // toDetailResponse converts an article model to a detail response DTO
 func (s *articleService) toDetailResponse(article *models.Article) *dto.ArticleDetailResponse {
 	response := &dto.ArticleDetailResponse{
@@ -706,18 +2023,40 @@ func (s *articleService) toDetailResponse(article *models.Article) *dto.ArticleD
 		Slug:               article.Slug,
 		Excerpt:            article.Excerpt,
 		Content:            article.Content,
+		ContentFormat:      string(article.ContentFormat),
+		ContentHTML:        article.ContentHTML,
 		FeaturedImageURL:   article.FeaturedImageURL,
 		Status:             string(article.Status),
+		Visibility:         string(article.Visibility),
 		PublishedAt:        article.PublishedAt,
 		ViewCount:          article.ViewCount,
+		ReadCount:          article.ReadCount,
 		ReadingTimeMinutes: article.ReadingTimeMinutes,
 		MetaTitle:          article.MetaTitle,
 		MetaDescription:    article.MetaDescription,
 		MetaKeywords:       article.MetaKeywords,
+		Version:            article.Version,
+		Locale:             article.Locale,
+		Translations:       []dto.ArticleTranslation{},
+		Comments:           toCommentSettingsResponse(article),
 		CreatedAt:          article.CreatedAt,
 		UpdatedAt:          article.UpdatedAt,
 	}
 
+	// Rows written before Markdown support have no rendered copy yet
+	toc := article.TableOfContents
+	if response.ContentHTML == "" && article.Content != "" {
+		rendered := *article
+		if err := renderContent(&rendered); err == nil {
+			response.ContentHTML = rendered.ContentHTML
+			response.ContentFormat = string(rendered.ContentFormat)
+			toc = rendered.TableOfContents
+		}
+	}
+	if toc != "" {
+		_ = json.Unmarshal([]byte(toc), &response.TableOfContents)
+	}
+
 	if article.Author != nil {
 		response.Author = dto.PublicUserResponse{
 			ID:              article.Author.ID.String(),
@@ -747,3 +2086,420 @@ func (s *articleService) toDetailResponse(article *models.Article) *dto.ArticleD
 
 	return response
 }
+
+// GetEditLock returns the active edit lock on an article, or nil if nobody is editing it
+func (s *articleService) GetEditLock(id string, userID string, isEditor bool) (*dto.EditLockResponse, error) {
+	article, err := s.findEditable(id, userID, isEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := s.lockRepo.Find(article.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, utils.WrapError(err, "failed to find edit lock")
+	}
+	if lock.IsExpired() {
+		return nil, nil
+	}
+
+	return toEditLockResponse(lock, userID), nil
+}
+
+// AcquireEditLock takes or refreshes the advisory edit lock on an article.
+// Clients call it periodically while the editor is open to keep the lock alive.
+func (s *articleService) AcquireEditLock(id string, userID string, isEditor bool) (*dto.EditLockResponse, error) {
+	article, err := s.findEditable(id, userID, isEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	lock, err := s.lockRepo.Acquire(article.ID, userUUID, time.Now().Add(editLockTTL))
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to acquire edit lock")
+	}
+
+	if lock.UserID != userUUID {
+		holder := "Another user"
+		if lock.User != nil {
+			holder = lock.User.Username
+		}
+		return nil, utils.NewAppError("ARTICLE_LOCKED", holder+" is editing this article", 409)
+	}
+
+	return toEditLockResponse(lock, userID), nil
+}
+
+// ReleaseEditLock releases the edit lock on an article. Editors may break locks held by others.
+func (s *articleService) ReleaseEditLock(id string, userID string, isEditor bool) error {
+	article, err := s.findEditable(id, userID, isEditor)
+	if err != nil {
+		return err
+	}
+
+	lock, err := s.lockRepo.Find(article.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return utils.WrapError(err, "failed to find edit lock")
+	}
+
+	if lock.UserID.String() != userID && !lock.IsExpired() && !isEditor {
+		return utils.ErrForbidden
+	}
+
+	if err := s.lockRepo.Release(article.ID); err != nil {
+		return utils.WrapError(err, "failed to release edit lock")
+	}
+
+	return nil
+}
+
+// CreatePreviewLink creates a signed, expiring link that shows an unpublished article without login
+func (s *articleService) CreatePreviewLink(id string, req *dto.CreatePreviewRequest, userID string, isEditor bool) (*dto.PreviewLinkResponse, error) {
+	article, err := s.findEditable(id, userID, isEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	if article.IsPubliclyVisible() {
+		return nil, utils.NewAppError("ARTICLE_ALREADY_PUBLIC", "Article is already published", 400)
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	hours := req.ExpiresInHours
+	if hours <= 0 {
+		hours = defaultPreviewTTLHours
+	}
+
+	preview := &models.ArticlePreview{
+		ArticleID: article.ID,
+		CreatedBy: userUUID,
+		ExpiresAt: time.Now().Add(time.Duration(hours) * time.Hour),
+	}
+	if err := s.previewRepo.Create(preview); err != nil {
+		return nil, utils.WrapError(err, "failed to create preview link")
+	}
+
+	return s.toPreviewLinkResponse(preview)
+}
+
+// GetPreviewLinks lists the preview links of an article, including expired and revoked ones
+func (s *articleService) GetPreviewLinks(id string, userID string, isEditor bool) ([]dto.PreviewLinkResponse, error) {
+	article, err := s.findEditable(id, userID, isEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	previews, err := s.previewRepo.FindByArticle(article.ID)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch preview links")
+	}
+
+	responses := make([]dto.PreviewLinkResponse, len(previews))
+	for i := range previews {
+		response, err := s.toPreviewLinkResponse(&previews[i])
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = *response
+	}
+
+	return responses, nil
+}
+
+// RevokePreviewLink revokes a preview link so it stops working before it expires
+func (s *articleService) RevokePreviewLink(id string, previewID string, userID string, isEditor bool) error {
+	article, err := s.findEditable(id, userID, isEditor)
+	if err != nil {
+		return err
+	}
+
+	previewUUID, err := uuid.Parse(previewID)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	preview, err := s.previewRepo.FindByID(previewUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrNotFound
+		}
+		return utils.WrapError(err, "failed to find preview link")
+	}
+	if preview.ArticleID != article.ID {
+		return utils.ErrNotFound
+	}
+
+	if err := s.previewRepo.Revoke(preview.ID); err != nil {
+		return utils.WrapError(err, "failed to revoke preview link")
+	}
+
+	return nil
+}
+
+// GetPreview retrieves the article behind a preview link token. Previews are
+// never counted as views. Once the article is live the link stops working, so
+// the usual read rules apply to its visibility.
+func (s *articleService) GetPreview(token string) (*dto.ArticleDetailResponse, error) {
+	invalid := utils.NewAppError("INVALID_PREVIEW_LINK", "Preview link is invalid, expired or revoked", 404)
+
+	claims, err := utils.ValidatePreviewToken(token, s.previewSecret)
+	if err != nil {
+		return nil, invalid
+	}
+
+	previewID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return nil, invalid
+	}
+
+	preview, err := s.previewRepo.FindByID(previewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid
+		}
+		return nil, utils.WrapError(err, "failed to find preview link")
+	}
+	if !preview.IsActive() || preview.ArticleID.String() != claims.ArticleID {
+		return nil, invalid
+	}
+
+	article, err := s.articleRepo.FindByID(preview.ArticleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid
+		}
+		return nil, utils.WrapError(err, "failed to find article")
+	}
+	if article.IsPubliclyVisible() {
+		return nil, invalid
+	}
+
+	response := s.toDetailResponse(article)
+	s.attachSeries(response, article, Viewer{})
+	response.IsPreview = true
+
+	return response, nil
+}
+
+// toPreviewLinkResponse converts a preview link to a response DTO with its signed token
+func (s *articleService) toPreviewLinkResponse(preview *models.ArticlePreview) (*dto.PreviewLinkResponse, error) {
+	token, err := utils.GeneratePreviewToken(preview.ID, preview.ArticleID, s.previewSecret, preview.ExpiresAt)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to sign preview link")
+	}
+
+	return &dto.PreviewLinkResponse{
+		ID:        preview.ID.String(),
+		Token:     token,
+		IsActive:  preview.IsActive(),
+		ExpiresAt: preview.ExpiresAt,
+		RevokedAt: preview.RevokedAt,
+		CreatedAt: preview.CreatedAt,
+	}, nil
+}
+
+// findEditable loads an article by ID and checks that the user may edit it
+func (s *articleService) findEditable(id string, userID string, isEditor bool) (*models.Article, error) {
+	articleID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	article, err := s.articleRepo.FindByID(articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, utils.WrapError(err, "failed to find article")
+	}
+
+	if article.AuthorID.String() != userID && !isEditor {
+		return nil, utils.ErrForbidden
+	}
+
+	return article, nil
+}
+
+// versionConflict reports the stored version of an article that changed during an update
+func (s *articleService) versionConflict(id uuid.UUID) error {
+	current, err := s.articleRepo.FindByID(id)
+	if err != nil {
+		return utils.WrapError(err, "failed to fetch current article")
+	}
+	return &utils.VersionConflictError{CurrentVersion: current.Version}
+}
+
+// toEditLockResponse converts an edit lock to a response DTO
+func toEditLockResponse(lock *models.ArticleEditLock, userID string) *dto.EditLockResponse {
+	response := &dto.EditLockResponse{
+		ArticleID: lock.ArticleID.String(),
+		IsMine:    lock.UserID.String() == userID,
+		ExpiresAt: lock.ExpiresAt,
+	}
+
+	if lock.User != nil {
+		response.User = dto.PublicUserResponse{
+			ID:              lock.User.ID.String(),
+			Username:        lock.User.Username,
+			FirstName:       lock.User.FirstName,
+			LastName:        lock.User.LastName,
+			Bio:             lock.User.Bio,
+			ProfileImageURL: lock.User.ProfileImageURL,
+		}
+	}
+
+	return response
+}
+
+// redirectFromSlug resolves a retired article slug to a redirect
+func (s *articleService) redirectFromSlug(slug string) error {
+	return slugRedirect(s.slugRepo, models.SlugEntityArticle, slug, func(id uuid.UUID) (string, error) {
+		article, err := s.articleRepo.FindByID(id)
+		if err != nil {
+			return "", err
+		}
+		return article.Slug, nil
+	})
+}
+
+// translationGroup returns the translation group of the article being translated,
+// checking that the group has no article in the new locale yet
+func (s *articleService) translationGroup(sourceID string, locale string) (uuid.UUID, error) {
+	sourceUUID, err := uuid.Parse(sourceID)
+	if err != nil {
+		return uuid.Nil, utils.ErrBadRequest
+	}
+
+	source, err := s.articleRepo.FindByID(sourceUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return uuid.Nil, utils.NewAppError("TRANSLATION_SOURCE_NOT_FOUND", "Article to translate not found", 404)
+		}
+		return uuid.Nil, utils.WrapError(err, "failed to find article to translate")
+	}
+
+	groupID := source.TranslationGroupID
+	if groupID == uuid.Nil {
+		groupID = source.ID
+	}
+
+	if err := s.checkTranslationLocale(groupID, uuid.Nil, locale); err != nil {
+		return uuid.Nil, err
+	}
+
+	return groupID, nil
+}
+
+// checkTranslationLocale reports a conflict if another article in the group already uses the locale
+func (s *articleService) checkTranslationLocale(groupID, exceptID uuid.UUID, locale string) error {
+	translations, err := s.articleRepo.FindTranslations(groupID)
+	if err != nil {
+		return utils.WrapError(err, "failed to fetch translations")
+	}
+
+	for _, translation := range translations {
+		if translation.ID != exceptID && translation.Locale == locale {
+			return utils.NewAppError("TRANSLATION_EXISTS", "A translation in this locale already exists: "+translation.Slug, 409)
+		}
+	}
+
+	return nil
+}
+
+// visibleTranslations returns the other articles in the translation group that the viewer may read
+func (s *articleService) visibleTranslations(article *models.Article, viewer Viewer) ([]models.Article, error) {
+	if article.TranslationGroupID == uuid.Nil {
+		return nil, nil
+	}
+
+	group, err := s.articleRepo.FindTranslations(article.TranslationGroupID)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch translations")
+	}
+
+	var translations []models.Article
+	for i := range group {
+		if group[i].ID == article.ID {
+			continue
+		}
+		canRead, err := s.canRead(&group[i], viewer)
+		if err != nil {
+			return nil, err
+		}
+		if canRead {
+			translations = append(translations, group[i])
+		}
+	}
+
+	return translations, nil
+}
+
+// toTranslationResponses converts translations to response DTOs
+func toTranslationResponses(articles []models.Article) []dto.ArticleTranslation {
+	responses := make([]dto.ArticleTranslation, len(articles))
+	for i, article := range articles {
+		responses[i] = dto.ArticleTranslation{
+			ID:     article.ID.String(),
+			Title:  article.Title,
+			Slug:   article.Slug,
+			Locale: article.Locale,
+		}
+	}
+	return responses
+}
+
+// normalizeLocale validates a locale from a request and returns its canonical form
+func normalizeLocale(locale string) (string, error) {
+	normalized, err := utils.NormalizeLocale(locale)
+	if err != nil {
+		return "", utils.NewAppError("INVALID_LOCALE", "Invalid locale: "+locale, 400)
+	}
+	return normalized, nil
+}
+
+// renderContent fills the sanitized HTML and table of contents from the article source
+func renderContent(article *models.Article) error {
+	if article.ContentFormat != models.ContentFormatMarkdown {
+		article.ContentFormat = models.ContentFormatHTML
+		article.ContentHTML = utils.SanitizeHTML(article.Content)
+		article.TableOfContents = ""
+		return nil
+	}
+
+	html, toc, err := utils.RenderMarkdown(article.Content)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(toTOCEntries(toc))
+	if err != nil {
+		return err
+	}
+
+	article.ContentHTML = html
+	article.TableOfContents = string(encoded)
+	return nil
+}
+
+// toTOCEntries converts rendered headings to the table of contents stored with the article
+func toTOCEntries(headings []utils.TOCEntry) []dto.TOCEntry {
+	entries := make([]dto.TOCEntry, len(headings))
+	for i, heading := range headings {
+		entries[i] = dto.TOCEntry{Level: heading.Level, Text: heading.Text, Anchor: heading.Anchor}
+	}
+	return entries
+}
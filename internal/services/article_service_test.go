@@ -1,11 +1,14 @@
 package services
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
 	"github.com/alfafaa/alfafaa-blog/internal/utils"
 	"github.com/alfafaa/alfafaa-blog/tests/mocks"
 	"github.com/google/uuid"
@@ -84,6 +87,120 @@ func (suite *ArticleServiceTestSuite) TestCreateArticle_Success() {
 	suite.tagRepo.AssertExpectations(suite.T())
 }
 
+func (suite *ArticleServiceTestSuite) TestCreateArticle_RendersMarkdown() {
+	authorID := uuid.New()
+
+	req := &dto.CreateArticleRequest{
+		Title:         "Markdown Article",
+		Content:       "## Intro\n\nSome **bold** text<script>alert(1)</script>",
+		ContentFormat: "markdown",
+	}
+
+	created := &models.Article{}
+	suite.articleRepo.On("ExistsBySlug", "markdown-article").Return(false, nil)
+	suite.categoryRepo.On("FindByIDs", []uuid.UUID{}).Return([]models.Category{}, nil)
+	suite.tagRepo.On("FindByIDs", []uuid.UUID{}).Return([]models.Tag{}, nil)
+	suite.articleRepo.On("Create", mock.AnythingOfType("*models.Article")).Run(func(args mock.Arguments) {
+		*created = *args.Get(0).(*models.Article)
+	}).Return(nil)
+	suite.articleRepo.On("FindByID", mock.AnythingOfType("uuid.UUID")).Return(created, nil)
+
+	result, err := suite.service.CreateArticle(req, authorID.String())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "markdown", result.ContentFormat)
+	assert.Contains(suite.T(), result.ContentHTML, "<strong>bold</strong>")
+	assert.NotContains(suite.T(), result.ContentHTML, "<script>")
+	assert.Len(suite.T(), result.TableOfContents, 1)
+	assert.Equal(suite.T(), "intro", result.TableOfContents[0].Anchor)
+}
+
+func (suite *ArticleServiceTestSuite) TestCreateArticle_DefaultsToHTML() {
+	authorID := uuid.New()
+
+	req := &dto.CreateArticleRequest{
+		Title:   "HTML Article",
+		Content: "<p>Some <strong>bold</strong> text</p>",
+	}
+
+	created := &models.Article{}
+	suite.articleRepo.On("ExistsBySlug", "html-article").Return(false, nil)
+	suite.categoryRepo.On("FindByIDs", []uuid.UUID{}).Return([]models.Category{}, nil)
+	suite.tagRepo.On("FindByIDs", []uuid.UUID{}).Return([]models.Tag{}, nil)
+	suite.articleRepo.On("Create", mock.AnythingOfType("*models.Article")).Run(func(args mock.Arguments) {
+		*created = *args.Get(0).(*models.Article)
+	}).Return(nil)
+	suite.articleRepo.On("FindByID", mock.AnythingOfType("uuid.UUID")).Return(created, nil)
+
+	result, err := suite.service.CreateArticle(req, authorID.String())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.ContentFormatHTML, created.ContentFormat)
+	assert.Equal(suite.T(), "html", result.ContentFormat)
+}
+
+func (suite *ArticleServiceTestSuite) TestCreateArticle_Translation() {
+	source := &models.Article{ID: uuid.New(), Slug: "hello", Locale: "en"}
+	source.TranslationGroupID = source.ID
+
+	req := &dto.CreateArticleRequest{
+		Title:         "Salaam Duniya",
+		Content:       "Translated content",
+		Locale:        "ur_pk",
+		TranslationOf: source.ID.String(),
+	}
+
+	created := &models.Article{}
+	suite.articleRepo.On("FindByID", source.ID).Return(source, nil).Once()
+	suite.articleRepo.On("FindTranslations", source.ID).Return([]models.Article{*source}, nil)
+	suite.articleRepo.On("ExistsBySlug", "salaam-duniya").Return(false, nil)
+	suite.categoryRepo.On("FindByIDs", []uuid.UUID{}).Return([]models.Category{}, nil)
+	suite.tagRepo.On("FindByIDs", []uuid.UUID{}).Return([]models.Tag{}, nil)
+	suite.articleRepo.On("Create", mock.AnythingOfType("*models.Article")).Run(func(args mock.Arguments) {
+		*created = *args.Get(0).(*models.Article)
+	}).Return(nil)
+	suite.articleRepo.On("FindByID", mock.AnythingOfType("uuid.UUID")).Return(created, nil)
+
+	result, err := suite.service.CreateArticle(req, uuid.New().String())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "ur-PK", result.Locale)
+	assert.Equal(suite.T(), source.ID, created.TranslationGroupID)
+}
+
+func (suite *ArticleServiceTestSuite) TestCreateArticle_TranslationLocaleTaken() {
+	source := &models.Article{ID: uuid.New(), Slug: "hello", Locale: "en"}
+	source.TranslationGroupID = source.ID
+
+	req := &dto.CreateArticleRequest{
+		Title:         "Hello again",
+		Content:       "Content",
+		Locale:        "en",
+		TranslationOf: source.ID.String(),
+	}
+
+	suite.articleRepo.On("FindByID", source.ID).Return(source, nil)
+	suite.articleRepo.On("FindTranslations", source.ID).Return([]models.Article{*source}, nil)
+
+	result, err := suite.service.CreateArticle(req, uuid.New().String())
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "TRANSLATION_EXISTS", appErr.Code)
+}
+
+func (suite *ArticleServiceTestSuite) TestCreateArticle_InvalidLocale() {
+	req := &dto.CreateArticleRequest{Title: "Test Article", Content: "Content", Locale: "not a locale"}
+
+	result, err := suite.service.CreateArticle(req, uuid.New().String())
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "INVALID_LOCALE", appErr.Code)
+}
+
 func (suite *ArticleServiceTestSuite) TestCreateArticle_InvalidAuthorID() {
 	req := &dto.CreateArticleRequest{
 		Title:   "Test Article",
@@ -189,7 +306,7 @@ func (suite *ArticleServiceTestSuite) TestGetArticle_Success() {
 
 	suite.articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 
-	result, err := suite.service.GetArticle("test-article", false)
+	result, err := suite.service.GetArticle("test-article", Viewer{}, false)
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
@@ -212,7 +329,7 @@ func (suite *ArticleServiceTestSuite) TestGetArticle_WithViewIncrement() {
 	suite.articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 	suite.articleRepo.On("IncrementViewCount", articleID).Return(nil)
 
-	result, err := suite.service.GetArticle("test-article", true)
+	result, err := suite.service.GetArticle("test-article", Viewer{}, true)
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
@@ -223,7 +340,7 @@ func (suite *ArticleServiceTestSuite) TestGetArticle_WithViewIncrement() {
 func (suite *ArticleServiceTestSuite) TestGetArticle_NotFound() {
 	suite.articleRepo.On("FindBySlug", "nonexistent").Return(nil, gorm.ErrRecordNotFound)
 
-	result, err := suite.service.GetArticle("nonexistent", false)
+	result, err := suite.service.GetArticle("nonexistent", Viewer{}, false)
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -231,8 +348,176 @@ func (suite *ArticleServiceTestSuite) TestGetArticle_NotFound() {
 	suite.articleRepo.AssertExpectations(suite.T())
 }
 
+func (suite *ArticleServiceTestSuite) TestGetArticle_DraftHiddenFromPublic() {
+	article := &models.Article{ID: uuid.New(), AuthorID: uuid.New(), Slug: "draft", Status: models.StatusDraft}
+	suite.articleRepo.On("FindBySlug", "draft").Return(article, nil)
+
+	result, err := suite.service.GetArticle("draft", Viewer{UserID: uuid.New().String()}, true)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), utils.ErrNotFound, err)
+	suite.articleRepo.AssertNotCalled(suite.T(), "IncrementViewCount", article.ID)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticle_ScheduledHiddenFromPublic() {
+	publishAt := time.Now().Add(time.Hour)
+	article := &models.Article{ID: uuid.New(), AuthorID: uuid.New(), Slug: "scheduled", Status: models.StatusPublished, PublishedAt: &publishAt}
+	suite.articleRepo.On("FindBySlug", "scheduled").Return(article, nil)
+
+	result, err := suite.service.GetArticle("scheduled", Viewer{}, false)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), utils.ErrNotFound, err)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticle_DraftVisibleToAuthorAndEditor() {
+	authorID := uuid.New()
+	article := &models.Article{ID: uuid.New(), AuthorID: authorID, Slug: "draft", Status: models.StatusDraft}
+	suite.articleRepo.On("FindBySlug", "draft").Return(article, nil)
+
+	result, err := suite.service.GetArticle("draft", Viewer{UserID: authorID.String()}, false)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+
+	result, err = suite.service.GetArticle("draft", Viewer{UserID: uuid.New().String(), IsEditor: true}, false)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticle_FollowersOnly() {
+	userRepo := new(mocks.MockUserRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithUserRepo(userRepo))
+
+	authorID := uuid.New()
+	follower := uuid.New()
+	stranger := uuid.New()
+	article := &models.Article{ID: uuid.New(), AuthorID: authorID, Slug: "followers", Status: models.StatusPublished, Visibility: models.VisibilityFollowers}
+	suite.articleRepo.On("FindBySlug", "followers").Return(article, nil)
+	userRepo.On("IsFollowing", follower, authorID).Return(true, nil)
+	userRepo.On("IsFollowing", stranger, authorID).Return(false, nil)
+
+	result, err := service.GetArticle("followers", Viewer{UserID: follower.String()}, false)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+
+	result, err = service.GetArticle("followers", Viewer{UserID: authorID.String()}, false)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+
+	_, err = service.GetArticle("followers", Viewer{UserID: stranger.String()}, false)
+	assert.Equal(suite.T(), utils.ErrNotFound, err)
+
+	_, err = service.GetArticle("followers", Viewer{}, false)
+	assert.Equal(suite.T(), utils.ErrNotFound, err)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticle_MembersOnlyPreview() {
+	content := strings.Repeat("word ", membersPreviewLength)
+	article := &models.Article{
+		ID:            uuid.New(),
+		AuthorID:      uuid.New(),
+		Slug:          "members",
+		Content:       content,
+		ContentFormat: models.ContentFormatMarkdown,
+		ContentHTML:   "<p>" + content + "</p>",
+		Status:        models.StatusPublished,
+		Visibility:    models.VisibilityMembers,
+	}
+	suite.articleRepo.On("FindBySlug", "members").Return(article, nil)
+
+	result, err := suite.service.GetArticle("members", Viewer{}, false)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsTruncated)
+	assert.Equal(suite.T(), "html", result.ContentFormat)
+	assert.Less(suite.T(), len(result.Content), len(content))
+	assert.Equal(suite.T(), result.Content, result.ContentHTML)
+
+	result, err = suite.service.GetArticle("members", Viewer{UserID: uuid.New().String()}, false)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), result.IsTruncated)
+	assert.Equal(suite.T(), content, result.Content)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticles_EditorSeesAllVisibilities() {
+	query := &dto.ArticleListQuery{PaginationQuery: dto.PaginationQuery{Page: 1, PerPage: 10}}
+	suite.articleRepo.On("FindAll", mock.MatchedBy(func(filters repositories.ArticleFilters) bool {
+		return filters.AllVisibilities && filters.Status == ""
+	})).Return([]models.Article{}, int64(0), nil)
+
+	_, _, _, err := suite.service.GetArticles(query, Viewer{UserID: uuid.New().String(), IsEditor: true})
+
+	assert.NoError(suite.T(), err)
+	suite.articleRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticle_ServesPreferredTranslation() {
+	groupID := uuid.New()
+	english := &models.Article{ID: groupID, Slug: "hello", Status: models.StatusPublished, Locale: "en", TranslationGroupID: groupID}
+	urdu := &models.Article{ID: uuid.New(), Slug: "salaam", Status: models.StatusPublished, Locale: "ur", TranslationGroupID: groupID}
+	draft := &models.Article{ID: uuid.New(), Slug: "bonjour", Status: models.StatusDraft, Locale: "fr", TranslationGroupID: groupID}
+
+	suite.articleRepo.On("FindBySlug", "hello").Return(english, nil)
+	suite.articleRepo.On("FindTranslations", groupID).Return([]models.Article{*english, *urdu, *draft}, nil)
+	suite.articleRepo.On("FindByID", urdu.ID).Return(urdu, nil)
+
+	result, err := suite.service.GetArticle("hello", Viewer{Locales: utils.ParseLocalePreferences("", "ur-PK, fr")}, false)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "salaam", result.Slug)
+	assert.Equal(suite.T(), "ur", result.Locale)
+	// Drafts are not listed to anonymous readers
+	assert.Len(suite.T(), result.Translations, 1)
+	assert.Equal(suite.T(), "hello", result.Translations[0].Slug)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticle_KeepsAcceptedLocale() {
+	groupID := uuid.New()
+	english := &models.Article{ID: groupID, Slug: "hello", Status: models.StatusPublished, Locale: "en", TranslationGroupID: groupID}
+	urdu := &models.Article{ID: uuid.New(), Slug: "salaam", Status: models.StatusPublished, Locale: "ur", TranslationGroupID: groupID}
+
+	suite.articleRepo.On("FindBySlug", "hello").Return(english, nil)
+	suite.articleRepo.On("FindTranslations", groupID).Return([]models.Article{*english, *urdu}, nil)
+
+	result, err := suite.service.GetArticle("hello", Viewer{Locales: utils.ParseLocalePreferences("", "ur, en-GB;q=0.5")}, false)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "hello", result.Slug)
+	assert.Len(suite.T(), result.Translations, 1)
+	suite.articleRepo.AssertNotCalled(suite.T(), "FindByID", urdu.ID)
+}
+
 // GetArticles Tests
 
+func (suite *ArticleServiceTestSuite) TestGetArticle_RedirectsRetiredSlug() {
+	slugRepo := new(mocks.MockSlugHistoryRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithSlugHistoryRepo(slugRepo))
+	articleID := uuid.New()
+
+	suite.articleRepo.On("FindBySlug", "old-title").Return(nil, gorm.ErrRecordNotFound)
+	slugRepo.On("FindByOldSlug", models.SlugEntityArticle, "old-title").Return(&models.SlugHistory{EntityID: articleID}, nil)
+	suite.articleRepo.On("FindByID", articleID).Return(&models.Article{ID: articleID, Slug: "new-title"}, nil)
+
+	result, err := service.GetArticle("old-title", Viewer{}, false)
+
+	assert.Nil(suite.T(), result)
+	redirect, ok := utils.IsRedirectError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "new-title", redirect.Slug)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticle_UnknownSlugWithHistory() {
+	slugRepo := new(mocks.MockSlugHistoryRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithSlugHistoryRepo(slugRepo))
+
+	suite.articleRepo.On("FindBySlug", "never-existed").Return(nil, gorm.ErrRecordNotFound)
+	slugRepo.On("FindByOldSlug", models.SlugEntityArticle, "never-existed").Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := service.GetArticle("never-existed", Viewer{}, false)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), utils.ErrNotFound, err)
+}
+
 func (suite *ArticleServiceTestSuite) TestGetArticles_Success() {
 	articles := []models.Article{
 		{ID: uuid.New(), Title: "Article 1", Slug: "article-1", Status: models.StatusPublished},
@@ -246,7 +531,7 @@ func (suite *ArticleServiceTestSuite) TestGetArticles_Success() {
 	suite.articleRepo.On("FindAll", mock.AnythingOfType("repositories.ArticleFilters")).
 		Return(articles, int64(2), nil)
 
-	result, total, err := suite.service.GetArticles(query, false)
+	result, total, _, err := suite.service.GetArticles(query, Viewer{})
 
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), result, 2)
@@ -270,7 +555,7 @@ func (suite *ArticleServiceTestSuite) TestGetArticles_WithCategoryFilter() {
 	suite.articleRepo.On("FindByCategory", categoryID, mock.AnythingOfType("repositories.ArticleFilters")).
 		Return(articles, int64(1), nil)
 
-	result, total, err := suite.service.GetArticles(query, false)
+	result, total, _, err := suite.service.GetArticles(query, Viewer{})
 
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), result, 1)
@@ -287,7 +572,7 @@ func (suite *ArticleServiceTestSuite) TestGetArticles_CategoryNotFound() {
 
 	suite.categoryRepo.On("FindBySlug", "nonexistent").Return(nil, gorm.ErrRecordNotFound)
 
-	result, total, err := suite.service.GetArticles(query, false)
+	result, total, _, err := suite.service.GetArticles(query, Viewer{})
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), result)
@@ -313,7 +598,7 @@ func (suite *ArticleServiceTestSuite) TestGetArticles_WithTagFilter() {
 	suite.articleRepo.On("FindByTag", tagID, mock.AnythingOfType("repositories.ArticleFilters")).
 		Return(articles, int64(1), nil)
 
-	result, total, err := suite.service.GetArticles(query, false)
+	result, total, _, err := suite.service.GetArticles(query, Viewer{})
 
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), result, 1)
@@ -335,7 +620,7 @@ func (suite *ArticleServiceTestSuite) TestGetArticles_WithSearch() {
 	suite.articleRepo.On("Search", "search", mock.AnythingOfType("repositories.ArticleFilters")).
 		Return(articles, int64(1), nil)
 
-	result, total, err := suite.service.GetArticles(query, false)
+	result, total, _, err := suite.service.GetArticles(query, Viewer{})
 
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), result, 1)
@@ -380,6 +665,76 @@ func (suite *ArticleServiceTestSuite) TestUpdateArticle_Success_Owner() {
 	suite.articleRepo.AssertExpectations(suite.T())
 }
 
+func (suite *ArticleServiceTestSuite) TestUpdateArticle_InvalidRelationsSaveNothing() {
+	articleID := uuid.New()
+	authorID := uuid.New()
+	missingID := uuid.New()
+
+	newTitle := "Updated Title"
+	cases := map[string]*dto.UpdateArticleRequest{
+		"INVALID_TAG_ID":      {Title: &newTitle, TagIDs: []string{"not-a-uuid"}},
+		"INVALID_CATEGORY_ID": {Title: &newTitle, CategoryIDs: []string{"not-a-uuid"}},
+		"CATEGORY_NOT_FOUND":  {Title: &newTitle, CategoryIDs: []string{missingID.String()}},
+	}
+
+	for code, req := range cases {
+		suite.SetupTest()
+		article := &models.Article{ID: articleID, Title: "Original Title", Slug: "original-title", AuthorID: authorID, Status: models.StatusDraft}
+
+		suite.articleRepo.On("FindByID", articleID).Return(article, nil).Once()
+		suite.articleRepo.On("ExistsBySlug", "updated-title").Return(false, nil)
+		suite.categoryRepo.On("FindByIDs", []uuid.UUID{missingID}).Return([]models.Category{}, nil)
+
+		_, err := suite.service.UpdateArticle(articleID.String(), req, authorID.String(), false)
+
+		appErr, ok := utils.IsAppError(err)
+		suite.Require().True(ok, code)
+		assert.Equal(suite.T(), code, appErr.Code)
+		suite.articleRepo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+	}
+}
+
+func (suite *ArticleServiceTestSuite) TestUpdateArticle_StaleVersion() {
+	articleID := uuid.New()
+	authorID := uuid.New()
+	article := &models.Article{ID: articleID, Title: "Original Title", AuthorID: authorID, Version: 3}
+
+	newTitle := "Updated Title"
+	staleVersion := 2
+	req := &dto.UpdateArticleRequest{Title: &newTitle, Version: &staleVersion}
+
+	suite.articleRepo.On("FindByID", articleID).Return(article, nil)
+
+	result, err := suite.service.UpdateArticle(articleID.String(), req, authorID.String(), false)
+
+	assert.Nil(suite.T(), result)
+	conflict, ok := utils.IsVersionConflictError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), 3, conflict.CurrentVersion)
+	suite.articleRepo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ArticleServiceTestSuite) TestUpdateArticle_ConcurrentSave() {
+	articleID := uuid.New()
+	authorID := uuid.New()
+
+	newTitle := "Updated Title"
+	version := 1
+	req := &dto.UpdateArticleRequest{Title: &newTitle, Version: &version}
+
+	suite.articleRepo.On("FindByID", articleID).Return(&models.Article{ID: articleID, AuthorID: authorID, Version: 1}, nil).Once()
+	suite.articleRepo.On("ExistsBySlug", "updated-title").Return(false, nil)
+	suite.articleRepo.On("Update", mock.AnythingOfType("*models.Article")).Return(repositories.ErrVersionConflict)
+	suite.articleRepo.On("FindByID", articleID).Return(&models.Article{ID: articleID, AuthorID: authorID, Version: 2}, nil).Once()
+
+	result, err := suite.service.UpdateArticle(articleID.String(), req, authorID.String(), false)
+
+	assert.Nil(suite.T(), result)
+	conflict, ok := utils.IsVersionConflictError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), 2, conflict.CurrentVersion)
+}
+
 func (suite *ArticleServiceTestSuite) TestUpdateArticle_Forbidden_NotOwner() {
 	articleID := uuid.New()
 	authorID := uuid.New()
@@ -599,6 +954,215 @@ func (suite *ArticleServiceTestSuite) TestUnpublishArticle_NotPublished() {
 	suite.articleRepo.AssertExpectations(suite.T())
 }
 
+// Staff Pick Tests
+
+func (suite *ArticleServiceTestSuite) TestPickArticle_Success() {
+	article := &models.Article{ID: uuid.New(), Slug: "picked", Status: models.StatusPublished}
+	curatorID := uuid.New()
+	position := 1
+	endsAt := time.Now().Add(24 * time.Hour)
+
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+	suite.articleRepo.On("SaveStaffPick", mock.MatchedBy(func(pick *models.StaffPick) bool {
+		return pick.ArticleID == article.ID && *pick.CuratorID == curatorID && pick.Note == "Great read" && *pick.Position == 1
+	})).Return(nil)
+
+	result, err := suite.service.PickArticle(article.ID.String(), &dto.StaffPickRequest{Note: "Great read", Position: &position, EndsAt: &endsAt}, curatorID.String())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "picked", result.ArticleSlug)
+	assert.True(suite.T(), result.IsActive)
+	suite.articleRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ArticleServiceTestSuite) TestPickArticle_InvalidSchedule() {
+	startsAt := time.Now()
+	endsAt := startsAt.Add(-time.Hour)
+
+	result, err := suite.service.PickArticle(uuid.New().String(), &dto.StaffPickRequest{StartsAt: &startsAt, EndsAt: &endsAt}, uuid.New().String())
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "INVALID_SCHEDULE", appErr.Code)
+}
+
+func (suite *ArticleServiceTestSuite) TestPickArticle_NotPublished() {
+	article := &models.Article{ID: uuid.New(), Status: models.StatusDraft}
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+
+	_, err := suite.service.PickArticle(article.ID.String(), &dto.StaffPickRequest{}, uuid.New().String())
+
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "NOT_PUBLISHED", appErr.Code)
+	suite.articleRepo.AssertNotCalled(suite.T(), "SaveStaffPick", mock.Anything)
+}
+
+func (suite *ArticleServiceTestSuite) TestUnpickArticle() {
+	picked := &models.Article{ID: uuid.New(), Status: models.StatusPublished, IsStaffPick: true}
+	notPicked := &models.Article{ID: uuid.New(), Status: models.StatusPublished}
+	suite.articleRepo.On("FindByID", picked.ID).Return(picked, nil)
+	suite.articleRepo.On("FindByID", notPicked.ID).Return(notPicked, nil)
+	suite.articleRepo.On("SetStaffPick", picked.ID, false).Return(nil)
+
+	assert.NoError(suite.T(), suite.service.UnpickArticle(picked.ID.String()))
+
+	appErr, ok := utils.IsAppError(suite.service.UnpickArticle(notPicked.ID.String()))
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "NOT_STAFF_PICK", appErr.Code)
+	suite.articleRepo.AssertExpectations(suite.T())
+}
+
+// Comment Settings Tests
+
+func (suite *ArticleServiceTestSuite) TestUpdateCommentSettings() {
+	authorID := uuid.New()
+	publishedAt := time.Now().AddDate(0, 0, -3)
+	days := 7
+	article := &models.Article{ID: uuid.New(), AuthorID: authorID, Status: models.StatusPublished, PublishedAt: &publishedAt, CommentsCloseAfterDays: &days}
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+	suite.articleRepo.On("UpdateCommentSettings", article).Return(nil)
+
+	locked := true
+	never := 0
+	audience := "followers"
+	result, err := suite.service.UpdateCommentSettings(article.ID.String(), &dto.CommentSettingsRequest{Locked: &locked, CloseAfterDays: &never, Audience: &audience}, authorID.String(), false)
+
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.Enabled)
+	assert.Nil(suite.T(), result.CloseAfterDays)
+	assert.Nil(suite.T(), result.ClosesAt)
+	assert.Equal(suite.T(), "followers", result.Audience)
+	assert.Equal(suite.T(), "locked", result.State)
+	suite.articleRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ArticleServiceTestSuite) TestUpdateCommentSettings_Forbidden() {
+	article := &models.Article{ID: uuid.New(), AuthorID: uuid.New()}
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+
+	enabled := false
+	_, err := suite.service.UpdateCommentSettings(article.ID.String(), &dto.CommentSettingsRequest{Enabled: &enabled}, uuid.New().String(), false)
+
+	assert.ErrorIs(suite.T(), err, utils.ErrForbidden)
+	suite.articleRepo.AssertNotCalled(suite.T(), "UpdateCommentSettings", mock.Anything)
+}
+
+// BulkUpdateArticles Tests
+
+func (suite *ArticleServiceTestSuite) TestBulkUpdateArticles_Publish() {
+	draft := &models.Article{ID: uuid.New(), Slug: "draft", Status: models.StatusDraft}
+	published := &models.Article{ID: uuid.New(), Slug: "published", Status: models.StatusPublished}
+	missingID := uuid.New()
+
+	suite.articleRepo.On("FindByID", draft.ID).Return(draft, nil)
+	suite.articleRepo.On("FindByID", published.ID).Return(published, nil)
+	suite.articleRepo.On("FindByID", missingID).Return(nil, gorm.ErrRecordNotFound)
+	suite.articleRepo.On("Update", draft).Return(nil)
+
+	report, err := suite.service.BulkUpdateArticles(&dto.BulkArticleRequest{
+		Action: dto.BulkActionPublish,
+		IDs:    []string{draft.ID.String(), published.ID.String(), missingID.String(), draft.ID.String()},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, report.Total)
+	assert.Equal(suite.T(), 1, report.Succeeded)
+	assert.Equal(suite.T(), 1, report.Skipped)
+	assert.Equal(suite.T(), 1, report.Failed)
+	assert.Equal(suite.T(), dto.BulkResultOK, report.Results[0].Status)
+	assert.Equal(suite.T(), "draft", report.Results[0].Slug)
+	assert.Equal(suite.T(), dto.BulkResultSkipped, report.Results[1].Status)
+	assert.Equal(suite.T(), dto.BulkResultError, report.Results[2].Status)
+	assert.Equal(suite.T(), models.StatusPublished, draft.Status)
+	suite.articleRepo.AssertNumberOfCalls(suite.T(), "Update", 1)
+}
+
+func (suite *ArticleServiceTestSuite) TestBulkUpdateArticles_AddTagsCountsNewTagsOnly() {
+	existing := models.Tag{ID: uuid.New(), Name: "Go"}
+	added := models.Tag{ID: uuid.New(), Name: "Web"}
+	article := &models.Article{ID: uuid.New(), Tags: []models.Tag{existing}}
+
+	suite.tagRepo.On("FindByIDs", []uuid.UUID{existing.ID, added.ID}).Return([]models.Tag{existing, added}, nil)
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+	suite.articleRepo.On("UpdateTags", article, []models.Tag{existing, added}).Return(nil)
+	suite.tagRepo.On("IncrementUsage", added.ID).Return(nil)
+
+	report, err := suite.service.BulkUpdateArticles(&dto.BulkArticleRequest{
+		Action: dto.BulkActionAddTags,
+		IDs:    []string{article.ID.String()},
+		TagIDs: []string{existing.ID.String(), added.ID.String()},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Succeeded)
+	suite.tagRepo.AssertNotCalled(suite.T(), "IncrementUsage", existing.ID)
+	suite.tagRepo.AssertExpectations(suite.T())
+	suite.articleRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ArticleServiceTestSuite) TestBulkUpdateArticles_SetTagsSkipsUnchanged() {
+	tag := models.Tag{ID: uuid.New(), Name: "Go"}
+	article := &models.Article{ID: uuid.New(), Tags: []models.Tag{tag}}
+
+	suite.tagRepo.On("FindByIDs", []uuid.UUID{tag.ID}).Return([]models.Tag{tag}, nil)
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+
+	report, err := suite.service.BulkUpdateArticles(&dto.BulkArticleRequest{
+		Action: dto.BulkActionSetTags,
+		IDs:    []string{article.ID.String()},
+		TagIDs: []string{tag.ID.String()},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Skipped)
+	suite.articleRepo.AssertNotCalled(suite.T(), "UpdateTags", mock.Anything, mock.Anything)
+}
+
+func (suite *ArticleServiceTestSuite) TestBulkUpdateArticles_DeleteDecrementsTags() {
+	tag := models.Tag{ID: uuid.New()}
+	article := &models.Article{ID: uuid.New(), Tags: []models.Tag{tag}}
+
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+	suite.tagRepo.On("DecrementUsage", tag.ID).Return(nil)
+	suite.articleRepo.On("Delete", article.ID).Return(nil)
+
+	report, err := suite.service.BulkUpdateArticles(&dto.BulkArticleRequest{
+		Action: dto.BulkActionDelete,
+		IDs:    []string{article.ID.String()},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Succeeded)
+	suite.tagRepo.AssertExpectations(suite.T())
+	suite.articleRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ArticleServiceTestSuite) TestBulkUpdateArticles_FilterMatchesTooMany() {
+	suite.articleRepo.On("FindAll", repositories.ArticleFilters{Status: "draft", AllVisibilities: true, Limit: maxBulkArticles, Sort: "oldest"}).
+		Return([]models.Article{}, int64(maxBulkArticles+1), nil)
+
+	report, err := suite.service.BulkUpdateArticles(&dto.BulkArticleRequest{
+		Action: dto.BulkActionPublish,
+		Filter: &dto.ArticleListQuery{Status: "draft"},
+	})
+
+	assert.Nil(suite.T(), report)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "TOO_MANY_ARTICLES", appErr.Code)
+}
+
+func (suite *ArticleServiceTestSuite) TestBulkUpdateArticles_RequiresSelection() {
+	report, err := suite.service.BulkUpdateArticles(&dto.BulkArticleRequest{Action: dto.BulkActionDelete})
+
+	assert.Nil(suite.T(), report)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "INVALID_SELECTION", appErr.Code)
+}
+
 // GetTrendingArticles Tests
 
 func (suite *ArticleServiceTestSuite) TestGetTrendingArticles_Success() {
@@ -607,9 +1171,9 @@ func (suite *ArticleServiceTestSuite) TestGetTrendingArticles_Success() {
 		{ID: uuid.New(), Title: "Trending 2", ViewCount: 500},
 	}
 
-	suite.articleRepo.On("FindTrending", 10).Return(articles, nil)
+	suite.articleRepo.On("FindTrending", repositories.TrendingFilters{Window: "7d"}, 10).Return(articles, nil)
 
-	result, err := suite.service.GetTrendingArticles(10)
+	result, err := suite.service.GetTrendingArticles(&dto.TrendingQuery{Limit: 10})
 
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), result, 2)
@@ -619,9 +1183,9 @@ func (suite *ArticleServiceTestSuite) TestGetTrendingArticles_Success() {
 func (suite *ArticleServiceTestSuite) TestGetTrendingArticles_DefaultLimit() {
 	articles := []models.Article{}
 
-	suite.articleRepo.On("FindTrending", 10).Return(articles, nil)
+	suite.articleRepo.On("FindTrending", repositories.TrendingFilters{Window: "7d"}, 10).Return(articles, nil)
 
-	result, err := suite.service.GetTrendingArticles(0)
+	result, err := suite.service.GetTrendingArticles(&dto.TrendingQuery{})
 
 	assert.NoError(suite.T(), err)
 	assert.Len(suite.T(), result, 0)
@@ -631,15 +1195,36 @@ func (suite *ArticleServiceTestSuite) TestGetTrendingArticles_DefaultLimit() {
 func (suite *ArticleServiceTestSuite) TestGetTrendingArticles_MaxLimit() {
 	articles := []models.Article{}
 
-	suite.articleRepo.On("FindTrending", 50).Return(articles, nil)
+	suite.articleRepo.On("FindTrending", repositories.TrendingFilters{Window: "7d"}, 50).Return(articles, nil)
 
-	result, err := suite.service.GetTrendingArticles(100) // Should be capped at 50
+	result, err := suite.service.GetTrendingArticles(&dto.TrendingQuery{Limit: 100}) // Should be capped at 50
 
 	assert.NoError(suite.T(), err)
 	suite.articleRepo.AssertExpectations(suite.T())
 	_ = result
 }
 
+func (suite *ArticleServiceTestSuite) TestGetTrendingArticles_WindowCategoryAndLanguage() {
+	category := &models.Category{ID: uuid.New(), Slug: "go"}
+	suite.categoryRepo.On("FindBySlug", "go").Return(category, nil)
+	suite.articleRepo.On("FindTrending", repositories.TrendingFilters{Window: "24h", Language: "ur", CategoryID: &category.ID}, 10).
+		Return([]models.Article{}, nil)
+
+	_, err := suite.service.GetTrendingArticles(&dto.TrendingQuery{Window: "24h", Category: "go", Lang: "ur-PK"})
+
+	assert.NoError(suite.T(), err)
+	suite.articleRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ArticleServiceTestSuite) TestGetTrendingArticles_UnknownCategory() {
+	suite.categoryRepo.On("FindBySlug", "missing").Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := suite.service.GetTrendingArticles(&dto.TrendingQuery{Category: "missing"})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), utils.ErrNotFound, err)
+}
+
 // GetRecentArticles Tests
 
 func (suite *ArticleServiceTestSuite) TestGetRecentArticles_Success() {
@@ -668,6 +1253,7 @@ func (suite *ArticleServiceTestSuite) TestGetRelatedArticles_Success() {
 		ID:         articleID,
 		Title:      "Main Article",
 		Slug:       "main-article",
+		Status:     models.StatusPublished,
 		Categories: []models.Category{{ID: categoryID}},
 		Tags:       []models.Tag{{ID: tagID}},
 	}
@@ -678,7 +1264,7 @@ func (suite *ArticleServiceTestSuite) TestGetRelatedArticles_Success() {
 	}
 
 	suite.articleRepo.On("FindBySlug", "main-article").Return(article, nil)
-	suite.articleRepo.On("FindRelated", articleID, []uuid.UUID{categoryID}, []uuid.UUID{tagID}, 5).
+	suite.articleRepo.On("FindRelated", articleID, []uuid.UUID{categoryID}, []uuid.UUID{tagID}, 5, "").
 		Return(relatedArticles, nil)
 
 	result, err := suite.service.GetRelatedArticles("main-article", 5)
@@ -688,6 +1274,40 @@ func (suite *ArticleServiceTestSuite) TestGetRelatedArticles_Success() {
 	suite.articleRepo.AssertExpectations(suite.T())
 }
 
+func (suite *ArticleServiceTestSuite) TestGetRelatedArticles_Precomputed() {
+	relatedRepo := new(mocks.MockRelatedArticleRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithRelatedRepo(relatedRepo))
+
+	article := &models.Article{ID: uuid.New(), Slug: "main-article", Status: models.StatusPublished}
+	ranked := []models.Article{{ID: uuid.New(), Title: "Best match"}}
+	suite.articleRepo.On("FindBySlug", "main-article").Return(article, nil)
+	relatedRepo.On("FindRelated", article.ID, 20).Return(ranked, nil)
+
+	result, err := service.GetRelatedArticles("main-article", 50)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 1)
+	assert.Equal(suite.T(), "Best match", result[0].Title)
+	suite.articleRepo.AssertNotCalled(suite.T(), "FindRelated", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetRelatedArticles_NotRankedYetFallsBack() {
+	relatedRepo := new(mocks.MockRelatedArticleRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithRelatedRepo(relatedRepo))
+
+	article := &models.Article{ID: uuid.New(), Slug: "main-article", Status: models.StatusPublished, Locale: "en-GB"}
+	suite.articleRepo.On("FindBySlug", "main-article").Return(article, nil)
+	relatedRepo.On("FindRelated", article.ID, 5).Return([]models.Article{}, nil)
+	suite.articleRepo.On("FindRelated", article.ID, []uuid.UUID{}, []uuid.UUID{}, 5, "en").
+		Return([]models.Article{{ID: uuid.New()}}, nil)
+
+	result, err := service.GetRelatedArticles("main-article", 5)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 1)
+	suite.articleRepo.AssertExpectations(suite.T())
+}
+
 func (suite *ArticleServiceTestSuite) TestGetRelatedArticles_ArticleNotFound() {
 	suite.articleRepo.On("FindBySlug", "nonexistent").Return(nil, gorm.ErrRecordNotFound)
 
@@ -721,3 +1341,420 @@ func (suite *ArticleServiceTestSuite) TestSearchArticles_Success() {
 	assert.Equal(suite.T(), int64(2), total)
 	suite.articleRepo.AssertExpectations(suite.T())
 }
+
+// Edit Lock Tests
+
+func (suite *ArticleServiceTestSuite) TestAcquireEditLock_HeldByOther() {
+	lockRepo := new(mocks.MockArticleLockRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithArticleLockRepo(lockRepo))
+	articleID := uuid.New()
+	authorID := uuid.New()
+	editor := &models.User{ID: uuid.New(), Username: "editor"}
+
+	suite.articleRepo.On("FindByID", articleID).Return(&models.Article{ID: articleID, AuthorID: authorID}, nil)
+	lockRepo.On("Acquire", articleID, authorID, mock.AnythingOfType("time.Time")).Return(&models.ArticleEditLock{
+		ArticleID: articleID,
+		UserID:    editor.ID,
+		User:      editor,
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+
+	result, err := service.AcquireEditLock(articleID.String(), authorID.String(), false)
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "ARTICLE_LOCKED", appErr.Code)
+	assert.Contains(suite.T(), appErr.Message, "editor")
+}
+
+func (suite *ArticleServiceTestSuite) TestGetEditLock_IgnoresExpiredLock() {
+	lockRepo := new(mocks.MockArticleLockRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithArticleLockRepo(lockRepo))
+	articleID := uuid.New()
+	authorID := uuid.New()
+
+	suite.articleRepo.On("FindByID", articleID).Return(&models.Article{ID: articleID, AuthorID: authorID}, nil)
+	lockRepo.On("Find", articleID).Return(&models.ArticleEditLock{
+		ArticleID: articleID,
+		UserID:    uuid.New(),
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}, nil)
+
+	result, err := service.GetEditLock(articleID.String(), authorID.String(), false)
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *ArticleServiceTestSuite) TestReleaseEditLock_ForbiddenForOtherAuthor() {
+	lockRepo := new(mocks.MockArticleLockRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithArticleLockRepo(lockRepo))
+	articleID := uuid.New()
+	authorID := uuid.New()
+
+	suite.articleRepo.On("FindByID", articleID).Return(&models.Article{ID: articleID, AuthorID: authorID}, nil)
+	lockRepo.On("Find", articleID).Return(&models.ArticleEditLock{
+		ArticleID: articleID,
+		UserID:    uuid.New(),
+		ExpiresAt: time.Now().Add(time.Minute),
+	}, nil)
+
+	err := service.ReleaseEditLock(articleID.String(), authorID.String(), false)
+
+	assert.Equal(suite.T(), utils.ErrForbidden, err)
+	lockRepo.AssertNotCalled(suite.T(), "Release", articleID)
+}
+
+// Revision Tests
+
+func (suite *ArticleServiceTestSuite) TestUpdateArticle_PublishedSavesRevision() {
+	revisionRepo := new(mocks.MockArticleRevisionRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithRevisionRepo(revisionRepo))
+	articleID := uuid.New()
+	authorID := uuid.New()
+	live := publishedArticle(authorID, "Live Title")
+	live.ID = articleID
+	live.Version = 4
+
+	newTitle := "Half Finished Title"
+	req := &dto.UpdateArticleRequest{Title: &newTitle}
+
+	suite.articleRepo.On("FindByID", articleID).Return(live, nil)
+	revisionRepo.On("FindPending", articleID).Return(nil, gorm.ErrRecordNotFound)
+	suite.articleRepo.On("Update", live).Return(nil)
+	revisionRepo.On("Create", mock.MatchedBy(func(r *models.ArticleRevision) bool {
+		return r.ArticleID == articleID && r.BaseVersion == 4 && r.Status == models.RevisionPending
+	})).Return(nil)
+
+	result, err := service.UpdateArticle(articleID.String(), req, authorID.String(), false)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Live Title", result.Title)
+	assert.NotNil(suite.T(), result.PendingRevision)
+	assert.Equal(suite.T(), newTitle, *result.PendingRevision.Changes.Title)
+	suite.articleRepo.AssertNotCalled(suite.T(), "ExistsBySlug", mock.Anything)
+	revisionRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ArticleServiceTestSuite) TestUpdateArticle_MergesIntoPendingRevision() {
+	revisionRepo := new(mocks.MockArticleRevisionRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithRevisionRepo(revisionRepo))
+	articleID := uuid.New()
+	authorID := uuid.New()
+	live := publishedArticle(authorID, "Live Title")
+	live.ID = articleID
+
+	pending := &models.ArticleRevision{
+		ID:        uuid.New(),
+		ArticleID: articleID,
+		Changes:   `{"title":"Draft Title"}`,
+		Status:    models.RevisionPending,
+	}
+
+	excerpt := "New excerpt"
+	req := &dto.UpdateArticleRequest{Excerpt: &excerpt}
+
+	suite.articleRepo.On("FindByID", articleID).Return(live, nil)
+	revisionRepo.On("FindPending", articleID).Return(pending, nil)
+	suite.articleRepo.On("Update", live).Return(nil)
+	revisionRepo.On("Update", pending).Return(nil)
+
+	result, err := service.UpdateArticle(articleID.String(), req, authorID.String(), false)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Draft Title", *result.PendingRevision.Changes.Title)
+	assert.Equal(suite.T(), excerpt, *result.PendingRevision.Changes.Excerpt)
+}
+
+func (suite *ArticleServiceTestSuite) TestPublishRevision_AppliesChanges() {
+	revisionRepo := new(mocks.MockArticleRevisionRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithRevisionRepo(revisionRepo))
+	articleID := uuid.New()
+	reviewerID := uuid.New()
+	live := publishedArticle(uuid.New(), "Live Title")
+	live.ID = articleID
+
+	pending := &models.ArticleRevision{
+		ID:        uuid.New(),
+		ArticleID: articleID,
+		Changes:   `{"excerpt":"Reviewed excerpt"}`,
+		Status:    models.RevisionPending,
+	}
+
+	suite.articleRepo.On("FindByID", articleID).Return(live, nil)
+	revisionRepo.On("FindPending", articleID).Return(pending, nil)
+	suite.articleRepo.On("Update", live).Return(nil)
+	revisionRepo.On("Update", pending).Return(nil)
+
+	result, err := service.PublishRevision(articleID.String(), reviewerID.String())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Reviewed excerpt", result.Excerpt)
+	assert.Equal(suite.T(), models.RevisionPublished, pending.Status)
+	assert.Equal(suite.T(), reviewerID, *pending.ReviewedBy)
+}
+
+func (suite *ArticleServiceTestSuite) TestPublishRevision_ArticleChangedSince() {
+	revisionRepo := new(mocks.MockArticleRevisionRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithRevisionRepo(revisionRepo))
+	articleID := uuid.New()
+	live := publishedArticle(uuid.New(), "Live Title")
+	live.ID = articleID
+	live.Version = 7
+
+	pending := &models.ArticleRevision{
+		ID:          uuid.New(),
+		ArticleID:   articleID,
+		Changes:     `{"excerpt":"Reviewed excerpt"}`,
+		BaseVersion: 5,
+		Status:      models.RevisionPending,
+	}
+
+	suite.articleRepo.On("FindByID", articleID).Return(live, nil)
+	revisionRepo.On("FindPending", articleID).Return(pending, nil)
+
+	result, err := service.PublishRevision(articleID.String(), uuid.New().String())
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "VERSION_CONFLICT", appErr.Code)
+	assert.Equal(suite.T(), 409, appErr.Status)
+	assert.Equal(suite.T(), models.RevisionPending, pending.Status)
+	suite.articleRepo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+	revisionRepo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetPendingRevisions_UndecodableChanges() {
+	revisionRepo := new(mocks.MockArticleRevisionRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithRevisionRepo(revisionRepo))
+	revisions := []models.ArticleRevision{
+		{ID: uuid.New(), ArticleID: uuid.New(), Changes: `{"title":"Fine"}`, Status: models.RevisionPending},
+		{ID: uuid.New(), ArticleID: uuid.New(), Changes: `{"title":`, Status: models.RevisionPending},
+	}
+
+	revisionRepo.On("FindAllPending", 10, 0).Return(revisions, int64(2), nil)
+
+	result, total, err := service.GetPendingRevisions(&dto.PaginationQuery{Page: 1, PerPage: 10})
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	assert.Zero(suite.T(), total)
+}
+
+func (suite *ArticleServiceTestSuite) TestPublishRevision_NoPendingChanges() {
+	revisionRepo := new(mocks.MockArticleRevisionRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithRevisionRepo(revisionRepo))
+	articleID := uuid.New()
+
+	suite.articleRepo.On("FindByID", articleID).Return(publishedArticle(uuid.New(), "Live Title"), nil)
+	revisionRepo.On("FindPending", mock.AnythingOfType("uuid.UUID")).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := service.PublishRevision(articleID.String(), uuid.New().String())
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "NO_PENDING_REVISION", appErr.Code)
+}
+
+// Preview link Tests
+
+const testPreviewSecret = "test-preview-secret"
+
+func (suite *ArticleServiceTestSuite) TestGetPreview_Success() {
+	previewRepo := new(mocks.MockArticlePreviewRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithPreviewLinks(previewRepo, testPreviewSecret))
+	article := &models.Article{ID: uuid.New(), Title: "Draft", Slug: "draft", Status: models.StatusDraft}
+	preview := &models.ArticlePreview{ID: uuid.New(), ArticleID: article.ID, ExpiresAt: time.Now().Add(time.Hour)}
+	token, _ := utils.GeneratePreviewToken(preview.ID, article.ID, testPreviewSecret, preview.ExpiresAt)
+
+	previewRepo.On("FindByID", preview.ID).Return(preview, nil)
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+
+	result, err := service.GetPreview(token)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Draft", result.Title)
+	assert.True(suite.T(), result.IsPreview)
+	suite.articleRepo.AssertNotCalled(suite.T(), "IncrementViewCount", article.ID)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetPreview_RevokedLink() {
+	previewRepo := new(mocks.MockArticlePreviewRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithPreviewLinks(previewRepo, testPreviewSecret))
+	revokedAt := time.Now()
+	preview := &models.ArticlePreview{ID: uuid.New(), ArticleID: uuid.New(), ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}
+	token, _ := utils.GeneratePreviewToken(preview.ID, preview.ArticleID, testPreviewSecret, preview.ExpiresAt)
+
+	previewRepo.On("FindByID", preview.ID).Return(preview, nil)
+
+	result, err := service.GetPreview(token)
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "INVALID_PREVIEW_LINK", appErr.Code)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetPreview_ArticlePublishedSince() {
+	previewRepo := new(mocks.MockArticlePreviewRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithPreviewLinks(previewRepo, testPreviewSecret))
+	article := publishedArticle(uuid.New(), "Now Live")
+	article.Visibility = models.VisibilityFollowers
+	preview := &models.ArticlePreview{ID: uuid.New(), ArticleID: article.ID, ExpiresAt: time.Now().Add(time.Hour)}
+	token, _ := utils.GeneratePreviewToken(preview.ID, article.ID, testPreviewSecret, preview.ExpiresAt)
+
+	previewRepo.On("FindByID", preview.ID).Return(preview, nil)
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+
+	result, err := service.GetPreview(token)
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "INVALID_PREVIEW_LINK", appErr.Code)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetPreview_ForgedToken() {
+	previewRepo := new(mocks.MockArticlePreviewRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithPreviewLinks(previewRepo, testPreviewSecret))
+	token, _ := utils.GeneratePreviewToken(uuid.New(), uuid.New(), "wrong-secret", time.Now().Add(time.Hour))
+
+	result, err := service.GetPreview(token)
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "INVALID_PREVIEW_LINK", appErr.Code)
+	previewRepo.AssertNotCalled(suite.T(), "FindByID", mock.Anything)
+}
+
+func (suite *ArticleServiceTestSuite) TestCreatePreviewLink_DefaultExpiry() {
+	previewRepo := new(mocks.MockArticlePreviewRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithPreviewLinks(previewRepo, testPreviewSecret))
+	authorID := uuid.New()
+	article := &models.Article{ID: uuid.New(), AuthorID: authorID, Status: models.StatusDraft}
+
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+	previewRepo.On("Create", mock.AnythingOfType("*models.ArticlePreview")).Return(nil)
+
+	result, err := service.CreatePreviewLink(article.ID.String(), &dto.CreatePreviewRequest{}, authorID.String(), false)
+
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), result.Token)
+	assert.WithinDuration(suite.T(), time.Now().Add(72*time.Hour), result.ExpiresAt, time.Minute)
+	claims, err := utils.ValidatePreviewToken(result.Token, testPreviewSecret)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), article.ID.String(), claims.ArticleID)
+}
+
+func (suite *ArticleServiceTestSuite) TestCreatePreviewLink_AlreadyPublished() {
+	previewRepo := new(mocks.MockArticlePreviewRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithPreviewLinks(previewRepo, testPreviewSecret))
+	authorID := uuid.New()
+	article := publishedArticle(authorID, "Live Title")
+
+	suite.articleRepo.On("FindByID", article.ID).Return(article, nil)
+
+	result, err := service.CreatePreviewLink(article.ID.String(), &dto.CreatePreviewRequest{}, authorID.String(), false)
+
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "ARTICLE_ALREADY_PUBLIC", appErr.Code)
+}
+
+// Duplicate Check Tests
+
+// stubDuplicateChecker records the articles it is asked to check
+type stubDuplicateChecker struct {
+	checked []uuid.UUID
+	err     error
+}
+
+func (c *stubDuplicateChecker) CheckArticle(article *models.Article) error {
+	c.checked = append(c.checked, article.ID)
+	return c.err
+}
+
+func (suite *ArticleServiceTestSuite) TestUpdateArticle_ChecksDuplicatesWithoutFailingSave() {
+	checker := &stubDuplicateChecker{err: errors.New("fingerprint store unavailable")}
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithDuplicateChecker(checker))
+	articleID := uuid.New()
+	authorID := uuid.New()
+	article := &models.Article{ID: articleID, Title: "Draft", Slug: "draft", AuthorID: authorID, Status: models.StatusDraft}
+
+	content := "Rewritten content"
+	suite.articleRepo.On("FindByID", articleID).Return(article, nil)
+	suite.articleRepo.On("Update", article).Return(nil)
+
+	result, err := service.UpdateArticle(articleID.String(), &dto.UpdateArticleRequest{Content: &content}, authorID.String(), false)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), []uuid.UUID{articleID}, checker.checked)
+}
+
+func (suite *ArticleServiceTestSuite) TestUpdateArticle_PendingRevisionNotChecked() {
+	checker := &stubDuplicateChecker{}
+	revisionRepo := new(mocks.MockArticleRevisionRepository)
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo,
+		WithRevisionRepo(revisionRepo),
+		WithDuplicateChecker(checker),
+	)
+	authorID := uuid.New()
+	live := publishedArticle(authorID, "Live Title")
+
+	newTitle := "Edited Title"
+	suite.articleRepo.On("FindByID", live.ID).Return(live, nil)
+	revisionRepo.On("FindPending", live.ID).Return(nil, gorm.ErrRecordNotFound)
+	suite.articleRepo.On("Update", live).Return(nil)
+	revisionRepo.On("Create", mock.Anything).Return(nil)
+
+	_, err := service.UpdateArticle(live.ID.String(), &dto.UpdateArticleRequest{Title: &newTitle}, authorID.String(), false)
+
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), checker.checked)
+}
+
+// stubViewRecorder counts the views it is asked to record
+type stubViewRecorder struct {
+	recorded int
+	counted  bool
+}
+
+func (r *stubViewRecorder) RecordView(articleID uuid.UUID, viewer Viewer) (bool, error) {
+	r.recorded++
+	return r.counted, nil
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticle_RepeatViewNotCounted() {
+	recorder := &stubViewRecorder{counted: false}
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithViewRecorder(recorder))
+	article := &models.Article{ID: uuid.New(), AuthorID: uuid.New(), Slug: "article", Status: models.StatusPublished, ViewCount: 10}
+	suite.articleRepo.On("FindBySlug", "article").Return(article, nil)
+
+	result, err := service.GetArticle("article", Viewer{ClientIP: "203.0.113.7", UserAgent: "Mozilla/5.0"}, true)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 10, result.ViewCount)
+	assert.Equal(suite.T(), 1, recorder.recorded)
+	suite.articleRepo.AssertNotCalled(suite.T(), "IncrementViewCount", article.ID)
+}
+
+func (suite *ArticleServiceTestSuite) TestGetArticle_AuthorViewNotCounted() {
+	recorder := &stubViewRecorder{counted: true}
+	service := NewArticleService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo, WithViewRecorder(recorder))
+	authorID := uuid.New()
+	article := &models.Article{ID: uuid.New(), AuthorID: authorID, Slug: "article", Status: models.StatusPublished, ViewCount: 10}
+	suite.articleRepo.On("FindBySlug", "article").Return(article, nil)
+
+	result, err := service.GetArticle("article", Viewer{UserID: authorID.String()}, true)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 10, result.ViewCount)
+	assert.Zero(suite.T(), recorder.recorded)
+	suite.articleRepo.AssertNotCalled(suite.T(), "IncrementViewCount", article.ID)
+}
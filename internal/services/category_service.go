@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/alfafaa/alfafaa-blog/internal/cache"
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/models"
 	"github.com/alfafaa/alfafaa-blog/internal/repositories"
@@ -24,16 +25,42 @@ type CategoryService interface {
 }
 
 type categoryService struct {
+	db           *gorm.DB
 	categoryRepo repositories.CategoryRepository
 	articleRepo  repositories.ArticleRepository
+	slugRepo     repositories.SlugHistoryRepository
+	readCache    *cache.ReadCache
 }
 
 // NewCategoryService creates a new category service
-func NewCategoryService(categoryRepo repositories.CategoryRepository, articleRepo repositories.ArticleRepository) CategoryService {
-	return &categoryService{
+func NewCategoryService(db *gorm.DB, categoryRepo repositories.CategoryRepository, articleRepo repositories.ArticleRepository, opts ...CategoryServiceOption) CategoryService {
+	svc := &categoryService{
+		db:           db,
 		categoryRepo: categoryRepo,
 		articleRepo:  articleRepo,
 	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// CategoryServiceOption is a functional option for configuring the category service
+type CategoryServiceOption func(*categoryService)
+
+// WithCategorySlugHistoryRepo sets the slug history repository on the category service (for redirects)
+func WithCategorySlugHistoryRepo(repo repositories.SlugHistoryRepository) CategoryServiceOption {
+	return func(s *categoryService) {
+		s.slugRepo = repo
+	}
+}
+
+// WithCategoryReadCache caches the category list and tree, and drops them
+// when categories change
+func WithCategoryReadCache(readCache *cache.ReadCache) CategoryServiceOption {
+	return func(s *categoryService) {
+		s.readCache = readCache
+	}
 }
 
 // CreateCategory creates a new category
@@ -90,6 +117,7 @@ func (s *categoryService) CreateCategory(req *dto.CreateCategoryRequest) (*dto.C
 		return nil, utils.WrapError(err, "failed to create category")
 	}
 
+	s.readCache.Invalidate(cache.CategoryChanged)
 	return s.toResponse(category), nil
 }
 
@@ -98,7 +126,7 @@ func (s *categoryService) GetCategory(slug string) (*dto.CategoryDetailResponse,
 	category, err := s.categoryRepo.FindBySlug(slug)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, utils.ErrNotFound
+			return nil, s.redirectFromSlug(slug)
 		}
 		return nil, utils.WrapError(err, "failed to find category")
 	}
@@ -117,32 +145,41 @@ func (s *categoryService) GetCategories(query *dto.CategoryListQuery) ([]dto.Cat
 		Search:          query.Search,
 	}
 
-	categories, err := s.categoryRepo.FindAll(filters)
-	if err != nil {
-		return nil, utils.WrapError(err, "failed to find categories")
-	}
+	load := func() ([]dto.CategoryResponse, error) {
+		categories, err := s.categoryRepo.FindAll(filters)
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to find categories")
+		}
 
-	responses := make([]dto.CategoryResponse, len(categories))
-	for i, category := range categories {
-		responses[i] = *s.toResponse(&category)
+		responses := make([]dto.CategoryResponse, len(categories))
+		for i, category := range categories {
+			responses[i] = *s.toResponse(&category)
+		}
+		return responses, nil
 	}
 
-	return responses, nil
+	// Searches are too varied to be worth caching
+	if filters.Search != "" {
+		return load()
+	}
+	key := fmt.Sprintf("%slist:%t:%t", cache.CategoryReads, filters.IncludeInactive, filters.ParentOnly)
+	return cache.Remember(s.readCache, key, load)
 }
 
 // GetCategoriesHierarchical retrieves categories in a hierarchical structure
 func (s *categoryService) GetCategoriesHierarchical() ([]*dto.CategoryTreeResponse, error) {
-	categories, err := s.categoryRepo.FindAllHierarchical()
-	if err != nil {
-		return nil, utils.WrapError(err, "failed to find categories")
-	}
-
-	responses := make([]*dto.CategoryTreeResponse, len(categories))
-	for i, category := range categories {
-		responses[i] = s.toTreeResponse(&category)
-	}
+	return cache.Remember(s.readCache, cache.CategoryReads+"tree", func() ([]*dto.CategoryTreeResponse, error) {
+		categories, err := s.categoryRepo.FindAllHierarchical()
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to find categories")
+		}
 
-	return responses, nil
+		responses := make([]*dto.CategoryTreeResponse, len(categories))
+		for i, category := range categories {
+			responses[i] = s.toTreeResponse(&category)
+		}
+		return responses, nil
+	})
 }
 
 // UpdateCategory updates a category
@@ -160,6 +197,8 @@ func (s *categoryService) UpdateCategory(id string, req *dto.UpdateCategoryReque
 		return nil, utils.WrapError(err, "failed to find category")
 	}
 
+	oldSlug := category.Slug
+
 	// Update fields
 	if req.Name != nil {
 		category.Name = *req.Name
@@ -200,10 +239,31 @@ func (s *categoryService) UpdateCategory(id string, req *dto.UpdateCategoryReque
 		}
 	}
 
-	if err := s.categoryRepo.Update(category); err != nil {
+	// The old slug must keep redirecting, so its history is saved with the rename
+	save := func(categoryRepo repositories.CategoryRepository, slugRepo repositories.SlugHistoryRepository) error {
+		if err := categoryRepo.Update(category); err != nil {
+			return err
+		}
+		return recordSlugChange(slugRepo, models.SlugEntityCategory, category.ID, oldSlug, category.Slug)
+	}
+
+	if s.db != nil {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			var slugRepo repositories.SlugHistoryRepository
+			if s.slugRepo != nil {
+				slugRepo = s.slugRepo.WithTx(tx)
+			}
+			return save(s.categoryRepo.WithTx(tx), slugRepo)
+		})
+	} else {
+		// Fallback for unit tests without db - run without transaction
+		err = save(s.categoryRepo, s.slugRepo)
+	}
+	if err != nil {
 		return nil, utils.WrapError(err, "failed to update category")
 	}
 
+	s.readCache.Invalidate(cache.CategoryChanged)
 	return s.toResponse(category), nil
 }
 
@@ -237,6 +297,7 @@ func (s *categoryService) DeleteCategory(id string) error {
 		return utils.WrapError(err, "failed to delete category")
 	}
 
+	s.readCache.Invalidate(cache.CategoryChanged)
 	return nil
 }
 
@@ -245,7 +306,7 @@ func (s *categoryService) GetCategoryArticles(slug string, query *dto.Pagination
 	category, err := s.categoryRepo.FindBySlug(slug)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, 0, utils.ErrNotFound
+			return nil, 0, s.redirectFromSlug(slug)
 		}
 		return nil, 0, utils.WrapError(err, "failed to find category")
 	}
@@ -339,3 +400,14 @@ func (s *categoryService) toTreeResponse(category *models.Category) *dto.Categor
 
 	return response
 }
+
+// redirectFromSlug resolves a retired category slug to a redirect
+func (s *categoryService) redirectFromSlug(slug string) error {
+	return slugRedirect(s.slugRepo, models.SlugEntityCategory, slug, func(id uuid.UUID) (string, error) {
+		category, err := s.categoryRepo.FindByID(id)
+		if err != nil {
+			return "", err
+		}
+		return category.Slug, nil
+	})
+}
@@ -26,7 +26,7 @@ type CategoryServiceTestSuite struct {
 func (suite *CategoryServiceTestSuite) SetupTest() {
 	suite.categoryRepo = new(mocks.MockCategoryRepository)
 	suite.articleRepo = new(mocks.MockArticleRepository)
-	suite.service = NewCategoryService(suite.categoryRepo, suite.articleRepo)
+	suite.service = NewCategoryService(nil, suite.categoryRepo, suite.articleRepo)
 }
 
 func TestCategoryServiceTestSuite(t *testing.T) {
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/fingerprint"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// duplicateThreshold is the share of an article's text that must also appear
+// in an earlier article for the pair to be flagged
+const duplicateThreshold = 0.5
+
+// DuplicateChecker fingerprints a saved article and flags it when it repeats another
+type DuplicateChecker interface {
+	CheckArticle(article *models.Article) error
+}
+
+// DuplicateService defines the interface for duplicate detection and review
+type DuplicateService interface {
+	DuplicateChecker
+	GetDuplicateReport(query *dto.DuplicateQuery) ([]dto.DuplicateFlagResponse, int64, error)
+	ReviewDuplicate(id, reviewerID string, req *dto.ReviewDuplicateRequest) (*dto.DuplicateFlagResponse, error)
+}
+
+type duplicateService struct {
+	duplicateRepo repositories.DuplicateRepository
+	articleRepo   repositories.ArticleRepository
+}
+
+// NewDuplicateService creates a new duplicate service
+func NewDuplicateService(duplicateRepo repositories.DuplicateRepository, articleRepo repositories.ArticleRepository) DuplicateService {
+	return &duplicateService{
+		duplicateRepo: duplicateRepo,
+		articleRepo:   articleRepo,
+	}
+}
+
+// CheckArticle stores the article's fingerprint and compares it with articles
+// sharing a band hash. Of each matching pair the newer article is flagged when
+// at least duplicateThreshold of its text is found in the older one.
+// Pending flags the article no longer earns are removed.
+func (s *duplicateService) CheckArticle(article *models.Article) error {
+	words := fingerprint.Words(articleText(article))
+	if len(words) < fingerprint.MinWords {
+		if err := s.duplicateRepo.DeleteFingerprint(article.ID); err != nil {
+			return err
+		}
+		return s.clearPendingFlags(article.ID, nil)
+	}
+
+	signature := fingerprint.Sign(words)
+	hashes := signature.BandHashes()
+	bands := make([]models.FingerprintBand, len(hashes))
+	for i, hash := range hashes {
+		bands[i] = models.FingerprintBand{ArticleID: article.ID, Band: i, Hash: int64(hash)}
+	}
+
+	stored := &models.ArticleFingerprint{ArticleID: article.ID, Signature: signature.Bytes()}
+	if err := s.duplicateRepo.SaveFingerprint(stored, bands); err != nil {
+		return err
+	}
+
+	candidates, err := s.duplicateRepo.FindCandidates(article.ID, bands)
+	if err != nil {
+		return err
+	}
+
+	matched := make(map[uuid.UUID]bool)
+	for _, candidate := range candidates {
+		other, err := s.articleRepo.FindByID(candidate.ArticleID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return err
+		}
+
+		copied, original := article, other
+		copiedWords, originalWords := words, fingerprint.Words(articleText(other))
+		if other.CreatedAt.After(article.CreatedAt) {
+			copied, original = other, article
+			copiedWords, originalWords = originalWords, copiedWords
+		}
+
+		overlap, passages := fingerprint.Compare(copiedWords, originalWords)
+		if overlap < duplicateThreshold {
+			continue
+		}
+
+		if passages == nil {
+			passages = []string{}
+		}
+		encoded, err := json.Marshal(passages)
+		if err != nil {
+			return err
+		}
+
+		flag := &models.DuplicateFlag{
+			ArticleID:        copied.ID,
+			MatchedArticleID: original.ID,
+			Similarity:       signature.Similarity(fingerprint.FromBytes(candidate.Signature)),
+			Overlap:          overlap,
+			Passages:         string(encoded),
+		}
+		if err := s.duplicateRepo.SaveFlag(flag); err != nil {
+			return err
+		}
+		matched[other.ID] = true
+	}
+
+	return s.clearPendingFlags(article.ID, matched)
+}
+
+// clearPendingFlags deletes the article's pending flags against articles it no
+// longer matches. Reviewed flags are kept as a record of the decision.
+func (s *duplicateService) clearPendingFlags(articleID uuid.UUID, matched map[uuid.UUID]bool) error {
+	flags, err := s.duplicateRepo.FindFlagsForArticle(articleID)
+	if err != nil {
+		return err
+	}
+
+	for _, flag := range flags {
+		other := flag.MatchedArticleID
+		if other == articleID {
+			other = flag.ArticleID
+		}
+		if flag.Status != models.DuplicateStatusPending || matched[other] {
+			continue
+		}
+		if err := s.duplicateRepo.DeleteFlag(flag.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetDuplicateReport lists flagged articles with the given status, pending by
+// default, highest overlap first
+func (s *duplicateService) GetDuplicateReport(query *dto.DuplicateQuery) ([]dto.DuplicateFlagResponse, int64, error) {
+	status := models.DuplicateStatusPending
+	if query.Status != "" {
+		status = models.DuplicateStatus(query.Status)
+	}
+
+	flags, total, err := s.duplicateRepo.FindFlags(status, query.GetPerPage(), query.GetOffset())
+	if err != nil {
+		return nil, 0, utils.WrapError(err, "failed to fetch duplicate flags")
+	}
+
+	responses := make([]dto.DuplicateFlagResponse, len(flags))
+	for i := range flags {
+		responses[i] = toDuplicateFlagResponse(&flags[i])
+	}
+
+	return responses, total, nil
+}
+
+// ReviewDuplicate records an editor's decision on a flagged article.
+// A dismissed flag stays dismissed when the articles are checked again.
+func (s *duplicateService) ReviewDuplicate(id, reviewerID string, req *dto.ReviewDuplicateRequest) (*dto.DuplicateFlagResponse, error) {
+	flagID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+	reviewerUUID, err := uuid.Parse(reviewerID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	flag, err := s.duplicateRepo.FindFlagByID(flagID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, utils.WrapError(err, "failed to find duplicate flag")
+	}
+
+	now := time.Now()
+	flag.Status = models.DuplicateStatus(req.Status)
+	flag.ReviewedBy = &reviewerUUID
+	flag.ReviewedAt = &now
+	if err := s.duplicateRepo.UpdateFlag(flag); err != nil {
+		return nil, utils.WrapError(err, "failed to update duplicate flag")
+	}
+
+	updated, err := s.duplicateRepo.FindFlagByID(flag.ID)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch duplicate flag")
+	}
+
+	response := toDuplicateFlagResponse(updated)
+	return &response, nil
+}
+
+// toDuplicateFlagResponse converts a duplicate flag to a response DTO
+func toDuplicateFlagResponse(flag *models.DuplicateFlag) dto.DuplicateFlagResponse {
+	response := dto.DuplicateFlagResponse{
+		ID:                flag.ID.String(),
+		Article:           toDuplicateArticleResponse(flag.ArticleID, flag.Article),
+		MatchedArticle:    toDuplicateArticleResponse(flag.MatchedArticleID, flag.MatchedArticle),
+		SimilarityPercent: toPercent(flag.Similarity),
+		OverlapPercent:    toPercent(flag.Overlap),
+		Passages:          []string{},
+		Status:            string(flag.Status),
+		ReviewedBy:        toDuplicateUser(flag.Reviewer),
+		ReviewedAt:        flag.ReviewedAt,
+		CreatedAt:         flag.CreatedAt,
+		UpdatedAt:         flag.UpdatedAt,
+	}
+	if flag.Passages != "" {
+		_ = json.Unmarshal([]byte(flag.Passages), &response.Passages)
+	}
+	return response
+}
+
+// toDuplicateArticleResponse summarises one article of a duplicate flag
+func toDuplicateArticleResponse(id uuid.UUID, article *models.Article) dto.DuplicateArticleResponse {
+	response := dto.DuplicateArticleResponse{ID: id.String()}
+	if article == nil {
+		return response
+	}
+
+	response.Slug = article.Slug
+	response.Title = article.Title
+	response.Status = string(article.Status)
+	response.CreatedAt = article.CreatedAt
+	if author := toDuplicateUser(article.Author); author != nil {
+		response.Author = *author
+	}
+	return response
+}
+
+// toDuplicateUser converts an author or reviewer to a public user
+func toDuplicateUser(user *models.User) *dto.PublicUserResponse {
+	if user == nil {
+		return nil
+	}
+	return &dto.PublicUserResponse{
+		ID:              user.ID.String(),
+		Username:        user.Username,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Bio:             user.Bio,
+		ProfileImageURL: user.ProfileImageURL,
+	}
+}
+
+// toPercent converts a 0-1 ratio to a percentage with one decimal
+func toPercent(ratio float64) float64 {
+	return math.Round(ratio*1000) / 10
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/fingerprint"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// helper to create duplicate service with mocks
+func newTestDuplicateService() (DuplicateService, *mocks.MockDuplicateRepository, *mocks.MockArticleRepository) {
+	duplicateRepo := new(mocks.MockDuplicateRepository)
+	articleRepo := new(mocks.MockArticleRepository)
+
+	service := NewDuplicateService(duplicateRepo, articleRepo)
+	return service, duplicateRepo, articleRepo
+}
+
+// essayWords returns n distinct words
+func essayWords(prefix string, n int) []string {
+	words := make([]string, n)
+	for i := range words {
+		words[i] = fmt.Sprintf("%s%d", prefix, i)
+	}
+	return words
+}
+
+func duplicateArticle(words []string, createdAt time.Time) *models.Article {
+	return &models.Article{
+		ID:        uuid.New(),
+		Content:   strings.Join(words, " "),
+		CreatedAt: createdAt,
+	}
+}
+
+func storedFingerprint(article *models.Article) models.ArticleFingerprint {
+	signature := fingerprint.Sign(fingerprint.Words(article.Content))
+	return models.ArticleFingerprint{ArticleID: article.ID, Signature: signature.Bytes()}
+}
+
+func TestCheckArticle_FlagsNewerCopy(t *testing.T) {
+	service, duplicateRepo, articleRepo := newTestDuplicateService()
+
+	originalWords := essayWords("w", 200)
+	copiedWords := append(append([]string(nil), originalWords[:180]...), essayWords("new", 20)...)
+	original := duplicateArticle(originalWords, time.Now().Add(-48*time.Hour))
+	copied := duplicateArticle(copiedWords, time.Now())
+
+	duplicateRepo.On("SaveFingerprint", mock.MatchedBy(func(fp *models.ArticleFingerprint) bool {
+		return fp.ArticleID == copied.ID && len(fp.Signature) == 8*fingerprint.NumHashes
+	}), mock.MatchedBy(func(bands []models.FingerprintBand) bool {
+		return len(bands) == fingerprint.Bands
+	})).Return(nil)
+	duplicateRepo.On("FindCandidates", copied.ID, mock.Anything).Return([]models.ArticleFingerprint{storedFingerprint(original)}, nil)
+	articleRepo.On("FindByID", original.ID).Return(original, nil)
+
+	var saved *models.DuplicateFlag
+	duplicateRepo.On("SaveFlag", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*models.DuplicateFlag)
+	}).Return(nil)
+	duplicateRepo.On("FindFlagsForArticle", copied.ID).Return([]models.DuplicateFlag{}, nil)
+
+	err := service.CheckArticle(copied)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, saved) {
+		assert.Equal(t, copied.ID, saved.ArticleID)
+		assert.Equal(t, original.ID, saved.MatchedArticleID)
+		assert.InDelta(t, 176.0/196.0, saved.Overlap, 1e-9)
+		assert.Greater(t, saved.Similarity, 0.6)
+
+		var passages []string
+		assert.NoError(t, json.Unmarshal([]byte(saved.Passages), &passages))
+		assert.Equal(t, []string{strings.Join(originalWords[:180], " ")}, passages)
+	}
+}
+
+func TestCheckArticle_EditingOriginalFlagsTheCopy(t *testing.T) {
+	service, duplicateRepo, articleRepo := newTestDuplicateService()
+
+	words := essayWords("w", 120)
+	original := duplicateArticle(words, time.Now().Add(-48*time.Hour))
+	copied := duplicateArticle(words, time.Now())
+
+	duplicateRepo.On("SaveFingerprint", mock.Anything, mock.Anything).Return(nil)
+	duplicateRepo.On("FindCandidates", original.ID, mock.Anything).Return([]models.ArticleFingerprint{storedFingerprint(copied)}, nil)
+	articleRepo.On("FindByID", copied.ID).Return(copied, nil)
+	duplicateRepo.On("SaveFlag", mock.MatchedBy(func(flag *models.DuplicateFlag) bool {
+		return flag.ArticleID == copied.ID && flag.MatchedArticleID == original.ID && flag.Overlap == 1
+	})).Return(nil)
+	duplicateRepo.On("FindFlagsForArticle", original.ID).Return([]models.DuplicateFlag{}, nil)
+
+	err := service.CheckArticle(original)
+
+	assert.NoError(t, err)
+	duplicateRepo.AssertCalled(t, "SaveFlag", mock.Anything)
+}
+
+func TestCheckArticle_RemovesStalePendingFlags(t *testing.T) {
+	service, duplicateRepo, articleRepo := newTestDuplicateService()
+
+	article := duplicateArticle(essayWords("w", 120), time.Now())
+	rewritten := duplicateArticle(essayWords("x", 120), time.Now().Add(-time.Hour))
+	stale := models.DuplicateFlag{ID: uuid.New(), ArticleID: article.ID, MatchedArticleID: rewritten.ID, Status: models.DuplicateStatusPending}
+	confirmed := models.DuplicateFlag{ID: uuid.New(), ArticleID: article.ID, MatchedArticleID: uuid.New(), Status: models.DuplicateStatusConfirmed}
+
+	duplicateRepo.On("SaveFingerprint", mock.Anything, mock.Anything).Return(nil)
+	duplicateRepo.On("FindCandidates", article.ID, mock.Anything).Return([]models.ArticleFingerprint{storedFingerprint(rewritten)}, nil)
+	articleRepo.On("FindByID", rewritten.ID).Return(rewritten, nil)
+	duplicateRepo.On("FindFlagsForArticle", article.ID).Return([]models.DuplicateFlag{stale, confirmed}, nil)
+	duplicateRepo.On("DeleteFlag", stale.ID).Return(nil)
+
+	err := service.CheckArticle(article)
+
+	assert.NoError(t, err)
+	duplicateRepo.AssertNotCalled(t, "SaveFlag", mock.Anything)
+	duplicateRepo.AssertNotCalled(t, "DeleteFlag", confirmed.ID)
+	duplicateRepo.AssertExpectations(t)
+}
+
+func TestCheckArticle_ShortTextNotFingerprinted(t *testing.T) {
+	service, duplicateRepo, _ := newTestDuplicateService()
+
+	article := duplicateArticle(essayWords("w", fingerprint.MinWords-1), time.Now())
+	duplicateRepo.On("DeleteFingerprint", article.ID).Return(nil)
+	duplicateRepo.On("FindFlagsForArticle", article.ID).Return([]models.DuplicateFlag{}, nil)
+
+	err := service.CheckArticle(article)
+
+	assert.NoError(t, err)
+	duplicateRepo.AssertNotCalled(t, "SaveFingerprint", mock.Anything, mock.Anything)
+}
+
+func TestGetDuplicateReport(t *testing.T) {
+	service, duplicateRepo, _ := newTestDuplicateService()
+
+	author := &models.User{ID: uuid.New(), Username: "copycat"}
+	flag := models.DuplicateFlag{
+		ID:               uuid.New(),
+		ArticleID:        uuid.New(),
+		MatchedArticleID: uuid.New(),
+		Similarity:       0.8125,
+		Overlap:          0.91837,
+		Passages:         `["a shared passage"]`,
+		Status:           models.DuplicateStatusPending,
+	}
+	flag.Article = &models.Article{ID: flag.ArticleID, Slug: "copy", Author: author}
+	duplicateRepo.On("FindFlags", models.DuplicateStatusPending, 20, 0).Return([]models.DuplicateFlag{flag}, int64(1), nil)
+
+	result, total, err := service.GetDuplicateReport(&dto.DuplicateQuery{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, 81.3, result[0].SimilarityPercent)
+	assert.Equal(t, 91.8, result[0].OverlapPercent)
+	assert.Equal(t, []string{"a shared passage"}, result[0].Passages)
+	assert.Equal(t, "copycat", result[0].Article.Author.Username)
+	assert.Equal(t, flag.MatchedArticleID.String(), result[0].MatchedArticle.ID)
+}
+
+func TestReviewDuplicate(t *testing.T) {
+	service, duplicateRepo, _ := newTestDuplicateService()
+
+	reviewerID := uuid.New()
+	flag := &models.DuplicateFlag{ID: uuid.New(), Status: models.DuplicateStatusPending}
+	duplicateRepo.On("FindFlagByID", flag.ID).Return(flag, nil)
+	duplicateRepo.On("UpdateFlag", mock.MatchedBy(func(f *models.DuplicateFlag) bool {
+		return f.Status == models.DuplicateStatusDismissed && *f.ReviewedBy == reviewerID && f.ReviewedAt != nil
+	})).Return(nil)
+
+	result, err := service.ReviewDuplicate(flag.ID.String(), reviewerID.String(), &dto.ReviewDuplicateRequest{Status: "dismissed"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "dismissed", result.Status)
+}
+
+func TestReviewDuplicate_NotFound(t *testing.T) {
+	service, duplicateRepo, _ := newTestDuplicateService()
+
+	id := uuid.New()
+	duplicateRepo.On("FindFlagByID", id).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := service.ReviewDuplicate(id.String(), uuid.New().String(), &dto.ReviewDuplicateRequest{Status: "confirmed"})
+
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+}
@@ -3,12 +3,14 @@ package services
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/models"
 	"github.com/alfafaa/alfafaa-blog/internal/repositories"
 	"github.com/alfafaa/alfafaa-blog/internal/utils"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
@@ -22,16 +24,16 @@ type EngagementService interface {
 	// Bookmarks
 	BookmarkArticle(userID, slug string) (*dto.BookmarkResponse, error)
 	UnbookmarkArticle(userID, slug string) (*dto.BookmarkResponse, error)
-	GetBookmarkedArticles(userID string, query *dto.PaginationQuery) ([]dto.ArticleListItemResponse, int64, error)
+	GetBookmarkedArticles(userID string, query *dto.PaginationQuery) ([]dto.ArticleListItemResponse, int64, string, error)
 
 	// Comments
-	GetComments(slug string, query *dto.PaginationQuery) ([]dto.EngagementCommentResponse, int64, error)
+	GetComments(userID, slug string, query *dto.PaginationQuery) ([]dto.EngagementCommentResponse, int64, string, error)
 	CreateComment(userID, slug string, req *dto.CreateCommentRequest) (*dto.EngagementCommentResponse, error)
 	UpdateComment(userID, slug, commentID string, req *dto.UpdateCommentRequest) (*dto.EngagementCommentResponse, error)
 	DeleteComment(userID, slug, commentID string, isAdmin bool) error
 
 	// Notifications
-	GetNotifications(userID string, query *dto.PaginationQuery) ([]dto.NotificationResponse, int64, error)
+	GetNotifications(userID string, query *dto.PaginationQuery) ([]dto.NotificationResponse, int64, string, error)
 	GetUnreadCount(userID string) (*dto.UnreadCountResponse, error)
 	MarkNotificationAsRead(userID, notificationID string) error
 	MarkAllNotificationsAsRead(userID string) error
@@ -41,6 +43,7 @@ type EngagementService interface {
 
 	// Article engagement data
 	GetArticleEngagement(articleID uuid.UUID, userID string) (likesCount int, commentsCount int, userLiked bool, userBookmarked bool)
+	EnrichArticleList(articles []dto.ArticleListItemResponse, userID string)
 }
 
 type engagementService struct {
@@ -73,12 +76,9 @@ func (s *engagementService) LikeArticle(userID, slug string) (*dto.LikeResponse,
 		return nil, utils.ErrBadRequest
 	}
 
-	article, err := s.articleRepo.FindBySlug(slug)
+	article, err := findReadableArticle(s.articleRepo, s.userRepo, slug, &userUUID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, utils.ErrNotFound
-		}
-		return nil, utils.WrapError(err, "failed to find article")
+		return nil, err
 	}
 
 	// Idempotent: if already liked, just return current status
@@ -145,12 +145,9 @@ func (s *engagementService) GetLikeStatus(userID, slug string) (*dto.LikeRespons
 		return nil, utils.ErrBadRequest
 	}
 
-	article, err := s.articleRepo.FindBySlug(slug)
+	article, err := findReadableArticle(s.articleRepo, s.userRepo, slug, &userUUID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, utils.ErrNotFound
-		}
-		return nil, utils.WrapError(err, "failed to find article")
+		return nil, err
 	}
 
 	liked, err := s.engagementRepo.HasLiked(userUUID, article.ID)
@@ -173,12 +170,9 @@ func (s *engagementService) BookmarkArticle(userID, slug string) (*dto.BookmarkR
 		return nil, utils.ErrBadRequest
 	}
 
-	article, err := s.articleRepo.FindBySlug(slug)
+	article, err := findReadableArticle(s.articleRepo, s.userRepo, slug, &userUUID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, utils.ErrNotFound
-		}
-		return nil, utils.WrapError(err, "failed to find article")
+		return nil, err
 	}
 
 	// Idempotent: if already bookmarked, just return
@@ -221,45 +215,60 @@ func (s *engagementService) UnbookmarkArticle(userID, slug string) (*dto.Bookmar
 	return &dto.BookmarkResponse{Bookmarked: false}, nil
 }
 
-func (s *engagementService) GetBookmarkedArticles(userID string, query *dto.PaginationQuery) ([]dto.ArticleListItemResponse, int64, error) {
+func (s *engagementService) GetBookmarkedArticles(userID string, query *dto.PaginationQuery) ([]dto.ArticleListItemResponse, int64, string, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
-		return nil, 0, utils.ErrBadRequest
+		return nil, 0, "", utils.ErrBadRequest
 	}
 
-	articles, total, err := s.engagementRepo.GetBookmarkedArticles(userUUID, query.GetPerPage(), query.GetOffset())
+	cursor, err := queryKeyset(query, "")
 	if err != nil {
-		return nil, 0, utils.WrapError(err, "failed to get bookmarked articles")
+		return nil, 0, "", err
 	}
 
-	responses := make([]dto.ArticleListItemResponse, len(articles))
-	for i, article := range articles {
-		responses[i] = toArticleListItemResponse(&article)
+	bookmarks, total, err := s.engagementRepo.GetBookmarks(userUUID, query.GetPerPage(), query.GetOffset(), cursor)
+	if err != nil {
+		return nil, 0, "", utils.WrapError(err, "failed to get bookmarked articles")
 	}
 
-	return responses, total, nil
+	responses := make([]dto.ArticleListItemResponse, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		if bookmark.Article != nil {
+			responses = append(responses, toArticleListItemResponse(bookmark.Article))
+		}
+	}
+
+	next := nextCursor(query, len(bookmarks), func() *repositories.Keyset {
+		last := bookmarks[len(bookmarks)-1]
+		return repositories.NewTimeKeyset(last.CreatedAt, last.ID)
+	})
+
+	return responses, total, next, nil
 }
 
 // --- Comments ---
 
-func (s *engagementService) GetComments(slug string, query *dto.PaginationQuery) ([]dto.EngagementCommentResponse, int64, error) {
-	article, err := s.articleRepo.FindBySlug(slug)
+func (s *engagementService) GetComments(userID, slug string, query *dto.PaginationQuery) ([]dto.EngagementCommentResponse, int64, string, error) {
+	cursor, err := queryKeyset(query, "")
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, 0, utils.ErrNotFound
-		}
-		return nil, 0, utils.WrapError(err, "failed to find article")
+		return nil, 0, "", err
+	}
+
+	article, err := findReadableArticle(s.articleRepo, s.userRepo, slug, optionalUserID(userID))
+	if err != nil {
+		return nil, 0, "", err
 	}
 
 	filters := repositories.CommentFilters{
 		IncludeUnapproved: true, // Show all comments in the new engagement model
 		Limit:             query.GetPerPage(),
 		Offset:            query.GetOffset(),
+		Cursor:            cursor,
 	}
 
 	comments, total, err := s.commentRepo.FindByArticle(article.ID, filters)
 	if err != nil {
-		return nil, 0, utils.WrapError(err, "failed to get comments")
+		return nil, 0, "", utils.WrapError(err, "failed to get comments")
 	}
 
 	responses := make([]dto.EngagementCommentResponse, len(comments))
@@ -267,7 +276,12 @@ func (s *engagementService) GetComments(slug string, query *dto.PaginationQuery)
 		responses[i] = s.toCommentResponse(&comment)
 	}
 
-	return responses, total, nil
+	next := nextCursor(query, len(comments), func() *repositories.Keyset {
+		last := comments[len(comments)-1]
+		return repositories.NewTimeKeyset(last.CreatedAt, last.ID)
+	})
+
+	return responses, total, next, nil
 }
 
 func (s *engagementService) CreateComment(userID, slug string, req *dto.CreateCommentRequest) (*dto.EngagementCommentResponse, error) {
@@ -276,12 +290,16 @@ func (s *engagementService) CreateComment(userID, slug string, req *dto.CreateCo
 		return nil, utils.ErrBadRequest
 	}
 
-	article, err := s.articleRepo.FindBySlug(slug)
+	article, err := findReadableArticle(s.articleRepo, s.userRepo, slug, &userUUID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, utils.ErrNotFound
-		}
-		return nil, utils.WrapError(err, "failed to find article")
+		return nil, err
+	}
+
+	if err := commentStateError(article); err != nil {
+		return nil, err
+	}
+	if err := s.checkCommentAudience(article, userUUID); err != nil {
+		return nil, err
 	}
 
 	comment := &models.Comment{
@@ -323,6 +341,48 @@ func (s *engagementService) CreateComment(userID, slug string, req *dto.CreateCo
 	return &response, nil
 }
 
+// commentStateError reports why an article doesn't take comments, or nil if it does
+func commentStateError(article *models.Article) error {
+	switch article.CommentState(time.Now()) {
+	case models.CommentStateDisabled:
+		return utils.NewAppError("COMMENTS_DISABLED", "Comments are disabled for this article", 403)
+	case models.CommentStateLocked:
+		return utils.NewAppError("COMMENTS_LOCKED", "Comments on this article are locked", 403)
+	case models.CommentStateClosed:
+		return utils.NewAppError("COMMENTS_CLOSED", "Comments on this article are closed", 403)
+	}
+	return nil
+}
+
+// checkCommentAudience checks that the user is among the readers allowed to
+// comment on the article. The author can always comment.
+func (s *engagementService) checkCommentAudience(article *models.Article, userID uuid.UUID) error {
+	if article.AuthorID == userID {
+		return nil
+	}
+
+	switch article.CommentAudience {
+	case models.CommentAudienceFollowers:
+		following, err := s.userRepo.IsFollowing(userID, article.AuthorID)
+		if err != nil {
+			return utils.WrapError(err, "failed to check follow status")
+		}
+		if !following {
+			return utils.NewAppError("COMMENTS_FOLLOWERS_ONLY", "Only the author's followers can comment on this article", 403)
+		}
+	case models.CommentAudienceVerified:
+		user, err := s.userRepo.FindByID(userID)
+		if err != nil {
+			return utils.WrapError(err, "failed to find user")
+		}
+		if !user.IsVerified {
+			return utils.NewAppError("COMMENTS_VERIFIED_ONLY", "Only users with a verified email can comment on this article", 403)
+		}
+	}
+
+	return nil
+}
+
 func (s *engagementService) UpdateComment(userID, slug, commentID string, req *dto.UpdateCommentRequest) (*dto.EngagementCommentResponse, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
@@ -347,6 +407,15 @@ func (s *engagementService) UpdateComment(userID, slug, commentID string, req *d
 		return nil, utils.ErrForbidden
 	}
 
+	// Comments on locked, closed or disabled articles are read-only
+	article, err := s.articleRepo.FindByID(comment.ArticleID)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to find article")
+	}
+	if err := commentStateError(article); err != nil {
+		return nil, err
+	}
+
 	comment.Content = req.Content
 	if err := s.commentRepo.Update(comment); err != nil {
 		return nil, utils.WrapError(err, "failed to update comment")
@@ -395,15 +464,20 @@ func (s *engagementService) DeleteComment(userID, slug, commentID string, isAdmi
 
 // --- Notifications ---
 
-func (s *engagementService) GetNotifications(userID string, query *dto.PaginationQuery) ([]dto.NotificationResponse, int64, error) {
+func (s *engagementService) GetNotifications(userID string, query *dto.PaginationQuery) ([]dto.NotificationResponse, int64, string, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
-		return nil, 0, utils.ErrBadRequest
+		return nil, 0, "", utils.ErrBadRequest
 	}
 
-	notifications, total, err := s.engagementRepo.GetNotifications(userUUID, query.GetPerPage(), query.GetOffset())
+	cursor, err := queryKeyset(query, "")
 	if err != nil {
-		return nil, 0, utils.WrapError(err, "failed to get notifications")
+		return nil, 0, "", err
+	}
+
+	notifications, total, err := s.engagementRepo.GetNotifications(userUUID, query.GetPerPage(), query.GetOffset(), cursor)
+	if err != nil {
+		return nil, 0, "", utils.WrapError(err, "failed to get notifications")
 	}
 
 	responses := make([]dto.NotificationResponse, len(notifications))
@@ -411,7 +485,12 @@ func (s *engagementService) GetNotifications(userID string, query *dto.Paginatio
 		responses[i] = s.toNotificationResponse(&notif)
 	}
 
-	return responses, total, nil
+	next := nextCursor(query, len(notifications), func() *repositories.Keyset {
+		last := notifications[len(notifications)-1]
+		return repositories.NewTimeKeyset(last.CreatedAt, last.ID)
+	})
+
+	return responses, total, next, nil
 }
 
 func (s *engagementService) GetUnreadCount(userID string) (*dto.UnreadCountResponse, error) {
@@ -500,6 +579,47 @@ func (s *engagementService) GetArticleEngagement(articleID uuid.UUID, userID str
 	return
 }
 
+// EnrichArticleList fills in the like and comment counts of a page of
+// articles, and whether the reader has liked or bookmarked them, with a fixed
+// number of queries for the whole page. On failure the page is left as it is.
+func (s *engagementService) EnrichArticleList(articles []dto.ArticleListItemResponse, userID string) {
+	if len(articles) == 0 {
+		return
+	}
+
+	articleIDs := make([]uuid.UUID, 0, len(articles))
+	for _, article := range articles {
+		if id, err := uuid.Parse(article.ID); err == nil {
+			articleIDs = append(articleIDs, id)
+		}
+	}
+
+	var reader *uuid.UUID
+	if userID != "" {
+		if id, err := uuid.Parse(userID); err == nil {
+			reader = &id
+		}
+	}
+
+	engagements, err := s.engagementRepo.GetArticleEngagements(articleIDs, reader)
+	if err != nil {
+		utils.Warn("Failed to load article engagement", zap.Int("articles", len(articleIDs)), zap.Error(err))
+		return
+	}
+
+	for i := range articles {
+		id, err := uuid.Parse(articles[i].ID)
+		if err != nil {
+			continue
+		}
+		engagement := engagements[id]
+		articles[i].LikesCount = int(engagement.LikesCount)
+		articles[i].CommentsCount = int(engagement.CommentsCount)
+		articles[i].UserLiked = engagement.Liked
+		articles[i].UserBookmarked = engagement.Bookmarked
+	}
+}
+
 // --- Helper methods ---
 
 func (s *engagementService) toCommentResponse(comment *models.Comment) dto.EngagementCommentResponse {
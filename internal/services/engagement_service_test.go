@@ -6,6 +6,8 @@ import (
 
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
 	"github.com/alfafaa/alfafaa-blog/tests/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -33,7 +35,7 @@ func TestLikeArticle_Success(t *testing.T) {
 	articleID := uuid.New()
 	authorID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article", AuthorID: authorID}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article", AuthorID: authorID}
 	user := &models.User{ID: userID, FirstName: "John", LastName: "Doe"}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
@@ -60,7 +62,7 @@ func TestLikeArticle_Idempotent(t *testing.T) {
 	userID := uuid.New()
 	articleID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article", AuthorID: uuid.New()}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article", AuthorID: uuid.New()}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 	engagementRepo.On("HasLiked", userID, articleID).Return(true, nil) // already liked
@@ -83,7 +85,7 @@ func TestLikeArticle_NoSelfNotification(t *testing.T) {
 	articleID := uuid.New()
 
 	// User is the author of the article
-	article := &models.Article{ID: articleID, Slug: "my-article", AuthorID: userID}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "my-article", AuthorID: userID}
 
 	articleRepo.On("FindBySlug", "my-article").Return(article, nil)
 	engagementRepo.On("HasLiked", userID, articleID).Return(false, nil)
@@ -110,6 +112,18 @@ func TestLikeArticle_ArticleNotFound(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestLikeArticle_DraftNotFound(t *testing.T) {
+	service, engagementRepo, articleRepo, _, _ := newTestEngagementService()
+
+	articleRepo.On("FindBySlug", "draft").Return(&models.Article{ID: uuid.New(), Status: models.StatusDraft}, nil)
+
+	result, err := service.LikeArticle(uuid.New().String(), "draft")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+	engagementRepo.AssertNotCalled(t, "CreateLike", mock.Anything)
+}
+
 func TestLikeArticle_InvalidUserID(t *testing.T) {
 	service, _, _, _, _ := newTestEngagementService()
 
@@ -125,7 +139,7 @@ func TestUnlikeArticle_Success(t *testing.T) {
 	userID := uuid.New()
 	articleID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article"}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article"}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 	engagementRepo.On("DeleteLike", userID, articleID).Return(nil)
@@ -144,7 +158,7 @@ func TestGetLikeStatus_Liked(t *testing.T) {
 	userID := uuid.New()
 	articleID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article"}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article"}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 	engagementRepo.On("HasLiked", userID, articleID).Return(true, nil)
@@ -163,7 +177,7 @@ func TestGetLikeStatus_NotLiked(t *testing.T) {
 	userID := uuid.New()
 	articleID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article"}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article"}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 	engagementRepo.On("HasLiked", userID, articleID).Return(false, nil)
@@ -184,7 +198,7 @@ func TestBookmarkArticle_Success(t *testing.T) {
 	userID := uuid.New()
 	articleID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article"}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article"}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 	engagementRepo.On("HasBookmarked", userID, articleID).Return(false, nil)
@@ -202,7 +216,7 @@ func TestBookmarkArticle_Idempotent(t *testing.T) {
 	userID := uuid.New()
 	articleID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article"}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article"}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 	engagementRepo.On("HasBookmarked", userID, articleID).Return(true, nil) // already bookmarked
@@ -227,13 +241,28 @@ func TestBookmarkArticle_ArticleNotFound(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestBookmarkArticle_FollowersOnly(t *testing.T) {
+	service, engagementRepo, articleRepo, _, userRepo := newTestEngagementService()
+
+	userID, authorID := uuid.New(), uuid.New()
+	article := &models.Article{ID: uuid.New(), Status: models.StatusPublished, Visibility: models.VisibilityFollowers, AuthorID: authorID}
+	articleRepo.On("FindBySlug", "followers").Return(article, nil)
+	userRepo.On("IsFollowing", userID, authorID).Return(false, nil)
+
+	result, err := service.BookmarkArticle(userID.String(), "followers")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+	engagementRepo.AssertNotCalled(t, "CreateBookmark", mock.Anything)
+}
+
 func TestUnbookmarkArticle_Success(t *testing.T) {
 	service, engagementRepo, articleRepo, _, _ := newTestEngagementService()
 
 	userID := uuid.New()
 	articleID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article"}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article"}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 	engagementRepo.On("DeleteBookmark", userID, articleID).Return(nil)
@@ -249,27 +278,28 @@ func TestGetBookmarkedArticles_Success(t *testing.T) {
 
 	userID := uuid.New()
 	author := &models.User{ID: uuid.New(), Username: "author1", FirstName: "Jane"}
-	articles := []models.Article{
-		{ID: uuid.New(), Title: "Bookmarked 1", Slug: "bookmarked-1", Status: models.StatusPublished, Author: author},
-		{ID: uuid.New(), Title: "Bookmarked 2", Slug: "bookmarked-2", Status: models.StatusPublished, Author: author},
+	bookmarks := []models.Bookmark{
+		{ID: uuid.New(), Article: &models.Article{ID: uuid.New(), Title: "Bookmarked 1", Slug: "bookmarked-1", Status: models.StatusPublished, Author: author}},
+		{ID: uuid.New(), Article: &models.Article{ID: uuid.New(), Title: "Bookmarked 2", Slug: "bookmarked-2", Status: models.StatusPublished, Author: author}},
 	}
 
 	query := &dto.PaginationQuery{Page: 1, PerPage: 20}
-	engagementRepo.On("GetBookmarkedArticles", userID, 20, 0).Return(articles, int64(2), nil)
+	engagementRepo.On("GetBookmarks", userID, 20, 0, (*repositories.Keyset)(nil)).Return(bookmarks, int64(2), nil)
 
-	result, total, err := service.GetBookmarkedArticles(userID.String(), query)
+	result, total, next, err := service.GetBookmarkedArticles(userID.String(), query)
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(2), total)
 	assert.Len(t, result, 2)
 	assert.Equal(t, "Bookmarked 1", result[0].Title)
+	assert.Empty(t, next) // The page isn't full
 }
 
 func TestGetBookmarkedArticles_InvalidUserID(t *testing.T) {
 	service, _, _, _, _ := newTestEngagementService()
 
 	query := &dto.PaginationQuery{Page: 1, PerPage: 20}
-	result, total, err := service.GetBookmarkedArticles("not-a-uuid", query)
+	result, total, _, err := service.GetBookmarkedArticles("not-a-uuid", query)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -282,7 +312,7 @@ func TestGetComments_Success(t *testing.T) {
 	service, _, articleRepo, commentRepo, _ := newTestEngagementService()
 
 	articleID := uuid.New()
-	article := &models.Article{ID: articleID, Slug: "test-article"}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article"}
 	commenter := &models.User{ID: uuid.New(), Username: "commenter1", FirstName: "John"}
 
 	comments := []models.Comment{
@@ -303,7 +333,7 @@ func TestGetComments_Success(t *testing.T) {
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
 	commentRepo.On("FindByArticle", articleID, mock.AnythingOfType("repositories.CommentFilters")).Return(comments, int64(1), nil)
 
-	result, total, err := service.GetComments("test-article", query)
+	result, total, _, err := service.GetComments("", "test-article", query)
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), total)
@@ -318,13 +348,35 @@ func TestGetComments_ArticleNotFound(t *testing.T) {
 	articleRepo.On("FindBySlug", "nonexistent").Return(nil, gorm.ErrRecordNotFound)
 	query := &dto.PaginationQuery{Page: 1, PerPage: 20}
 
-	result, total, err := service.GetComments("nonexistent", query)
+	result, total, _, err := service.GetComments("", "nonexistent", query)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Equal(t, int64(0), total)
 }
 
+func TestGetComments_HiddenArticles(t *testing.T) {
+	service, _, articleRepo, commentRepo, userRepo := newTestEngagementService()
+
+	follower, authorID := uuid.New(), uuid.New()
+	followersOnly := &models.Article{ID: uuid.New(), Status: models.StatusPublished, Visibility: models.VisibilityFollowers, AuthorID: authorID}
+	articleRepo.On("FindBySlug", "draft").Return(&models.Article{ID: uuid.New(), Status: models.StatusDraft, AuthorID: authorID}, nil)
+	articleRepo.On("FindBySlug", "followers").Return(followersOnly, nil)
+	userRepo.On("IsFollowing", follower, authorID).Return(true, nil)
+	commentRepo.On("FindByArticle", followersOnly.ID, mock.AnythingOfType("repositories.CommentFilters")).Return([]models.Comment{}, int64(0), nil)
+	query := &dto.PaginationQuery{Page: 1, PerPage: 20}
+
+	_, _, _, err := service.GetComments("", "draft", query)
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+
+	_, _, _, err = service.GetComments("", "followers", query)
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+
+	_, _, _, err = service.GetComments(follower.String(), "followers", query)
+	assert.NoError(t, err)
+	commentRepo.AssertNumberOfCalls(t, "FindByArticle", 1)
+}
+
 func TestCreateComment_Success(t *testing.T) {
 	service, engagementRepo, articleRepo, commentRepo, userRepo := newTestEngagementService()
 
@@ -333,7 +385,7 @@ func TestCreateComment_Success(t *testing.T) {
 	authorID := uuid.New()
 	commentID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article", AuthorID: authorID}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article", AuthorID: authorID}
 	user := &models.User{ID: userID, Username: "commenter", FirstName: "John", LastName: "Doe"}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
@@ -373,7 +425,7 @@ func TestCreateComment_WithParentID(t *testing.T) {
 	parentID := uuid.New()
 	commentID := uuid.New()
 
-	article := &models.Article{ID: articleID, Slug: "test-article", AuthorID: authorID}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "test-article", AuthorID: authorID}
 	user := &models.User{ID: userID, Username: "replier", FirstName: "Jane"}
 
 	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
@@ -413,7 +465,7 @@ func TestCreateComment_NoSelfNotification(t *testing.T) {
 	commentID := uuid.New()
 
 	// User is the article author - commenting on own article
-	article := &models.Article{ID: articleID, Slug: "my-article", AuthorID: userID}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "my-article", AuthorID: userID}
 	user := &models.User{ID: userID, Username: "author", FirstName: "Self"}
 
 	articleRepo.On("FindBySlug", "my-article").Return(article, nil)
@@ -438,8 +490,77 @@ func TestCreateComment_NoSelfNotification(t *testing.T) {
 	assert.NotNil(t, result)
 }
 
+func TestCreateComment_CommentState(t *testing.T) {
+	published := time.Now().AddDate(0, 0, -10)
+	closeAfter := 7
+
+	tests := []struct {
+		name    string
+		article models.Article
+		code    string
+	}{
+		{name: "disabled", article: models.Article{CommentsDisabled: true}, code: "COMMENTS_DISABLED"},
+		{name: "locked", article: models.Article{CommentsLocked: true}, code: "COMMENTS_LOCKED"},
+		{name: "closed", article: models.Article{PublishedAt: &published, CommentsCloseAfterDays: &closeAfter}, code: "COMMENTS_CLOSED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, articleRepo, commentRepo, _ := newTestEngagementService()
+			article := tt.article
+			article.ID = uuid.New()
+			article.Status = models.StatusPublished
+			articleRepo.On("FindBySlug", "test-article").Return(&article, nil)
+
+			_, err := service.CreateComment(uuid.New().String(), "test-article", &dto.CreateCommentRequest{Content: "Hello"})
+
+			appErr, ok := utils.IsAppError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.code, appErr.Code)
+			commentRepo.AssertNotCalled(t, "Create", mock.Anything)
+		})
+	}
+}
+
+func TestCreateComment_Audience(t *testing.T) {
+	service, _, articleRepo, _, userRepo := newTestEngagementService()
+
+	authorID := uuid.New()
+	stranger := uuid.New()
+	unverified := uuid.New()
+
+	articleRepo.On("FindBySlug", "followers").Return(&models.Article{ID: uuid.New(), Status: models.StatusPublished, AuthorID: authorID, CommentAudience: models.CommentAudienceFollowers}, nil)
+	articleRepo.On("FindBySlug", "verified").Return(&models.Article{ID: uuid.New(), Status: models.StatusPublished, AuthorID: authorID, CommentAudience: models.CommentAudienceVerified}, nil)
+	userRepo.On("IsFollowing", stranger, authorID).Return(false, nil)
+	userRepo.On("FindByID", unverified).Return(&models.User{ID: unverified}, nil)
+
+	_, err := service.CreateComment(stranger.String(), "followers", &dto.CreateCommentRequest{Content: "Hello"})
+	appErr, ok := utils.IsAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, "COMMENTS_FOLLOWERS_ONLY", appErr.Code)
+
+	_, err = service.CreateComment(unverified.String(), "verified", &dto.CreateCommentRequest{Content: "Hello"})
+	appErr, ok = utils.IsAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, "COMMENTS_VERIFIED_ONLY", appErr.Code)
+}
+
+func TestCreateComment_FollowersOnlyArticle(t *testing.T) {
+	service, _, articleRepo, commentRepo, userRepo := newTestEngagementService()
+
+	userID, authorID := uuid.New(), uuid.New()
+	article := &models.Article{ID: uuid.New(), Status: models.StatusPublished, Visibility: models.VisibilityFollowers, AuthorID: authorID}
+	articleRepo.On("FindBySlug", "followers").Return(article, nil)
+	userRepo.On("IsFollowing", userID, authorID).Return(false, nil)
+
+	_, err := service.CreateComment(userID.String(), "followers", &dto.CreateCommentRequest{Content: "Hello"})
+
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+	commentRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
 func TestUpdateComment_Success(t *testing.T) {
-	service, _, _, commentRepo, _ := newTestEngagementService()
+	service, _, articleRepo, commentRepo, _ := newTestEngagementService()
 
 	userID := uuid.New()
 	commentID := uuid.New()
@@ -458,6 +579,7 @@ func TestUpdateComment_Success(t *testing.T) {
 	}
 
 	commentRepo.On("FindByID", commentID).Return(comment, nil).Once()
+	articleRepo.On("FindByID", articleID).Return(&models.Article{ID: articleID}, nil)
 	commentRepo.On("Update", mock.AnythingOfType("*models.Comment")).Return(nil)
 	commentRepo.On("FindByID", commentID).Return(&models.Comment{
 		ID:        commentID,
@@ -477,6 +599,24 @@ func TestUpdateComment_Success(t *testing.T) {
 	assert.Equal(t, "Updated content", result.Content)
 }
 
+func TestUpdateComment_Locked(t *testing.T) {
+	service, _, articleRepo, commentRepo, _ := newTestEngagementService()
+
+	userID := uuid.New()
+	commentID := uuid.New()
+	articleID := uuid.New()
+
+	commentRepo.On("FindByID", commentID).Return(&models.Comment{ID: commentID, ArticleID: articleID, UserID: userID}, nil)
+	articleRepo.On("FindByID", articleID).Return(&models.Article{ID: articleID, CommentsLocked: true}, nil)
+
+	_, err := service.UpdateComment(userID.String(), "test-article", commentID.String(), &dto.UpdateCommentRequest{Content: "Edited"})
+
+	appErr, ok := utils.IsAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, "COMMENTS_LOCKED", appErr.Code)
+	commentRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
 func TestUpdateComment_Forbidden(t *testing.T) {
 	service, _, _, commentRepo, _ := newTestEngagementService()
 
@@ -572,7 +712,7 @@ func TestGetNotifications_Success(t *testing.T) {
 	articleID := uuid.New()
 
 	actor := &models.User{ID: actorID, Username: "actor", FirstName: "Jane", LastName: "Doe"}
-	article := &models.Article{ID: articleID, Slug: "liked-article", Title: "Liked Article"}
+	article := &models.Article{ID: articleID, Status: models.StatusPublished, Slug: "liked-article", Title: "Liked Article"}
 
 	notifications := []models.Notification{
 		{
@@ -590,9 +730,9 @@ func TestGetNotifications_Success(t *testing.T) {
 	}
 
 	query := &dto.PaginationQuery{Page: 1, PerPage: 20}
-	engagementRepo.On("GetNotifications", userID, 20, 0).Return(notifications, int64(1), nil)
+	engagementRepo.On("GetNotifications", userID, 20, 0, (*repositories.Keyset)(nil)).Return(notifications, int64(1), nil)
 
-	result, total, err := service.GetNotifications(userID.String(), query)
+	result, total, _, err := service.GetNotifications(userID.String(), query)
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), total)
@@ -629,15 +769,56 @@ func TestGetNotifications_FollowTypeNoArticle(t *testing.T) {
 	}
 
 	query := &dto.PaginationQuery{Page: 1, PerPage: 20}
-	engagementRepo.On("GetNotifications", userID, 20, 0).Return(notifications, int64(1), nil)
+	engagementRepo.On("GetNotifications", userID, 20, 0, (*repositories.Keyset)(nil)).Return(notifications, int64(1), nil)
 
-	result, _, err := service.GetNotifications(userID.String(), query)
+	result, _, _, err := service.GetNotifications(userID.String(), query)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "follow", result[0].Type)
 	assert.Nil(t, result[0].Article)
 }
 
+func TestGetNotifications_CursorPages(t *testing.T) {
+	service, engagementRepo, _, _, _ := newTestEngagementService()
+
+	userID := uuid.New()
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	notifications := []models.Notification{
+		{ID: uuid.New(), UserID: userID, Type: models.NotificationTypeFollow, CreatedAt: createdAt},
+		{ID: uuid.New(), UserID: userID, Type: models.NotificationTypeFollow, CreatedAt: createdAt.Add(-time.Minute)},
+	}
+
+	// A full first page links to the page after its last notification
+	engagementRepo.On("GetNotifications", userID, 2, 0, (*repositories.Keyset)(nil)).Return(notifications, int64(5), nil)
+
+	_, total, next, err := service.GetNotifications(userID.String(), &dto.PaginationQuery{PerPage: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), total)
+	assert.NotEmpty(t, next)
+
+	after, err := repositories.DecodeKeyset(next)
+	assert.NoError(t, err)
+	assert.Equal(t, notifications[1].ID, after.ID)
+
+	engagementRepo.On("GetNotifications", userID, 2, 0, mock.AnythingOfType("*repositories.Keyset")).Return(notifications[:1], int64(0), nil)
+
+	result, _, next, err := service.GetNotifications(userID.String(), &dto.PaginationQuery{PerPage: 2, Cursor: next})
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Empty(t, next)
+}
+
+func TestGetNotifications_InvalidCursor(t *testing.T) {
+	service, engagementRepo, _, _, _ := newTestEngagementService()
+
+	_, _, _, err := service.GetNotifications(uuid.New().String(), &dto.PaginationQuery{Cursor: "bogus"})
+
+	appErr, ok := utils.IsAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, "INVALID_CURSOR", appErr.Code)
+	engagementRepo.AssertNotCalled(t, "GetNotifications", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestGetUnreadCount_Success(t *testing.T) {
 	service, engagementRepo, _, _, _ := newTestEngagementService()
 
@@ -767,3 +948,51 @@ func TestGetArticleEngagement_NoAuthUser(t *testing.T) {
 	engagementRepo.AssertNotCalled(t, "HasLiked", mock.Anything, mock.Anything)
 	engagementRepo.AssertNotCalled(t, "HasBookmarked", mock.Anything, mock.Anything)
 }
+
+// ===================== EnrichArticleList Tests =====================
+
+func TestEnrichArticleList_OneBatchForThePage(t *testing.T) {
+	service, engagementRepo, _, _, _ := newTestEngagementService()
+
+	userID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	articles := []dto.ArticleListItemResponse{{ID: first.String()}, {ID: second.String()}}
+
+	engagementRepo.On("GetArticleEngagements", []uuid.UUID{first, second}, &userID).Return(map[uuid.UUID]repositories.ArticleEngagement{
+		first: {LikesCount: 4, CommentsCount: 2, Liked: true},
+	}, nil).Once()
+
+	service.EnrichArticleList(articles, userID.String())
+
+	assert.Equal(t, 4, articles[0].LikesCount)
+	assert.Equal(t, 2, articles[0].CommentsCount)
+	assert.True(t, articles[0].UserLiked)
+	assert.False(t, articles[0].UserBookmarked)
+	assert.Zero(t, articles[1].LikesCount)
+	assert.False(t, articles[1].UserLiked)
+	engagementRepo.AssertExpectations(t)
+}
+
+func TestEnrichArticleList_Anonymous(t *testing.T) {
+	service, engagementRepo, _, _, _ := newTestEngagementService()
+
+	articleID := uuid.New()
+	articles := []dto.ArticleListItemResponse{{ID: articleID.String()}}
+
+	engagementRepo.On("GetArticleEngagements", []uuid.UUID{articleID}, (*uuid.UUID)(nil)).Return(map[uuid.UUID]repositories.ArticleEngagement{
+		articleID: {LikesCount: 9},
+	}, nil)
+
+	service.EnrichArticleList(articles, "")
+
+	assert.Equal(t, 9, articles[0].LikesCount)
+	assert.False(t, articles[0].UserLiked)
+}
+
+func TestEnrichArticleList_EmptyPage(t *testing.T) {
+	service, engagementRepo, _, _, _ := newTestEngagementService()
+
+	service.EnrichArticleList(nil, uuid.New().String())
+
+	engagementRepo.AssertNotCalled(t, "GetArticleEngagements", mock.Anything, mock.Anything)
+}
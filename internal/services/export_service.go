@@ -0,0 +1,117 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/config"
+	"github.com/alfafaa/alfafaa-blog/internal/exporter"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// exportBatchSize is the number of articles loaded at a time while exporting
+const exportBatchSize = 100
+
+// ExportService defines the interface for article exports
+type ExportService interface {
+	ExportAuthorArticles(authorID string, requesterID string, isAdmin bool) (*ArticleExport, error)
+	ExportAllArticles() *ArticleExport
+}
+
+// ArticleExport is an export that passed its checks and is ready to be
+// streamed. Splitting the checks from the writing lets handlers report errors
+// before any of the archive has been sent.
+type ArticleExport struct {
+	Filename    string
+	authorID    *uuid.UUID
+	articleRepo repositories.ArticleRepository
+	uploadPath  string
+}
+
+type exportService struct {
+	articleRepo  repositories.ArticleRepository
+	userRepo     repositories.UserRepository
+	uploadConfig config.UploadConfig
+}
+
+// NewExportService creates a new export service
+func NewExportService(articleRepo repositories.ArticleRepository, userRepo repositories.UserRepository, uploadConfig config.UploadConfig) ExportService {
+	return &exportService{
+		articleRepo:  articleRepo,
+		userRepo:     userRepo,
+		uploadConfig: uploadConfig,
+	}
+}
+
+// ExportAuthorArticles prepares an export of all of an author's articles,
+// including drafts. Only the author and admins may export them.
+func (s *exportService) ExportAuthorArticles(authorID string, requesterID string, isAdmin bool) (*ArticleExport, error) {
+	authorUUID, err := uuid.Parse(authorID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	if authorID != requesterID && !isAdmin {
+		return nil, utils.ErrForbidden
+	}
+
+	author, err := s.userRepo.FindByID(authorUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, utils.WrapError(err, "failed to find user")
+	}
+
+	return &ArticleExport{
+		Filename:    fmt.Sprintf("%s-articles-%s.zip", author.Username, time.Now().Format("2006-01-02")),
+		authorID:    &author.ID,
+		articleRepo: s.articleRepo,
+		uploadPath:  s.uploadConfig.Path,
+	}, nil
+}
+
+// ExportAllArticles prepares an export of every article on the site
+func (s *exportService) ExportAllArticles() *ArticleExport {
+	return &ArticleExport{
+		Filename:    fmt.Sprintf("articles-%s.zip", time.Now().Format("2006-01-02")),
+		articleRepo: s.articleRepo,
+		uploadPath:  s.uploadConfig.Path,
+	}
+}
+
+// Write streams the export to w as a zip archive, loading the articles in
+// batches so that large sites are never held in memory
+func (e *ArticleExport) Write(w io.Writer) error {
+	writer := exporter.NewWriter(w, e.uploadPath)
+
+	for offset := 0; ; offset += exportBatchSize {
+		articles, _, err := e.articleRepo.FindAll(repositories.ArticleFilters{
+			AuthorID:        e.authorID,
+			AllVisibilities: true,
+			Sort:            "oldest",
+			Limit:           exportBatchSize,
+			Offset:          offset,
+		})
+		if err != nil {
+			return utils.WrapError(err, "failed to fetch articles")
+		}
+
+		for i := range articles {
+			if err := writer.WriteArticle(&articles[i]); err != nil {
+				return utils.WrapError(err, "failed to export article "+articles[i].Slug)
+			}
+		}
+
+		if len(articles) < exportBatchSize {
+			break
+		}
+	}
+
+	return writer.Close()
+}
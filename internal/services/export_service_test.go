@@ -0,0 +1,80 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/alfafaa/alfafaa-blog/internal/config"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ExportServiceTestSuite struct {
+	suite.Suite
+	articleRepo *mocks.MockArticleRepository
+	userRepo    *mocks.MockUserRepository
+	service     ExportService
+}
+
+func (suite *ExportServiceTestSuite) SetupTest() {
+	suite.articleRepo = new(mocks.MockArticleRepository)
+	suite.userRepo = new(mocks.MockUserRepository)
+	suite.service = NewExportService(suite.articleRepo, suite.userRepo, config.UploadConfig{Path: suite.T().TempDir()})
+}
+
+func TestExportServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ExportServiceTestSuite))
+}
+
+func (suite *ExportServiceTestSuite) TestExportAuthorArticles_Forbidden() {
+	export, err := suite.service.ExportAuthorArticles(uuid.New().String(), uuid.New().String(), false)
+
+	assert.Nil(suite.T(), export)
+	assert.Equal(suite.T(), utils.ErrForbidden, err)
+}
+
+func (suite *ExportServiceTestSuite) TestExportAuthorArticles_NotFound() {
+	authorID := uuid.New()
+	suite.userRepo.On("FindByID", authorID).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := suite.service.ExportAuthorArticles(authorID.String(), uuid.New().String(), true)
+
+	assert.Equal(suite.T(), utils.ErrNotFound, err)
+}
+
+func (suite *ExportServiceTestSuite) TestExportAuthorArticles_WritesBatches() {
+	author := &models.User{ID: uuid.New(), Username: "jane"}
+	suite.userRepo.On("FindByID", author.ID).Return(author, nil)
+
+	firstBatch := make([]models.Article, exportBatchSize)
+	for i := range firstBatch {
+		firstBatch[i] = models.Article{ID: uuid.New(), Title: "Post", Slug: fmt.Sprintf("post-%d", i), AuthorID: author.ID, Author: author}
+	}
+	lastBatch := []models.Article{{ID: uuid.New(), Title: "Last", Slug: "last", AuthorID: author.ID, Author: author}}
+
+	suite.articleRepo.On("FindAll", repositories.ArticleFilters{AuthorID: &author.ID, AllVisibilities: true, Sort: "oldest", Limit: exportBatchSize}).
+		Return(firstBatch, int64(exportBatchSize+1), nil)
+	suite.articleRepo.On("FindAll", repositories.ArticleFilters{AuthorID: &author.ID, AllVisibilities: true, Sort: "oldest", Limit: exportBatchSize, Offset: exportBatchSize}).
+		Return(lastBatch, int64(exportBatchSize+1), nil)
+
+	export, err := suite.service.ExportAuthorArticles(author.ID.String(), author.ID.String(), false)
+	require.NoError(suite.T(), err)
+	assert.Contains(suite.T(), export.Filename, "jane-articles-")
+
+	var buf bytes.Buffer
+	require.NoError(suite.T(), export.Write(&buf))
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), reader.File, exportBatchSize+1)
+	assert.Equal(suite.T(), "articles/last.md", reader.File[exportBatchSize].Name)
+}
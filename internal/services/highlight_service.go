@@ -0,0 +1,250 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// popularHighlightsLimit is how many passages are listed as an article's top highlights
+const popularHighlightsLimit = 10
+
+// highlightMilestones are the reader counts at which an author is told a passage is popular
+var highlightMilestones = map[int64]bool{5: true, 25: true, 100: true}
+
+// HighlightService defines the interface for highlight operations
+type HighlightService interface {
+	CreateHighlight(userID, slug string, req *dto.CreateHighlightRequest) (*dto.HighlightResponse, error)
+	GetMyHighlights(userID string, query *dto.HighlightQuery) ([]dto.HighlightResponse, int64, error)
+	GetPopularHighlights(userID, slug string) ([]dto.PopularHighlightResponse, error)
+	DeleteHighlight(userID, highlightID string) error
+}
+
+type highlightService struct {
+	highlightRepo  repositories.HighlightRepository
+	articleRepo    repositories.ArticleRepository
+	engagementRepo repositories.EngagementRepository
+	userRepo       repositories.UserRepository
+}
+
+// NewHighlightService creates a new highlight service
+func NewHighlightService(
+	highlightRepo repositories.HighlightRepository,
+	articleRepo repositories.ArticleRepository,
+	engagementRepo repositories.EngagementRepository,
+	userRepo repositories.UserRepository,
+) HighlightService {
+	return &highlightService{
+		highlightRepo:  highlightRepo,
+		articleRepo:    articleRepo,
+		engagementRepo: engagementRepo,
+		userRepo:       userRepo,
+	}
+}
+
+// CreateHighlight highlights a passage of an article the user may read.
+// The quote must appear in the article text; whitespace differences are ignored.
+func (s *highlightService) CreateHighlight(userID, slug string, req *dto.CreateHighlightRequest) (*dto.HighlightResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	article, err := s.findReadable(slug, &userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := strings.TrimSpace(req.Quote)
+	if !strings.Contains(compactText(articleText(article)), compactText(quote)) {
+		return nil, utils.NewAppError("QUOTE_NOT_FOUND", "The highlighted text was not found in the article", 400)
+	}
+
+	highlight := &models.Highlight{
+		UserID:    userUUID,
+		ArticleID: article.ID,
+		Quote:     quote,
+		Prefix:    req.Prefix,
+		Suffix:    req.Suffix,
+		Note:      strings.TrimSpace(req.Note),
+		IsPublic:  req.IsPublic,
+	}
+
+	// Readers are counted before and after, so a reader highlighting the same
+	// passage twice doesn't count towards a milestone again
+	var readersBefore int64
+	notify := highlight.IsPublic && article.AuthorID != userUUID
+	if notify {
+		readersBefore, _ = s.highlightRepo.CountPublicReaders(article.ID, quote)
+	}
+
+	if err := s.highlightRepo.Create(highlight); err != nil {
+		return nil, utils.WrapError(err, "failed to create highlight")
+	}
+
+	if notify {
+		readers, err := s.highlightRepo.CountPublicReaders(article.ID, quote)
+		if err == nil && readers > readersBefore && highlightMilestones[readers] {
+			notification := &models.Notification{
+				UserID:    article.AuthorID,
+				ActorID:   userUUID,
+				Type:      models.NotificationTypeHighlight,
+				Message:   fmt.Sprintf("%d readers highlighted the same passage in \"%s\"", readers, article.Title),
+				ArticleID: &article.ID,
+			}
+			_ = s.engagementRepo.CreateNotification(notification)
+		}
+	}
+
+	response := toHighlightResponse(highlight)
+	return &response, nil
+}
+
+// GetMyHighlights lists the user's highlights, newest first, optionally for one article
+func (s *highlightService) GetMyHighlights(userID string, query *dto.HighlightQuery) ([]dto.HighlightResponse, int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, 0, utils.ErrBadRequest
+	}
+
+	var articleID *uuid.UUID
+	if query.ArticleSlug != "" {
+		article, err := s.articleRepo.FindBySlug(query.ArticleSlug)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, 0, utils.ErrNotFound
+			}
+			return nil, 0, utils.WrapError(err, "failed to find article")
+		}
+		articleID = &article.ID
+	}
+
+	highlights, total, err := s.highlightRepo.FindByUser(userUUID, articleID, query.GetPerPage(), query.GetOffset())
+	if err != nil {
+		return nil, 0, utils.WrapError(err, "failed to fetch highlights")
+	}
+
+	responses := make([]dto.HighlightResponse, len(highlights))
+	for i := range highlights {
+		responses[i] = toHighlightResponse(&highlights[i])
+	}
+
+	return responses, total, nil
+}
+
+// GetPopularHighlights lists the passages of an article highlighted publicly by the most readers
+func (s *highlightService) GetPopularHighlights(userID, slug string) ([]dto.PopularHighlightResponse, error) {
+	article, err := s.findReadable(slug, optionalUserID(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	popular, err := s.highlightRepo.FindPopular(article.ID, popularHighlightsLimit)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch highlights")
+	}
+
+	responses := make([]dto.PopularHighlightResponse, len(popular))
+	for i, p := range popular {
+		responses[i] = dto.PopularHighlightResponse{
+			Quote:   p.Quote,
+			Prefix:  p.Prefix,
+			Suffix:  p.Suffix,
+			Readers: p.Readers,
+		}
+	}
+
+	return responses, nil
+}
+
+// DeleteHighlight deletes one of the user's highlights
+func (s *highlightService) DeleteHighlight(userID, highlightID string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	highlightUUID, err := uuid.Parse(highlightID)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	highlight, err := s.highlightRepo.FindByID(highlightUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrNotFound
+		}
+		return utils.WrapError(err, "failed to find highlight")
+	}
+
+	if highlight.UserID != userUUID {
+		return utils.ErrForbidden
+	}
+
+	if err := s.highlightRepo.Delete(highlightUUID); err != nil {
+		return utils.WrapError(err, "failed to delete highlight")
+	}
+
+	return nil
+}
+
+// findReadable finds an article by slug whose full text the user may read.
+// Highlights quote the article verbatim, so anonymous readers, who only get a
+// preview of members-only articles, can't see their highlights either.
+func (s *highlightService) findReadable(slug string, userID *uuid.UUID) (*models.Article, error) {
+	article, err := findReadableArticle(s.articleRepo, s.userRepo, slug, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID == nil && article.Visibility == models.VisibilityMembers {
+		return nil, utils.ErrNotFound
+	}
+
+	return article, nil
+}
+
+// articleText returns the plain text of an article
+func articleText(article *models.Article) string {
+	content := article.ContentHTML
+	if content == "" {
+		content = article.Content
+	}
+	return html.UnescapeString(utils.SanitizeStrict(content))
+}
+
+// compactText removes all whitespace, so text matches regardless of line breaks
+// and of how the client joined block elements
+func compactText(text string) string {
+	return strings.Join(strings.Fields(text), "")
+}
+
+// toHighlightResponse converts a highlight to a response DTO
+func toHighlightResponse(highlight *models.Highlight) dto.HighlightResponse {
+	response := dto.HighlightResponse{
+		ID:        highlight.ID.String(),
+		ArticleID: highlight.ArticleID.String(),
+		Quote:     highlight.Quote,
+		Prefix:    highlight.Prefix,
+		Suffix:    highlight.Suffix,
+		Note:      highlight.Note,
+		IsPublic:  highlight.IsPublic,
+		CreatedAt: highlight.CreatedAt,
+	}
+	if highlight.Article != nil {
+		response.Article = &dto.HighlightArticleResponse{
+			ID:    highlight.Article.ID.String(),
+			Slug:  highlight.Article.Slug,
+			Title: highlight.Article.Title,
+		}
+	}
+	return response
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// helper to create highlight service with mocks
+func newTestHighlightService() (HighlightService, *mocks.MockHighlightRepository, *mocks.MockArticleRepository, *mocks.MockEngagementRepository, *mocks.MockUserRepository) {
+	highlightRepo := new(mocks.MockHighlightRepository)
+	articleRepo := new(mocks.MockArticleRepository)
+	engagementRepo := new(mocks.MockEngagementRepository)
+	userRepo := new(mocks.MockUserRepository)
+
+	service := NewHighlightService(highlightRepo, articleRepo, engagementRepo, userRepo)
+	return service, highlightRepo, articleRepo, engagementRepo, userRepo
+}
+
+func highlightArticle() *models.Article {
+	return &models.Article{
+		ID:          uuid.New(),
+		Slug:        "test-article",
+		Title:       "Test Article",
+		AuthorID:    uuid.New(),
+		Status:      models.StatusPublished,
+		ContentHTML: "<p>The first paragraph.</p><p>A line worth   keeping &amp; sharing.</p>",
+	}
+}
+
+func TestCreateHighlight_Private(t *testing.T) {
+	service, highlightRepo, articleRepo, engagementRepo, _ := newTestHighlightService()
+
+	userID := uuid.New()
+	article := highlightArticle()
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	highlightRepo.On("Create", mock.MatchedBy(func(h *models.Highlight) bool {
+		return h.UserID == userID && h.ArticleID == article.ID && !h.IsPublic && h.Note == "Remember this"
+	})).Return(nil)
+
+	result, err := service.CreateHighlight(userID.String(), "test-article", &dto.CreateHighlightRequest{
+		Quote:  "A line worth keeping & sharing.",
+		Prefix: "first paragraph.",
+		Note:   " Remember this ",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "A line worth keeping & sharing.", result.Quote)
+	highlightRepo.AssertNotCalled(t, "CountPublicReaders", mock.Anything, mock.Anything)
+	engagementRepo.AssertNotCalled(t, "CreateNotification", mock.Anything)
+}
+
+func TestCreateHighlight_QuoteAcrossParagraphs(t *testing.T) {
+	service, highlightRepo, articleRepo, _, _ := newTestHighlightService()
+
+	articleRepo.On("FindBySlug", "test-article").Return(highlightArticle(), nil)
+	highlightRepo.On("Create", mock.AnythingOfType("*models.Highlight")).Return(nil)
+
+	_, err := service.CreateHighlight(uuid.New().String(), "test-article", &dto.CreateHighlightRequest{Quote: "paragraph.\nA line"})
+
+	assert.NoError(t, err)
+}
+
+func TestCreateHighlight_QuoteNotFound(t *testing.T) {
+	service, highlightRepo, articleRepo, _, _ := newTestHighlightService()
+
+	articleRepo.On("FindBySlug", "test-article").Return(highlightArticle(), nil)
+
+	_, err := service.CreateHighlight(uuid.New().String(), "test-article", &dto.CreateHighlightRequest{Quote: "not in the article"})
+
+	appErr, ok := utils.IsAppError(err)
+	assert.True(t, ok)
+	assert.Equal(t, "QUOTE_NOT_FOUND", appErr.Code)
+	highlightRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCreateHighlight_DraftNotFound(t *testing.T) {
+	service, _, articleRepo, _, _ := newTestHighlightService()
+
+	article := highlightArticle()
+	article.Status = models.StatusDraft
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+
+	_, err := service.CreateHighlight(uuid.New().String(), "test-article", &dto.CreateHighlightRequest{Quote: "first paragraph"})
+
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+}
+
+func TestCreateHighlight_FollowersOnly(t *testing.T) {
+	service, highlightRepo, articleRepo, _, userRepo := newTestHighlightService()
+
+	follower, stranger := uuid.New(), uuid.New()
+	article := highlightArticle()
+	article.Visibility = models.VisibilityFollowers
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	userRepo.On("IsFollowing", follower, article.AuthorID).Return(true, nil)
+	userRepo.On("IsFollowing", stranger, article.AuthorID).Return(false, nil)
+	highlightRepo.On("Create", mock.AnythingOfType("*models.Highlight")).Return(nil)
+
+	// Strangers can't probe the text: they get not found, not QUOTE_NOT_FOUND
+	_, err := service.CreateHighlight(stranger.String(), "test-article", &dto.CreateHighlightRequest{Quote: "not in the article"})
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+
+	_, err = service.CreateHighlight(follower.String(), "test-article", &dto.CreateHighlightRequest{Quote: "first paragraph"})
+	assert.NoError(t, err)
+	highlightRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestCreateHighlight_NotifiesAuthorAtMilestone(t *testing.T) {
+	service, highlightRepo, articleRepo, engagementRepo, _ := newTestHighlightService()
+
+	userID := uuid.New()
+	article := highlightArticle()
+	quote := "The first paragraph."
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	highlightRepo.On("CountPublicReaders", article.ID, quote).Return(int64(4), nil).Once()
+	highlightRepo.On("Create", mock.AnythingOfType("*models.Highlight")).Return(nil)
+	highlightRepo.On("CountPublicReaders", article.ID, quote).Return(int64(5), nil).Once()
+	engagementRepo.On("CreateNotification", mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserID == article.AuthorID && n.ActorID == userID && n.Type == models.NotificationTypeHighlight
+	})).Return(nil)
+
+	_, err := service.CreateHighlight(userID.String(), "test-article", &dto.CreateHighlightRequest{Quote: quote, IsPublic: true})
+
+	assert.NoError(t, err)
+	engagementRepo.AssertExpectations(t)
+}
+
+func TestCreateHighlight_RepeatReaderDoesNotNotify(t *testing.T) {
+	service, highlightRepo, articleRepo, engagementRepo, _ := newTestHighlightService()
+
+	article := highlightArticle()
+	quote := "The first paragraph."
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	highlightRepo.On("CountPublicReaders", article.ID, quote).Return(int64(5), nil)
+	highlightRepo.On("Create", mock.AnythingOfType("*models.Highlight")).Return(nil)
+
+	_, err := service.CreateHighlight(uuid.New().String(), "test-article", &dto.CreateHighlightRequest{Quote: quote, IsPublic: true})
+
+	assert.NoError(t, err)
+	engagementRepo.AssertNotCalled(t, "CreateNotification", mock.Anything)
+}
+
+func TestGetPopularHighlights_FollowersOnly(t *testing.T) {
+	service, highlightRepo, articleRepo, _, userRepo := newTestHighlightService()
+
+	follower := uuid.New()
+	article := highlightArticle()
+	article.Visibility = models.VisibilityFollowers
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	userRepo.On("IsFollowing", follower, article.AuthorID).Return(true, nil)
+	highlightRepo.On("FindPopular", article.ID, popularHighlightsLimit).Return([]repositories.PopularHighlight{
+		{Quote: "The first paragraph.", Readers: 3},
+	}, nil)
+
+	_, err := service.GetPopularHighlights("", "test-article")
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+
+	results, err := service.GetPopularHighlights(follower.String(), "test-article")
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	highlightRepo.AssertNumberOfCalls(t, "FindPopular", 1)
+}
+
+func TestGetPopularHighlights_MembersOnly(t *testing.T) {
+	service, highlightRepo, articleRepo, _, _ := newTestHighlightService()
+
+	article := highlightArticle()
+	article.Visibility = models.VisibilityMembers
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	highlightRepo.On("FindPopular", article.ID, popularHighlightsLimit).Return([]repositories.PopularHighlight{}, nil)
+
+	// Anonymous readers only get a preview, so the passages stay hidden
+	_, err := service.GetPopularHighlights("", "test-article")
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+
+	_, err = service.GetPopularHighlights(uuid.New().String(), "test-article")
+	assert.NoError(t, err)
+	highlightRepo.AssertNumberOfCalls(t, "FindPopular", 1)
+}
+
+func TestGetMyHighlights_ForArticle(t *testing.T) {
+	service, highlightRepo, articleRepo, _, _ := newTestHighlightService()
+
+	userID := uuid.New()
+	article := highlightArticle()
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	highlightRepo.On("FindByUser", userID, &article.ID, 20, 0).Return([]models.Highlight{
+		{ID: uuid.New(), UserID: userID, ArticleID: article.ID, Quote: "The first paragraph.", Article: article},
+	}, int64(1), nil)
+
+	results, total, err := service.GetMyHighlights(userID.String(), &dto.HighlightQuery{ArticleSlug: "test-article"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "test-article", results[0].Article.Slug)
+}
+
+func TestDeleteHighlight_Forbidden(t *testing.T) {
+	service, highlightRepo, _, _, _ := newTestHighlightService()
+
+	highlightID := uuid.New()
+	highlightRepo.On("FindByID", highlightID).Return(&models.Highlight{ID: highlightID, UserID: uuid.New()}, nil)
+
+	err := service.DeleteHighlight(uuid.New().String(), highlightID.String())
+
+	assert.ErrorIs(t, err, utils.ErrForbidden)
+	highlightRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
@@ -0,0 +1,605 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/cache"
+	"github.com/alfafaa/alfafaa-blog/internal/config"
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/importer"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ImportService defines the interface for bulk imports from other blogs
+type ImportService interface {
+	Import(filename string, r io.ReaderAt, size int64, userID string, dryRun bool) (*dto.ImportReport, error)
+}
+
+type importService struct {
+	db           *gorm.DB
+	articleRepo  repositories.ArticleRepository
+	categoryRepo repositories.CategoryRepository
+	tagRepo      repositories.TagRepository
+	userRepo     repositories.UserRepository
+	mediaRepo    repositories.MediaRepository
+	importRepo   repositories.ImportRecordRepository
+	uploadConfig config.UploadConfig
+	readCache    *cache.ReadCache
+}
+
+// NewImportService creates a new import service
+func NewImportService(
+	db *gorm.DB,
+	articleRepo repositories.ArticleRepository,
+	categoryRepo repositories.CategoryRepository,
+	tagRepo repositories.TagRepository,
+	userRepo repositories.UserRepository,
+	mediaRepo repositories.MediaRepository,
+	importRepo repositories.ImportRecordRepository,
+	uploadConfig config.UploadConfig,
+	opts ...ImportServiceOption,
+) ImportService {
+	svc := &importService{
+		db:           db,
+		articleRepo:  articleRepo,
+		categoryRepo: categoryRepo,
+		tagRepo:      tagRepo,
+		userRepo:     userRepo,
+		mediaRepo:    mediaRepo,
+		importRepo:   importRepo,
+		uploadConfig: uploadConfig,
+	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// ImportServiceOption is a functional option for configuring the import service
+type ImportServiceOption func(*importService)
+
+// WithImportReadCache drops cached reads after an import has created
+// articles, categories or tags
+func WithImportReadCache(readCache *cache.ReadCache) ImportServiceOption {
+	return func(s *importService) {
+		s.readCache = readCache
+	}
+}
+
+// importRun holds the state of a single import, so that authors, terms and
+// media shared by several posts are resolved only once
+type importRun struct {
+	*importService
+	archive    *importer.Archive
+	report     *dto.ImportReport
+	dryRun     bool
+	importer   *models.User
+	authors    map[string]*models.User
+	categories map[string]*models.Category
+	tags       map[string]*models.Tag
+	media      map[string]string // Archive path -> public URL
+	slugs      map[string]bool   // Slugs taken by earlier posts in this run
+	warned     map[string]bool
+}
+
+// Import imports the posts of a WordPress WXR export or a zip archive of
+// Markdown files of the given size. Posts imported by an earlier run are skipped, so an import
+// can safely be repeated. In a dry run nothing is written and the report
+// describes what would be imported.
+func (s *importService) Import(filename string, r io.ReaderAt, size int64, userID string, dryRun bool) (*dto.ImportReport, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	user, err := s.userRepo.FindByID(userUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, utils.WrapError(err, "failed to find user")
+	}
+
+	archive, err := importer.Parse(filename, r, size)
+	if err != nil {
+		return nil, utils.NewAppError("INVALID_IMPORT_FILE", err.Error(), 400)
+	}
+
+	run := &importRun{
+		importService: s,
+		archive:       archive,
+		dryRun:        dryRun,
+		importer:      user,
+		authors:       make(map[string]*models.User),
+		categories:    make(map[string]*models.Category),
+		tags:          make(map[string]*models.Tag),
+		media:         make(map[string]string),
+		slugs:         make(map[string]bool),
+		warned:        make(map[string]bool),
+		report: &dto.ImportReport{
+			DryRun:        dryRun,
+			Source:        archive.Source,
+			Articles:      []dto.ImportItemResult{},
+			Authors:       []dto.ImportAuthorMapping{},
+			NewCategories: []string{},
+			NewTags:       []string{},
+			Warnings:      []string{},
+		},
+	}
+
+	for _, skipped := range archive.Skipped {
+		run.warn("Skipped " + skipped)
+	}
+
+	for i := range archive.Posts {
+		result := run.importPost(&archive.Posts[i])
+		switch result.Action {
+		case dto.ImportActionCreate:
+			run.report.Created++
+		case dto.ImportActionSkip:
+			run.report.Skipped++
+		case dto.ImportActionError:
+			run.report.Failed++
+		}
+		run.report.Articles = append(run.report.Articles, result)
+	}
+
+	if !dryRun && run.report.Created > 0 {
+		s.readCache.Invalidate(cache.ArticleChanged)
+	}
+
+	return run.report, nil
+}
+
+// importPost imports a single post. Errors are reported on the post so that
+// one bad post does not abort the whole import.
+func (r *importRun) importPost(post *importer.Post) dto.ImportItemResult {
+	result := dto.ImportItemResult{
+		SourceID: post.SourceID,
+		Title:    post.Title,
+		Status:   post.Status,
+		Action:   dto.ImportActionCreate,
+	}
+
+	record, err := r.importRepo.Find(models.ImportEntityArticle, post.SourceID)
+	if err == nil {
+		result.Action = dto.ImportActionSkip
+		result.ArticleID = record.EntityID.String()
+		result.Message = "Already imported"
+		return result
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return importFailed(result, utils.WrapError(err, "failed to check import records"))
+	}
+
+	article, err := r.buildArticle(post, &result)
+	if err != nil {
+		return importFailed(result, err)
+	}
+
+	if r.dryRun {
+		return result
+	}
+
+	if err := r.saveArticle(post, article); err != nil {
+		return importFailed(result, utils.WrapError(err, "failed to create article"))
+	}
+
+	result.ArticleID = article.ID.String()
+	return result
+}
+
+// buildArticle maps a post onto an article, resolving its author, terms,
+// slug and media
+func (r *importRun) buildArticle(post *importer.Post, result *dto.ImportItemResult) (*models.Article, error) {
+	if strings.TrimSpace(post.Title) == "" {
+		return nil, utils.NewAppError("MISSING_TITLE", "Post has no title", 400)
+	}
+
+	author := r.author(post.Author)
+
+	categories, err := r.resolveCategories(post.Categories)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := r.resolveTags(post.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	slug, err := r.uniqueSlug(post)
+	if err != nil {
+		return nil, err
+	}
+	result.Slug = slug
+
+	locale, err := utils.NormalizeLocale(post.Locale)
+	if err != nil {
+		r.warn(fmt.Sprintf("Locale %q is not valid; %q is used instead", post.Locale, utils.DefaultLocale))
+		locale = utils.DefaultLocale
+	}
+
+	content, featuredImage, mediaCount, err := r.importMedia(post, author)
+	if err != nil {
+		return nil, err
+	}
+	result.Media = mediaCount
+
+	contentFormat := models.ContentFormatHTML
+	if models.ContentFormat(post.Format) == models.ContentFormatMarkdown {
+		contentFormat = models.ContentFormatMarkdown
+	}
+
+	status := models.StatusDraft
+	if post.Status == importer.StatusPublished {
+		status = models.StatusPublished
+	}
+
+	article := &models.Article{
+		Title:           post.Title,
+		Slug:            slug,
+		Content:         content,
+		ContentFormat:   contentFormat,
+		Excerpt:         post.Excerpt,
+		AuthorID:        author.ID,
+		Status:          status,
+		MetaTitle:       post.MetaTitle,
+		MetaDescription: post.MetaDescription,
+		MetaKeywords:    post.MetaKeywords,
+		Locale:          locale,
+		Categories:      categories,
+		Tags:            tags,
+	}
+	if featuredImage != "" {
+		article.FeaturedImageURL = &featuredImage
+	}
+
+	// Keep the original dates; posts published without a date go live now
+	if post.PublishedAt != nil {
+		article.CreatedAt = *post.PublishedAt
+		article.UpdatedAt = *post.PublishedAt
+	}
+	if status == models.StatusPublished {
+		publishedAt := time.Now()
+		if post.PublishedAt != nil {
+			publishedAt = *post.PublishedAt
+		}
+		article.PublishedAt = &publishedAt
+	}
+
+	if err := renderContent(article); err != nil {
+		return nil, utils.WrapError(err, "failed to render content")
+	}
+
+	return article, nil
+}
+
+// saveArticle creates the article and records it as imported in one transaction
+func (r *importRun) saveArticle(post *importer.Post, article *models.Article) error {
+	save := func(articleRepo repositories.ArticleRepository, tagRepo repositories.TagRepository, importRepo repositories.ImportRecordRepository) error {
+		if err := articleRepo.Create(article); err != nil {
+			return err
+		}
+		for _, tag := range article.Tags {
+			if err := tagRepo.IncrementUsage(tag.ID); err != nil {
+				return err
+			}
+		}
+		return importRepo.Create(models.ImportEntityArticle, post.SourceID, article.ID)
+	}
+
+	if r.db != nil {
+		return r.db.Transaction(func(tx *gorm.DB) error {
+			return save(r.articleRepo.WithTx(tx), r.tagRepo.WithTx(tx), r.importRepo.WithTx(tx))
+		})
+	}
+
+	// Fallback for unit tests without db - run without transaction
+	return save(r.articleRepo, r.tagRepo, r.importRepo)
+}
+
+// author maps an author from the export to a user, matching by email and then
+// by username. Unknown authors are attributed to the user running the import.
+func (r *importRun) author(author importer.Author) *models.User {
+	key := author.Key()
+	if user, ok := r.authors[key]; ok {
+		return user
+	}
+
+	var user *models.User
+	if author.Email != "" {
+		if found, err := r.userRepo.FindByEmail(author.Email); err == nil {
+			user = found
+		}
+	}
+	if user == nil && author.Login != "" {
+		if found, err := r.userRepo.FindByUsername(author.Login); err == nil {
+			user = found
+		}
+	}
+
+	mapping := dto.ImportAuthorMapping{Source: key, Matched: user != nil}
+	if user == nil {
+		user = r.importer
+		if key != "" {
+			r.warn(fmt.Sprintf("No user matches author %q; their posts are attributed to %s", key, user.Username))
+		}
+	}
+	mapping.UserID = user.ID.String()
+	mapping.Username = user.Username
+
+	r.authors[key] = user
+	r.report.Authors = append(r.report.Authors, mapping)
+	return user
+}
+
+// resolveCategories finds categories by slug, creating the missing ones
+func (r *importRun) resolveCategories(names []string) ([]models.Category, error) {
+	var categories []models.Category
+	seen := make(map[string]bool)
+
+	for _, name := range names {
+		slug := utils.GenerateSlug(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+
+		category, ok := r.categories[slug]
+		if !ok {
+			found, err := r.categoryRepo.FindBySlug(slug)
+			switch {
+			case err == nil:
+				category = found
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				category = &models.Category{Name: name, Slug: slug, IsActive: true}
+				if !r.dryRun {
+					if err := r.categoryRepo.Create(category); err != nil {
+						return nil, utils.WrapError(err, "failed to create category "+name)
+					}
+				}
+				r.report.NewCategories = append(r.report.NewCategories, name)
+			default:
+				return nil, utils.WrapError(err, "failed to find category")
+			}
+			r.categories[slug] = category
+		}
+
+		categories = append(categories, *category)
+	}
+
+	return categories, nil
+}
+
+// resolveTags finds tags by slug, creating the missing ones
+func (r *importRun) resolveTags(names []string) ([]models.Tag, error) {
+	var tags []models.Tag
+	seen := make(map[string]bool)
+
+	for _, name := range names {
+		slug := utils.GenerateSlug(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+
+		tag, ok := r.tags[slug]
+		if !ok {
+			found, err := r.tagRepo.FindBySlug(slug)
+			switch {
+			case err == nil:
+				tag = found
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				tag = &models.Tag{Name: name, Slug: slug}
+				if !r.dryRun {
+					if err := r.tagRepo.Create(tag); err != nil {
+						return nil, utils.WrapError(err, "failed to create tag "+name)
+					}
+				}
+				r.report.NewTags = append(r.report.NewTags, name)
+			default:
+				return nil, utils.WrapError(err, "failed to find tag")
+			}
+			r.tags[slug] = tag
+		}
+
+		tags = append(tags, *tag)
+	}
+
+	return tags, nil
+}
+
+// uniqueSlug keeps the post's slug where possible. Slugs already in use get a
+// numeric suffix, like CreateArticle does.
+func (r *importRun) uniqueSlug(post *importer.Post) (string, error) {
+	// WordPress stores non-Latin slugs percent-encoded
+	source := post.Slug
+	if unescaped, err := url.PathUnescape(source); err == nil {
+		source = unescaped
+	}
+
+	base := utils.TruncateSlug(utils.GenerateSlug(source), 200)
+	if base == "" {
+		base = utils.TruncateSlug(utils.GenerateSlug(post.Title), 200)
+	}
+	if base == "" {
+		base = "imported-article"
+	}
+
+	slug := base
+	for i := 1; ; i++ {
+		taken := r.slugs[slug]
+		if !taken {
+			exists, err := r.articleRepo.ExistsBySlug(slug)
+			if err != nil {
+				return "", utils.WrapError(err, "failed to check slug")
+			}
+			taken = exists
+		}
+		if !taken {
+			break
+		}
+		slug = utils.GenerateUniqueSlug(utils.TruncateSlug(base, 190), fmt.Sprintf("%d", i))
+	}
+
+	if slug != base {
+		r.warn(fmt.Sprintf("Slug %q is already taken; %q is imported as %q", base, post.Title, slug))
+	}
+	r.slugs[slug] = true
+	return slug, nil
+}
+
+// importMedia copies the images a post links to into the media library and
+// returns the content and featured image with their links rewritten
+func (r *importRun) importMedia(post *importer.Post, uploader *models.User) (string, string, int, error) {
+	replacements := make(map[string]string)
+	for link, name := range r.archive.LocalFiles(post) {
+		mediaURL, ok, err := r.importFile(name, uploader)
+		if err != nil {
+			return "", "", 0, err
+		}
+		if ok {
+			replacements[link] = mediaURL
+		}
+	}
+
+	featuredImage := post.FeaturedImage
+	if mediaURL, ok := replacements[featuredImage]; ok {
+		featuredImage = mediaURL
+	}
+
+	return importer.RewriteLinks(post.Content, replacements), featuredImage, len(replacements), nil
+}
+
+// importFile stores an archive file in the media library once per run. Files
+// imported by an earlier run are recognized by their content hash and reused.
+// Files that are not supported images are left out with a warning.
+func (r *importRun) importFile(name string, uploader *models.User) (string, bool, error) {
+	if mediaURL, ok := r.media[name]; ok {
+		return mediaURL, true, nil
+	}
+
+	content := r.archive.Files[name]
+	mimeType := http.DetectContentType(content)
+	if !utils.IsValidImageExtension(name) || !utils.IsValidImageType(mimeType) {
+		r.warn(fmt.Sprintf("%s is not a supported image and was not imported", name))
+		return "", false, nil
+	}
+	if r.uploadConfig.MaxSize > 0 && int64(len(content)) > r.uploadConfig.MaxSize {
+		r.warn(fmt.Sprintf("%s is larger than the upload limit and was not imported", name))
+		return "", false, nil
+	}
+
+	sum := sha256.Sum256(content)
+	sourceKey := "media:" + hex.EncodeToString(sum[:])
+
+	record, err := r.importRepo.Find(models.ImportEntityMedia, sourceKey)
+	switch {
+	case err == nil:
+		media, err := r.mediaRepo.FindByID(record.EntityID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				r.warn(fmt.Sprintf("%s was imported before but its media has been deleted", name))
+				return "", false, nil
+			}
+			return "", false, utils.WrapError(err, "failed to find media")
+		}
+		r.media[name] = "/" + media.FilePath
+		return r.media[name], true, nil
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return "", false, utils.WrapError(err, "failed to check import records")
+	}
+
+	if r.dryRun {
+		r.report.MediaImported++
+		r.media[name] = name
+		return name, true, nil
+	}
+
+	media, err := r.saveMedia(name, content, mimeType, sourceKey, uploader)
+	if err != nil {
+		return "", false, err
+	}
+
+	r.report.MediaImported++
+	r.media[name] = "/" + media.FilePath
+	return r.media[name], true, nil
+}
+
+// saveMedia writes a file to the upload directory and creates its media record
+func (r *importRun) saveMedia(name string, content []byte, mimeType, sourceKey string, uploader *models.User) (*models.Media, error) {
+	filename := uuid.New().String() + strings.ToLower(path.Ext(name))
+	filePath := filepath.Join(r.uploadConfig.Path, filename)
+
+	if err := os.MkdirAll(r.uploadConfig.Path, 0755); err != nil {
+		return nil, utils.WrapError(err, "failed to create upload directory")
+	}
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
+		return nil, utils.WrapError(err, "failed to save file")
+	}
+
+	media := &models.Media{
+		Filename:         filename,
+		OriginalFilename: path.Base(name),
+		FilePath:         filePath,
+		FileSize:         int64(len(content)),
+		MimeType:         mimeType,
+		UploadedBy:       uploader.ID,
+	}
+
+	save := func(mediaRepo repositories.MediaRepository, importRepo repositories.ImportRecordRepository) error {
+		if err := mediaRepo.Create(media); err != nil {
+			return err
+		}
+		return importRepo.Create(models.ImportEntityMedia, sourceKey, media.ID)
+	}
+
+	var err error
+	if r.db != nil {
+		err = r.db.Transaction(func(tx *gorm.DB) error {
+			return save(r.mediaRepo.WithTx(tx), r.importRepo.WithTx(tx))
+		})
+	} else {
+		// Fallback for unit tests without db - run without transaction
+		err = save(r.mediaRepo, r.importRepo)
+	}
+	if err != nil {
+		// Clean up file on error
+		os.Remove(filePath)
+		return nil, utils.WrapError(err, "failed to save media record")
+	}
+
+	return media, nil
+}
+
+// warn adds a warning to the report once
+func (r *importRun) warn(message string) {
+	if r.warned[message] {
+		return
+	}
+	r.warned[message] = true
+	r.report.Warnings = append(r.report.Warnings, message)
+}
+
+// importFailed marks a post as failed with the reason
+func importFailed(result dto.ImportItemResult, err error) dto.ImportItemResult {
+	result.Action = dto.ImportActionError
+	result.Message = err.Error()
+	return result
+}
@@ -0,0 +1,202 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/alfafaa/alfafaa-blog/internal/config"
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// testPNG is enough of a PNG file for content sniffing
+const testPNG = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+
+type ImportServiceTestSuite struct {
+	suite.Suite
+	articleRepo  *mocks.MockArticleRepository
+	categoryRepo *mocks.MockCategoryRepository
+	tagRepo      *mocks.MockTagRepository
+	userRepo     *mocks.MockUserRepository
+	mediaRepo    *mocks.MockMediaRepository
+	importRepo   *mocks.MockImportRecordRepository
+	uploadPath   string
+	admin        *models.User
+	service      ImportService
+}
+
+func (suite *ImportServiceTestSuite) SetupTest() {
+	suite.articleRepo = new(mocks.MockArticleRepository)
+	suite.categoryRepo = new(mocks.MockCategoryRepository)
+	suite.tagRepo = new(mocks.MockTagRepository)
+	suite.userRepo = new(mocks.MockUserRepository)
+	suite.mediaRepo = new(mocks.MockMediaRepository)
+	suite.importRepo = new(mocks.MockImportRecordRepository)
+	suite.uploadPath = suite.T().TempDir()
+	suite.admin = &models.User{ID: uuid.New(), Username: "admin", Email: "admin@example.com"}
+	suite.service = NewImportService(nil, suite.articleRepo, suite.categoryRepo, suite.tagRepo,
+		suite.userRepo, suite.mediaRepo, suite.importRepo,
+		config.UploadConfig{Path: suite.uploadPath, MaxSize: 1 << 20},
+	)
+
+	suite.userRepo.On("FindByID", suite.admin.ID).Return(suite.admin, nil)
+}
+
+func TestImportServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportServiceTestSuite))
+}
+
+func importArchive(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func (suite *ImportServiceTestSuite) markdownArchive() *bytes.Reader {
+	return importArchive(suite.T(), map[string]string{
+		"posts/hello.md":         "---\ntitle: Hello World\nslug: hello-world\ndate: 2021-03-04\nauthor: jane\ncategories: [News]\ntags: [Go]\n---\n![photo](images/photo.png)\n",
+		"posts/images/photo.png": testPNG,
+	})
+}
+
+func (suite *ImportServiceTestSuite) expectLookups() {
+	suite.importRepo.On("Find", models.ImportEntityArticle, "markdown:posts/hello.md").Return(nil, gorm.ErrRecordNotFound)
+	suite.importRepo.On("Find", models.ImportEntityMedia, mock.AnythingOfType("string")).Return(nil, gorm.ErrRecordNotFound)
+	suite.userRepo.On("FindByUsername", "jane").Return(nil, gorm.ErrRecordNotFound)
+	suite.categoryRepo.On("FindBySlug", "news").Return(nil, gorm.ErrRecordNotFound)
+	suite.tagRepo.On("FindBySlug", "go").Return(nil, gorm.ErrRecordNotFound)
+	suite.articleRepo.On("ExistsBySlug", "hello-world").Return(false, nil)
+}
+
+func (suite *ImportServiceTestSuite) TestImport_DryRun() {
+	suite.expectLookups()
+
+	archive := suite.markdownArchive()
+	report, err := suite.service.Import("site.zip", archive, archive.Size(), suite.admin.ID.String(), true)
+
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), report.DryRun)
+	assert.Equal(suite.T(), "markdown", report.Source)
+	assert.Equal(suite.T(), 1, report.Created)
+	assert.Equal(suite.T(), 1, report.MediaImported)
+	assert.Equal(suite.T(), []string{"News"}, report.NewCategories)
+	assert.Equal(suite.T(), []string{"Go"}, report.NewTags)
+	require.Len(suite.T(), report.Articles, 1)
+	assert.Equal(suite.T(), "hello-world", report.Articles[0].Slug)
+	assert.Empty(suite.T(), report.Articles[0].ArticleID)
+	require.Len(suite.T(), report.Authors, 1)
+	assert.False(suite.T(), report.Authors[0].Matched)
+	assert.Equal(suite.T(), "admin", report.Authors[0].Username)
+	assert.Len(suite.T(), report.Warnings, 1)
+
+	suite.articleRepo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+	suite.categoryRepo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+	suite.mediaRepo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+	entries, _ := os.ReadDir(suite.uploadPath)
+	assert.Empty(suite.T(), entries)
+}
+
+func (suite *ImportServiceTestSuite) TestImport_CreatesArticleAndMedia() {
+	suite.expectLookups()
+	suite.categoryRepo.On("Create", mock.AnythingOfType("*models.Category")).Return(nil)
+	suite.tagRepo.On("Create", mock.AnythingOfType("*models.Tag")).Return(nil)
+	suite.tagRepo.On("IncrementUsage", mock.AnythingOfType("uuid.UUID")).Return(nil)
+	suite.mediaRepo.On("Create", mock.AnythingOfType("*models.Media")).Return(nil)
+	suite.importRepo.On("Create", models.ImportEntityMedia, mock.AnythingOfType("string"), mock.AnythingOfType("uuid.UUID")).Return(nil)
+	suite.importRepo.On("Create", models.ImportEntityArticle, "markdown:posts/hello.md", mock.AnythingOfType("uuid.UUID")).Return(nil)
+
+	created := &models.Article{}
+	suite.articleRepo.On("Create", mock.AnythingOfType("*models.Article")).Run(func(args mock.Arguments) {
+		article := args.Get(0).(*models.Article)
+		article.ID = uuid.New()
+		*created = *article
+	}).Return(nil)
+
+	archive := suite.markdownArchive()
+	report, err := suite.service.Import("site.zip", archive, archive.Size(), suite.admin.ID.String(), false)
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Created)
+	assert.Equal(suite.T(), 1, report.MediaImported)
+	require.Len(suite.T(), report.Articles, 1)
+	assert.Equal(suite.T(), dto.ImportActionCreate, report.Articles[0].Action)
+	assert.Equal(suite.T(), created.ID.String(), report.Articles[0].ArticleID)
+
+	assert.Equal(suite.T(), "hello-world", created.Slug)
+	assert.Equal(suite.T(), models.StatusPublished, created.Status)
+	assert.Equal(suite.T(), models.ContentFormatMarkdown, created.ContentFormat)
+	assert.Equal(suite.T(), suite.admin.ID, created.AuthorID)
+	assert.Equal(suite.T(), 2021, created.PublishedAt.Year())
+	assert.Len(suite.T(), created.Categories, 1)
+	assert.Len(suite.T(), created.Tags, 1)
+	assert.NotContains(suite.T(), created.Content, "images/photo.png")
+	assert.Contains(suite.T(), created.Content, "/"+suite.uploadPath+"/")
+
+	entries, _ := os.ReadDir(suite.uploadPath)
+	require.Len(suite.T(), entries, 1)
+	assert.True(suite.T(), strings.HasSuffix(entries[0].Name(), ".png"))
+}
+
+func (suite *ImportServiceTestSuite) TestImport_SkipsImportedPosts() {
+	articleID := uuid.New()
+	suite.importRepo.On("Find", models.ImportEntityArticle, "markdown:posts/hello.md").Return(&models.ImportRecord{EntityID: articleID}, nil)
+
+	archive := suite.markdownArchive()
+	report, err := suite.service.Import("site.zip", archive, archive.Size(), suite.admin.ID.String(), false)
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, report.Created)
+	assert.Equal(suite.T(), 1, report.Skipped)
+	assert.Equal(suite.T(), dto.ImportActionSkip, report.Articles[0].Action)
+	assert.Equal(suite.T(), articleID.String(), report.Articles[0].ArticleID)
+	suite.articleRepo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ImportServiceTestSuite) TestImport_SlugConflict() {
+	data := importArchive(suite.T(), map[string]string{
+		"about.md": "---\ntitle: About\nauthor: admin@example.com\n---\ntext",
+	})
+	suite.importRepo.On("Find", models.ImportEntityArticle, "markdown:about.md").Return(nil, gorm.ErrRecordNotFound)
+	suite.userRepo.On("FindByEmail", "admin@example.com").Return(suite.admin, nil)
+	suite.articleRepo.On("ExistsBySlug", "about").Return(true, nil)
+	suite.articleRepo.On("ExistsBySlug", "about-1").Return(false, nil)
+
+	report, err := suite.service.Import("site.zip", data, data.Size(), suite.admin.ID.String(), true)
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "about-1", report.Articles[0].Slug)
+	assert.True(suite.T(), report.Authors[0].Matched)
+	require.Len(suite.T(), report.Warnings, 1)
+	assert.Contains(suite.T(), report.Warnings[0], "about-1")
+}
+
+func (suite *ImportServiceTestSuite) TestImport_InvalidFile() {
+	data := bytes.NewReader([]byte("not xml"))
+	report, err := suite.service.Import("export.xml", data, data.Size(), suite.admin.ID.String(), true)
+
+	assert.Nil(suite.T(), report)
+	appErr, ok := utils.IsAppError(err)
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), "INVALID_IMPORT_FILE", appErr.Code)
+}
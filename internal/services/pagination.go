@@ -0,0 +1,33 @@
+package services
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+)
+
+// errInvalidCursor is returned for a cursor that wasn't made by this listing
+var errInvalidCursor = utils.NewAppError("INVALID_CURSOR", "Invalid pagination cursor", 400)
+
+// queryKeyset decodes the cursor of a list query in the given sort order;
+// listings sorted by time alone pass an empty sort. It returns nil when the
+// query pages by offset.
+func queryKeyset(query *dto.PaginationQuery, sort string) (*repositories.Keyset, error) {
+	if !query.IsKeyset() {
+		return nil, nil
+	}
+	keyset, err := repositories.DecodeKeyset(query.Cursor)
+	if err != nil || !keyset.Fits(sort) {
+		return nil, errInvalidCursor
+	}
+	return keyset, nil
+}
+
+// nextCursor returns the cursor of the page after one of count items that
+// ends at last. A page that isn't full is the last one, and has no cursor.
+func nextCursor(query *dto.PaginationQuery, count int, last func() *repositories.Keyset) string {
+	if count == 0 || count < query.GetPerPage() {
+		return ""
+	}
+	return repositories.EncodeKeyset(last())
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// readProgressThreshold is the scroll position, in percent, at which an article can count as read
+const readProgressThreshold = 90
+
+// readTimeShare is the share of an article's estimated reading time a reader
+// must spend on it for it to count as read, so skimming to the end doesn't
+const readTimeShare = 0.25
+
+// ReadingService defines the interface for reading progress and history
+type ReadingService interface {
+	RecordProgress(userID, slug string, req *dto.ReadingProgressRequest) (*dto.ReadingProgressResponse, error)
+	GetHistory(userID string, query *dto.ReadingHistoryQuery) ([]dto.ReadingProgressResponse, int64, error)
+	DeleteHistoryEntry(userID, id string) error
+	ClearHistory(userID string) error
+}
+
+type readingService struct {
+	readingRepo repositories.ReadingRepository
+	articleRepo repositories.ArticleRepository
+	userRepo    repositories.UserRepository
+}
+
+// NewReadingService creates a new reading service
+func NewReadingService(
+	readingRepo repositories.ReadingRepository,
+	articleRepo repositories.ArticleRepository,
+	userRepo repositories.UserRepository,
+) ReadingService {
+	return &readingService{
+		readingRepo: readingRepo,
+		articleRepo: articleRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// RecordProgress saves how far the user has read an article and adds to their
+// time spent on it. The first time the article is read in full it counts as a
+// read, unless the reader is its author.
+func (s *readingService) RecordProgress(userID, slug string, req *dto.ReadingProgressRequest) (*dto.ReadingProgressResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	article, err := s.findReadable(slug, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.readingRepo.FindProgress(userUUID, article.ID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.WrapError(err, "failed to find reading progress")
+		}
+		progress = &models.ReadingProgress{UserID: userUUID, ArticleID: article.ID}
+	}
+
+	now := time.Now()
+	progress.Progress = req.Progress
+	progress.MaxProgress = max(progress.MaxProgress, req.Progress)
+	progress.TimeSpentSeconds += req.TimeSpentSeconds
+	progress.LastReadAt = now
+
+	finished := progress.CompletedAt == nil &&
+		progress.MaxProgress >= readProgressThreshold &&
+		float64(progress.TimeSpentSeconds) >= float64(article.ReadingTimeMinutes*60)*readTimeShare
+	if finished {
+		progress.CompletedAt = &now
+	}
+
+	if err := s.readingRepo.SaveProgress(progress); err != nil {
+		return nil, utils.WrapError(err, "failed to save reading progress")
+	}
+
+	if finished && article.AuthorID != userUUID {
+		counted, err := s.readingRepo.CountRead(userUUID, article.ID)
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to count read")
+		}
+		if counted {
+			article.ReadCount++
+		}
+	}
+
+	progress.Article = article
+	response := toReadingProgressResponse(progress)
+	return &response, nil
+}
+
+// GetHistory lists the user's reading history, most recently read first
+func (s *readingService) GetHistory(userID string, query *dto.ReadingHistoryQuery) ([]dto.ReadingProgressResponse, int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, 0, utils.ErrBadRequest
+	}
+
+	var completed *bool
+	if query.Status != "" {
+		read := query.Status == "read"
+		completed = &read
+	}
+
+	history, total, err := s.readingRepo.FindHistory(userUUID, completed, query.GetPerPage(), query.GetOffset())
+	if err != nil {
+		return nil, 0, utils.WrapError(err, "failed to fetch reading history")
+	}
+
+	responses := make([]dto.ReadingProgressResponse, len(history))
+	for i := range history {
+		responses[i] = toReadingProgressResponse(&history[i])
+	}
+
+	return responses, total, nil
+}
+
+// DeleteHistoryEntry removes one article from the user's reading history
+func (s *readingService) DeleteHistoryEntry(userID, id string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+	entryID, err := uuid.Parse(id)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	deleted, err := s.readingRepo.DeleteEntry(userUUID, entryID)
+	if err != nil {
+		return utils.WrapError(err, "failed to delete reading history entry")
+	}
+	if !deleted {
+		return utils.ErrNotFound
+	}
+
+	return nil
+}
+
+// ClearHistory removes the user's whole reading history. Read counts are not changed.
+func (s *readingService) ClearHistory(userID string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	if err := s.readingRepo.DeleteHistory(userUUID); err != nil {
+		return utils.WrapError(err, "failed to clear reading history")
+	}
+
+	return nil
+}
+
+// findReadable finds a live article by slug that the user may read
+func (s *readingService) findReadable(slug string, userID uuid.UUID) (*models.Article, error) {
+	return findReadableArticle(s.articleRepo, s.userRepo, slug, &userID)
+}
+
+// toReadingProgressResponse converts a reading progress entry to a response DTO
+func toReadingProgressResponse(progress *models.ReadingProgress) dto.ReadingProgressResponse {
+	response := dto.ReadingProgressResponse{
+		ID:               progress.ID.String(),
+		Progress:         progress.Progress,
+		MaxProgress:      progress.MaxProgress,
+		TimeSpentSeconds: progress.TimeSpentSeconds,
+		IsRead:           progress.CompletedAt != nil,
+		CompletedAt:      progress.CompletedAt,
+		LastReadAt:       progress.LastReadAt,
+	}
+	if progress.Article != nil {
+		article := toArticleListItemResponse(progress.Article)
+		response.Article = &article
+	}
+	return response
+}
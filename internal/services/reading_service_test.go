@@ -0,0 +1,194 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// helper to create reading service with mocks
+func newTestReadingService() (ReadingService, *mocks.MockReadingRepository, *mocks.MockArticleRepository, *mocks.MockUserRepository) {
+	readingRepo := new(mocks.MockReadingRepository)
+	articleRepo := new(mocks.MockArticleRepository)
+	userRepo := new(mocks.MockUserRepository)
+
+	service := NewReadingService(readingRepo, articleRepo, userRepo)
+	return service, readingRepo, articleRepo, userRepo
+}
+
+func readingArticle() *models.Article {
+	publishedAt := time.Now().Add(-time.Hour)
+	return &models.Article{
+		ID:                 uuid.New(),
+		Slug:               "test-article",
+		Title:              "Test Article",
+		AuthorID:           uuid.New(),
+		Status:             models.StatusPublished,
+		Visibility:         models.VisibilityPublic,
+		PublishedAt:        &publishedAt,
+		ReadingTimeMinutes: 8,
+	}
+}
+
+func TestRecordProgress_FirstReport(t *testing.T) {
+	service, readingRepo, articleRepo, _ := newTestReadingService()
+
+	userID := uuid.New()
+	article := readingArticle()
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	readingRepo.On("FindProgress", userID, article.ID).Return(nil, gorm.ErrRecordNotFound)
+	readingRepo.On("SaveProgress", mock.MatchedBy(func(p *models.ReadingProgress) bool {
+		return p.UserID == userID && p.Progress == 30 && p.MaxProgress == 30 && p.TimeSpentSeconds == 45 && p.CompletedAt == nil
+	})).Return(nil)
+
+	result, err := service.RecordProgress(userID.String(), "test-article", &dto.ReadingProgressRequest{Progress: 30, TimeSpentSeconds: 45})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 30, result.Progress)
+	assert.False(t, result.IsRead)
+	assert.Equal(t, "test-article", result.Article.Slug)
+	readingRepo.AssertNotCalled(t, "CountRead", mock.Anything, mock.Anything)
+}
+
+func TestRecordProgress_CountsReadOnce(t *testing.T) {
+	service, readingRepo, articleRepo, _ := newTestReadingService()
+
+	userID := uuid.New()
+	article := readingArticle()
+	existing := &models.ReadingProgress{ID: uuid.New(), UserID: userID, ArticleID: article.ID, Progress: 60, MaxProgress: 60, TimeSpentSeconds: 100}
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	readingRepo.On("FindProgress", userID, article.ID).Return(existing, nil)
+	readingRepo.On("SaveProgress", existing).Return(nil)
+	readingRepo.On("CountRead", userID, article.ID).Return(true, nil).Once()
+
+	// 8 minutes of reading time need 120 seconds spent
+	result, err := service.RecordProgress(userID.String(), "test-article", &dto.ReadingProgressRequest{Progress: 95, TimeSpentSeconds: 20})
+	assert.NoError(t, err)
+	assert.True(t, result.IsRead)
+	assert.Equal(t, 120, result.TimeSpentSeconds)
+
+	// Scrolling back up keeps the article read and moves the resume position
+	result, err = service.RecordProgress(userID.String(), "test-article", &dto.ReadingProgressRequest{Progress: 20, TimeSpentSeconds: 10})
+	assert.NoError(t, err)
+	assert.True(t, result.IsRead)
+	assert.Equal(t, 20, result.Progress)
+	assert.Equal(t, 95, result.MaxProgress)
+	readingRepo.AssertExpectations(t)
+}
+
+func TestRecordProgress_ReadAfterClearedHistory(t *testing.T) {
+	service, readingRepo, articleRepo, _ := newTestReadingService()
+
+	userID := uuid.New()
+	article := readingArticle()
+	article.ReadCount = 4
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	readingRepo.On("FindProgress", userID, article.ID).Return(nil, gorm.ErrRecordNotFound)
+	readingRepo.On("SaveProgress", mock.Anything).Return(nil)
+	readingRepo.On("CountRead", userID, article.ID).Return(false, nil)
+
+	result, err := service.RecordProgress(userID.String(), "test-article", &dto.ReadingProgressRequest{Progress: 100, TimeSpentSeconds: 600})
+
+	assert.NoError(t, err)
+	assert.True(t, result.IsRead)
+	assert.Equal(t, 4, result.Article.ReadCount, "a read counted before the history was cleared isn't counted again")
+}
+
+func TestRecordProgress_SkimmingIsNotARead(t *testing.T) {
+	service, readingRepo, articleRepo, _ := newTestReadingService()
+
+	userID := uuid.New()
+	article := readingArticle()
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	readingRepo.On("FindProgress", userID, article.ID).Return(nil, gorm.ErrRecordNotFound)
+	readingRepo.On("SaveProgress", mock.Anything).Return(nil)
+
+	result, err := service.RecordProgress(userID.String(), "test-article", &dto.ReadingProgressRequest{Progress: 100, TimeSpentSeconds: 15})
+
+	assert.NoError(t, err)
+	assert.False(t, result.IsRead)
+	readingRepo.AssertNotCalled(t, "CountRead", mock.Anything, mock.Anything)
+}
+
+func TestRecordProgress_AuthorReadNotCounted(t *testing.T) {
+	service, readingRepo, articleRepo, _ := newTestReadingService()
+
+	article := readingArticle()
+	articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+	readingRepo.On("FindProgress", article.AuthorID, article.ID).Return(nil, gorm.ErrRecordNotFound)
+	readingRepo.On("SaveProgress", mock.Anything).Return(nil)
+
+	result, err := service.RecordProgress(article.AuthorID.String(), "test-article", &dto.ReadingProgressRequest{Progress: 100, TimeSpentSeconds: 600})
+
+	assert.NoError(t, err)
+	assert.True(t, result.IsRead)
+	readingRepo.AssertNotCalled(t, "CountRead", mock.Anything, mock.Anything)
+}
+
+func TestRecordProgress_Unreadable(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(article *models.Article)
+	}{
+		{"draft", func(a *models.Article) { a.Status = models.StatusDraft }},
+		{"scheduled", func(a *models.Article) {
+			future := time.Now().Add(time.Hour)
+			a.PublishedAt = &future
+		}},
+		{"followers only", func(a *models.Article) { a.Visibility = models.VisibilityFollowers }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, readingRepo, articleRepo, userRepo := newTestReadingService()
+
+			userID := uuid.New()
+			article := readingArticle()
+			tt.modify(article)
+			articleRepo.On("FindBySlug", "test-article").Return(article, nil)
+			userRepo.On("IsFollowing", userID, article.AuthorID).Return(false, nil)
+
+			_, err := service.RecordProgress(userID.String(), "test-article", &dto.ReadingProgressRequest{Progress: 10})
+
+			assert.ErrorIs(t, err, utils.ErrNotFound)
+			readingRepo.AssertNotCalled(t, "SaveProgress", mock.Anything)
+		})
+	}
+}
+
+func TestGetHistory_StatusFilter(t *testing.T) {
+	service, readingRepo, _, _ := newTestReadingService()
+
+	userID := uuid.New()
+	entry := models.ReadingProgress{ID: uuid.New(), Progress: 40, Article: readingArticle()}
+	readingRepo.On("FindHistory", userID, mock.MatchedBy(func(completed *bool) bool {
+		return completed != nil && !*completed
+	}), 20, 0).Return([]models.ReadingProgress{entry}, int64(1), nil)
+
+	result, total, err := service.GetHistory(userID.String(), &dto.ReadingHistoryQuery{Status: "reading"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, 40, result[0].Progress)
+	assert.Equal(t, "test-article", result[0].Article.Slug)
+}
+
+func TestDeleteHistoryEntry_NotFound(t *testing.T) {
+	service, readingRepo, _, _ := newTestReadingService()
+
+	userID := uuid.New()
+	entryID := uuid.New()
+	readingRepo.On("DeleteEntry", userID, entryID).Return(false, nil)
+
+	err := service.DeleteHistoryEntry(userID.String(), entryID.String())
+
+	assert.ErrorIs(t, err, utils.ErrNotFound)
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/related"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+)
+
+// maxRelatedArticles is the most related articles served, and stored, per article
+const maxRelatedArticles = 20
+
+// RelatedService defines the interface for ranking related articles
+type RelatedService interface {
+	RebuildRelated(ctx context.Context) (int, error)
+}
+
+type relatedService struct {
+	relatedRepo repositories.RelatedArticleRepository
+}
+
+// NewRelatedService creates a new related article ranking service
+func NewRelatedService(relatedRepo repositories.RelatedArticleRepository) RelatedService {
+	return &relatedService{
+		relatedRepo: relatedRepo,
+	}
+}
+
+// RebuildRelated scores every live article against the others and replaces
+// the stored suggestions. It returns how many articles got suggestions.
+func (s *relatedService) RebuildRelated(ctx context.Context) (int, error) {
+	articles, err := s.relatedRepo.FindRankingSources()
+	if err != nil {
+		return 0, utils.WrapError(err, "failed to load articles")
+	}
+
+	likes, err := s.relatedRepo.CountLikes()
+	if err != nil {
+		return 0, utils.WrapError(err, "failed to count likes")
+	}
+
+	docs := make([]related.Document, len(articles))
+	for i := range articles {
+		docs[i] = toRelatedDocument(&articles[i], likes)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	ranked := related.Rank(docs, maxRelatedArticles, now)
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	var rows []models.RelatedArticle
+	for articleID, matches := range ranked {
+		for i, match := range matches {
+			rows = append(rows, models.RelatedArticle{
+				ArticleID:  articleID,
+				RelatedID:  match.ID,
+				Rank:       i + 1,
+				Score:      match.Score,
+				ComputedAt: now,
+			})
+		}
+	}
+
+	if err := s.relatedRepo.ReplaceAll(rows); err != nil {
+		return 0, utils.WrapError(err, "failed to save related articles")
+	}
+
+	return len(ranked), nil
+}
+
+// toRelatedDocument converts an article to the ranker's view of it
+func toRelatedDocument(article *models.Article, likes map[uuid.UUID]int) related.Document {
+	doc := related.Document{
+		ID:          article.ID,
+		Language:    utils.BaseLanguage(article.Locale),
+		Title:       article.Title,
+		Text:        articleText(article),
+		TagIDs:      article.GetTagIDs(),
+		CategoryIDs: article.GetCategoryIDs(),
+		Views:       article.ViewCount,
+		Likes:       likes[article.ID],
+		Listed:      article.Visibility == models.VisibilityPublic || article.Visibility == models.VisibilityMembers,
+	}
+	if article.PublishedAt != nil {
+		doc.PublishedAt = *article.PublishedAt
+	}
+	return doc
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRebuildRelated_StoresRankedSuggestions(t *testing.T) {
+	relatedRepo := new(mocks.MockRelatedArticleRepository)
+	service := NewRelatedService(relatedRepo)
+
+	published := time.Now().AddDate(0, 0, -1)
+	tag := models.Tag{ID: uuid.New()}
+	source := models.Article{ID: uuid.New(), Title: "Hiking the Karakoram", ContentHTML: "<p>Trails and peaks.</p>", Locale: "en", Visibility: models.VisibilityPublic, PublishedAt: &published, Tags: []models.Tag{tag}}
+	match := models.Article{ID: uuid.New(), Title: "Karakoram trails", ContentHTML: "<p>More peaks.</p>", Locale: "en", Visibility: models.VisibilityPublic, PublishedAt: &published, Tags: []models.Tag{tag}}
+	unlisted := models.Article{ID: uuid.New(), Title: "Karakoram trails", ContentHTML: "<p>More peaks.</p>", Locale: "en", Visibility: models.VisibilityUnlisted, PublishedAt: &published, Tags: []models.Tag{tag}}
+	unrelated := models.Article{ID: uuid.New(), Title: "Sourdough", ContentHTML: "<p>Bread.</p>", Locale: "en", Visibility: models.VisibilityPublic, PublishedAt: &published}
+
+	relatedRepo.On("FindRankingSources").Return([]models.Article{source, match, unlisted, unrelated}, nil)
+	relatedRepo.On("CountLikes").Return(map[uuid.UUID]int{match.ID: 3}, nil)
+
+	var saved []models.RelatedArticle
+	relatedRepo.On("ReplaceAll", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).([]models.RelatedArticle)
+	}).Return(nil)
+
+	ranked, err := service.RebuildRelated(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, ranked) // source, match and unlisted each get a suggestion
+	bySource := make(map[uuid.UUID][]uuid.UUID)
+	for _, row := range saved {
+		assert.Equal(t, len(bySource[row.ArticleID])+1, row.Rank)
+		bySource[row.ArticleID] = append(bySource[row.ArticleID], row.RelatedID)
+	}
+	assert.Equal(t, []uuid.UUID{match.ID}, bySource[source.ID], "unlisted articles are never suggested")
+	assert.ElementsMatch(t, []uuid.UUID{source.ID, match.ID}, bySource[unlisted.ID])
+	assert.Empty(t, bySource[unrelated.ID])
+}
+
+func TestRebuildRelated_LoadError(t *testing.T) {
+	relatedRepo := new(mocks.MockRelatedArticleRepository)
+	service := NewRelatedService(relatedRepo)
+
+	relatedRepo.On("FindRankingSources").Return(nil, errors.New("db down"))
+
+	_, err := service.RebuildRelated(context.Background())
+
+	assert.Error(t, err)
+	relatedRepo.AssertNotCalled(t, "ReplaceAll", mock.Anything)
+}
@@ -0,0 +1,450 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SeriesService defines the interface for series operations
+type SeriesService interface {
+	CreateSeries(req *dto.CreateSeriesRequest, authorID string) (*dto.SeriesDetailResponse, error)
+	GetSeries(slug string, viewer Viewer) (*dto.SeriesDetailResponse, error)
+	GetSeriesList(query *dto.SeriesListQuery) ([]dto.SeriesResponse, int64, error)
+	UpdateSeries(id string, req *dto.UpdateSeriesRequest, userID string, isEditor bool) (*dto.SeriesDetailResponse, error)
+	DeleteSeries(id string, userID string, isEditor bool) error
+}
+
+type seriesService struct {
+	db          *gorm.DB
+	seriesRepo  repositories.SeriesRepository
+	articleRepo repositories.ArticleRepository
+	userRepo    repositories.UserRepository
+}
+
+// NewSeriesService creates a new series service
+func NewSeriesService(db *gorm.DB, seriesRepo repositories.SeriesRepository, articleRepo repositories.ArticleRepository, userRepo repositories.UserRepository) SeriesService {
+	return &seriesService{
+		db:          db,
+		seriesRepo:  seriesRepo,
+		articleRepo: articleRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// CreateSeries creates a new series owned by the author
+func (s *seriesService) CreateSeries(req *dto.CreateSeriesRequest, authorID string) (*dto.SeriesDetailResponse, error) {
+	authorUUID, err := uuid.Parse(authorID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	slug, err := s.uniqueSlug(req.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	series := &models.Series{
+		Title:         req.Title,
+		Slug:          slug,
+		Description:   req.Description,
+		CoverImageURL: req.CoverImageURL,
+		AuthorID:      authorUUID,
+	}
+
+	articleIDs, err := s.validateParts(series, req.ArticleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.save(series, articleIDs, true); err != nil {
+		return nil, utils.WrapError(err, "failed to create series")
+	}
+
+	created, err := s.seriesRepo.FindByID(series.ID)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch created series")
+	}
+
+	return toSeriesDetailResponse(created, seriesAudience{manager: true}), nil
+}
+
+// GetSeries retrieves a series by slug. Parts are listed as in article listings:
+// unpublished parts only for the series author and editors, followers-only
+// parts only for the author's followers, and unlisted parts not at all.
+func (s *seriesService) GetSeries(slug string, viewer Viewer) (*dto.SeriesDetailResponse, error) {
+	series, err := s.seriesRepo.FindBySlug(slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, utils.WrapError(err, "failed to find series")
+	}
+
+	audience, err := newSeriesAudience(s.userRepo, series, viewer)
+	if err != nil {
+		return nil, err
+	}
+	return toSeriesDetailResponse(series, audience), nil
+}
+
+// GetSeriesList retrieves series with pagination
+func (s *seriesService) GetSeriesList(query *dto.SeriesListQuery) ([]dto.SeriesResponse, int64, error) {
+	filters := repositories.SeriesFilters{
+		Limit:  query.GetPerPage(),
+		Offset: query.GetOffset(),
+	}
+
+	if query.AuthorID != "" {
+		authorID, err := uuid.Parse(query.AuthorID)
+		if err != nil {
+			return nil, 0, utils.NewAppError("INVALID_AUTHOR_ID", "Invalid author ID", 400)
+		}
+		filters.AuthorID = &authorID
+	}
+
+	seriesList, total, err := s.seriesRepo.FindAll(filters)
+	if err != nil {
+		return nil, 0, utils.WrapError(err, "failed to find series")
+	}
+
+	responses := make([]dto.SeriesResponse, len(seriesList))
+	for i := range seriesList {
+		responses[i] = toSeriesDetailResponse(&seriesList[i], seriesAudience{}).SeriesResponse
+	}
+
+	return responses, total, nil
+}
+
+// UpdateSeries updates a series and optionally replaces its parts
+func (s *seriesService) UpdateSeries(id string, req *dto.UpdateSeriesRequest, userID string, isEditor bool) (*dto.SeriesDetailResponse, error) {
+	seriesID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	series, err := s.seriesRepo.FindByID(seriesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, utils.WrapError(err, "failed to find series")
+	}
+
+	// Check permissions
+	if series.AuthorID.String() != userID && !isEditor {
+		return nil, utils.ErrForbidden
+	}
+
+	if req.Title != nil {
+		series.Title = *req.Title
+		// Only regenerate the slug if the title maps to a different one
+		if utils.TruncateSlug(utils.GenerateSlug(*req.Title), 200) != series.Slug {
+			slug, err := s.uniqueSlug(*req.Title)
+			if err != nil {
+				return nil, err
+			}
+			series.Slug = slug
+		}
+	}
+	if req.Description != nil {
+		series.Description = *req.Description
+	}
+	if req.CoverImageURL != nil {
+		series.CoverImageURL = req.CoverImageURL
+	}
+
+	var articleIDs []uuid.UUID
+	replaceParts := req.ArticleIDs != nil
+	if replaceParts {
+		articleIDs, err = s.validateParts(series, req.ArticleIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.save(series, articleIDs, replaceParts); err != nil {
+		return nil, utils.WrapError(err, "failed to update series")
+	}
+
+	updated, err := s.seriesRepo.FindByID(series.ID)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch updated series")
+	}
+
+	return toSeriesDetailResponse(updated, seriesAudience{manager: true}), nil
+}
+
+// DeleteSeries deletes a series; its articles are left untouched
+func (s *seriesService) DeleteSeries(id string, userID string, isEditor bool) error {
+	seriesID, err := uuid.Parse(id)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	series, err := s.seriesRepo.FindByID(seriesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrNotFound
+		}
+		return utils.WrapError(err, "failed to find series")
+	}
+
+	// Check permissions
+	if series.AuthorID.String() != userID && !isEditor {
+		return utils.ErrForbidden
+	}
+
+	if s.db != nil {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			return s.seriesRepo.WithTx(tx).Delete(seriesID)
+		})
+	} else {
+		// Fallback for unit tests without db - run without transaction
+		err = s.seriesRepo.Delete(seriesID)
+	}
+
+	if err != nil {
+		return utils.WrapError(err, "failed to delete series")
+	}
+
+	return nil
+}
+
+// uniqueSlug generates a slug for the title that is not used by any series
+func (s *seriesService) uniqueSlug(title string) (string, error) {
+	base := utils.TruncateSlug(utils.GenerateSlug(title), 200)
+	slug := base
+
+	exists, err := s.seriesRepo.ExistsBySlug(slug)
+	if err != nil {
+		return "", utils.WrapError(err, "failed to check slug")
+	}
+	for i := 1; exists; i++ {
+		slug = utils.GenerateUniqueSlug(utils.TruncateSlug(base, 190), fmt.Sprintf("%d", i))
+		exists, err = s.seriesRepo.ExistsBySlug(slug)
+		if err != nil {
+			return "", utils.WrapError(err, "failed to check slug")
+		}
+	}
+
+	return slug, nil
+}
+
+// validateParts parses the ordered article IDs and checks that every article
+// belongs to the series author and is not already part of another series
+func (s *seriesService) validateParts(series *models.Series, ids []string) ([]uuid.UUID, error) {
+	articleIDs := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+
+	for _, id := range ids {
+		articleID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, utils.NewAppError("INVALID_ARTICLE_ID", "Invalid article ID: "+id, 400)
+		}
+		if seen[articleID] {
+			return nil, utils.NewAppError("DUPLICATE_ARTICLE", "Article listed more than once: "+id, 400)
+		}
+		seen[articleID] = true
+
+		article, err := s.articleRepo.FindByID(articleID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, utils.NewAppError("ARTICLE_NOT_FOUND", "Article not found: "+id, 404)
+			}
+			return nil, utils.WrapError(err, "failed to find article")
+		}
+		if article.AuthorID != series.AuthorID {
+			return nil, utils.NewAppError("ARTICLE_NOT_OWNED", "Only the series author's articles can be added: "+id, 403)
+		}
+
+		existing, err := s.seriesRepo.FindByArticle(articleID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.WrapError(err, "failed to check article series")
+		}
+		if existing != nil && existing.ID != series.ID {
+			return nil, utils.NewAppError("ARTICLE_IN_OTHER_SERIES", "Article already belongs to series: "+existing.Title, 409)
+		}
+
+		articleIDs = append(articleIDs, articleID)
+	}
+
+	return articleIDs, nil
+}
+
+// save persists the series and, when requested, replaces its ordered parts
+func (s *seriesService) save(series *models.Series, articleIDs []uuid.UUID, replaceParts bool) error {
+	isNew := series.ID == uuid.Nil
+
+	persist := func(repo repositories.SeriesRepository) error {
+		if isNew {
+			if err := repo.Create(series); err != nil {
+				return err
+			}
+		} else if err := repo.Update(series); err != nil {
+			return err
+		}
+		if replaceParts {
+			return repo.SetArticles(series.ID, articleIDs)
+		}
+		return nil
+	}
+
+	if s.db != nil {
+		return s.db.Transaction(func(tx *gorm.DB) error {
+			return persist(s.seriesRepo.WithTx(tx))
+		})
+	}
+	// Fallback for unit tests without db - run without transaction
+	return persist(s.seriesRepo)
+}
+
+// seriesAudience describes which parts of a series a viewer may see
+type seriesAudience struct {
+	manager       bool // The series author or an editor, who sees every part
+	followsAuthor bool // Followers-only parts are listed for the author's followers
+}
+
+// newSeriesAudience works out which parts of the series the viewer may see.
+// Every part is written by the series author, so one follow check covers them all.
+func newSeriesAudience(userRepo repositories.UserRepository, series *models.Series, viewer Viewer) (seriesAudience, error) {
+	if viewer.IsEditor || (viewer.UserID != "" && viewer.UserID == series.AuthorID.String()) {
+		return seriesAudience{manager: true}, nil
+	}
+
+	viewerID := optionalUserID(viewer.UserID)
+	if viewerID == nil || userRepo == nil || !hasFollowersOnlyPart(series) {
+		return seriesAudience{}, nil
+	}
+
+	following, err := userRepo.IsFollowing(*viewerID, series.AuthorID)
+	if err != nil {
+		return seriesAudience{}, utils.WrapError(err, "failed to check follow status")
+	}
+	return seriesAudience{followsAuthor: following}, nil
+}
+
+// canSee checks if the part is listed for the audience
+func (a seriesAudience) canSee(article *models.Article) bool {
+	if a.manager {
+		return true
+	}
+	if !article.IsPubliclyVisible() {
+		return false
+	}
+	switch article.Visibility {
+	case models.VisibilityUnlisted:
+		return false
+	case models.VisibilityFollowers:
+		return a.followsAuthor
+	}
+	return true
+}
+
+// hasFollowersOnlyPart checks if any part of the series is followers-only
+func hasFollowersOnlyPart(series *models.Series) bool {
+	for _, part := range series.Parts {
+		if part.Article != nil && part.Article.Visibility == models.VisibilityFollowers {
+			return true
+		}
+	}
+	return false
+}
+
+// toSeriesDetailResponse converts a series model to a detail response DTO
+func toSeriesDetailResponse(series *models.Series, audience seriesAudience) *dto.SeriesDetailResponse {
+	response := &dto.SeriesDetailResponse{
+		SeriesResponse: dto.SeriesResponse{
+			ID:            series.ID.String(),
+			Title:         series.Title,
+			Slug:          series.Slug,
+			Description:   series.Description,
+			CoverImageURL: series.CoverImageURL,
+			CreatedAt:     series.CreatedAt,
+			UpdatedAt:     series.UpdatedAt,
+		},
+		Parts: []dto.SeriesPartResponse{},
+	}
+
+	if series.Author != nil {
+		response.Author = dto.PublicUserResponse{
+			ID:              series.Author.ID.String(),
+			Username:        series.Author.Username,
+			FirstName:       series.Author.FirstName,
+			LastName:        series.Author.LastName,
+			Bio:             series.Author.Bio,
+			ProfileImageURL: series.Author.ProfileImageURL,
+		}
+	}
+
+	response.Parts = append(response.Parts, seriesParts(series, audience.canSee)...)
+	response.PartsCount = len(response.Parts)
+
+	return response
+}
+
+// toArticleSeriesResponse builds the previous/next navigation for an article within its series.
+// Only the parts listed for the audience are used, plus the article being read, so
+// readers are never linked to drafts or to articles they may not read.
+func toArticleSeriesResponse(series *models.Series, articleID uuid.UUID, audience seriesAudience) *dto.ArticleSeriesResponse {
+	parts := seriesParts(series, func(article *models.Article) bool {
+		return article.ID == articleID || audience.canSee(article)
+	})
+
+	response := &dto.ArticleSeriesResponse{
+		ID:         series.ID.String(),
+		Title:      series.Title,
+		Slug:       series.Slug,
+		TotalParts: len(parts),
+	}
+
+	for i, part := range parts {
+		if part.ID != articleID.String() {
+			continue
+		}
+		response.Position = part.Position
+		if i > 0 {
+			prev := parts[i-1]
+			response.Previous = &prev
+		}
+		if i < len(parts)-1 {
+			next := parts[i+1]
+			response.Next = &next
+		}
+		break
+	}
+
+	return response
+}
+
+// seriesParts returns the ordered parts of a series, renumbered from 1
+// after hidden or deleted articles have been filtered out
+func seriesParts(series *models.Series, visible func(article *models.Article) bool) []dto.SeriesPartResponse {
+	parts := make([]dto.SeriesPartResponse, 0, len(series.Parts))
+	for _, part := range series.Parts {
+		article := part.Article
+		if article == nil {
+			continue
+		}
+		if !visible(article) {
+			continue
+		}
+		parts = append(parts, dto.SeriesPartResponse{
+			Position:           len(parts) + 1,
+			ID:                 article.ID.String(),
+			Title:              article.Title,
+			Slug:               article.Slug,
+			Excerpt:            article.Excerpt,
+			Status:             string(article.Status),
+			PublishedAt:        article.PublishedAt,
+			ReadingTimeMinutes: article.ReadingTimeMinutes,
+		})
+	}
+	return parts
+}
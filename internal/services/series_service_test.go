@@ -0,0 +1,293 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type SeriesServiceTestSuite struct {
+	suite.Suite
+	seriesRepo  *mocks.MockSeriesRepository
+	articleRepo *mocks.MockArticleRepository
+	userRepo    *mocks.MockUserRepository
+	service     SeriesService
+}
+
+func (suite *SeriesServiceTestSuite) SetupTest() {
+	suite.seriesRepo = new(mocks.MockSeriesRepository)
+	suite.articleRepo = new(mocks.MockArticleRepository)
+	suite.userRepo = new(mocks.MockUserRepository)
+	suite.service = NewSeriesService(nil, suite.seriesRepo, suite.articleRepo, suite.userRepo)
+}
+
+func TestSeriesServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SeriesServiceTestSuite))
+}
+
+func publishedArticle(authorID uuid.UUID, title string) *models.Article {
+	now := time.Now()
+	return &models.Article{
+		ID:          uuid.New(),
+		Title:       title,
+		Slug:        utils.GenerateSlug(title),
+		AuthorID:    authorID,
+		Status:      models.StatusPublished,
+		PublishedAt: &now,
+	}
+}
+
+// CreateSeries Tests
+
+func (suite *SeriesServiceTestSuite) TestCreateSeries_Success() {
+	authorID := uuid.New()
+	part1 := publishedArticle(authorID, "Part One")
+	part2 := publishedArticle(authorID, "Part Two")
+
+	req := &dto.CreateSeriesRequest{
+		Title:      "Go Tutorial",
+		ArticleIDs: []string{part1.ID.String(), part2.ID.String()},
+	}
+
+	suite.seriesRepo.On("ExistsBySlug", "go-tutorial").Return(false, nil)
+	suite.articleRepo.On("FindByID", part1.ID).Return(part1, nil)
+	suite.articleRepo.On("FindByID", part2.ID).Return(part2, nil)
+	suite.seriesRepo.On("FindByArticle", mock.AnythingOfType("uuid.UUID")).Return(nil, gorm.ErrRecordNotFound)
+	suite.seriesRepo.On("Create", mock.AnythingOfType("*models.Series")).Return(nil)
+	suite.seriesRepo.On("SetArticles", mock.AnythingOfType("uuid.UUID"), []uuid.UUID{part1.ID, part2.ID}).Return(nil)
+	suite.seriesRepo.On("FindByID", mock.AnythingOfType("uuid.UUID")).Return(&models.Series{
+		ID:       uuid.New(),
+		Title:    "Go Tutorial",
+		Slug:     "go-tutorial",
+		AuthorID: authorID,
+		Parts: []models.SeriesArticle{
+			{Position: 1, ArticleID: part1.ID, Article: part1},
+			{Position: 2, ArticleID: part2.ID, Article: part2},
+		},
+	}, nil)
+
+	result, err := suite.service.CreateSeries(req, authorID.String())
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), "go-tutorial", result.Slug)
+	assert.Equal(suite.T(), 2, result.PartsCount)
+	assert.Equal(suite.T(), part1.ID.String(), result.Parts[0].ID)
+	suite.seriesRepo.AssertExpectations(suite.T())
+}
+
+func (suite *SeriesServiceTestSuite) TestCreateSeries_ArticleNotOwned() {
+	authorID := uuid.New()
+	other := publishedArticle(uuid.New(), "Someone Else")
+
+	req := &dto.CreateSeriesRequest{
+		Title:      "Go Tutorial",
+		ArticleIDs: []string{other.ID.String()},
+	}
+
+	suite.seriesRepo.On("ExistsBySlug", "go-tutorial").Return(false, nil)
+	suite.articleRepo.On("FindByID", other.ID).Return(other, nil)
+
+	result, err := suite.service.CreateSeries(req, authorID.String())
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "ARTICLE_NOT_OWNED", appErr.Code)
+}
+
+func (suite *SeriesServiceTestSuite) TestCreateSeries_ArticleInOtherSeries() {
+	authorID := uuid.New()
+	part := publishedArticle(authorID, "Part One")
+
+	req := &dto.CreateSeriesRequest{
+		Title:      "Go Tutorial",
+		ArticleIDs: []string{part.ID.String()},
+	}
+
+	suite.seriesRepo.On("ExistsBySlug", "go-tutorial").Return(false, nil)
+	suite.articleRepo.On("FindByID", part.ID).Return(part, nil)
+	suite.seriesRepo.On("FindByArticle", part.ID).Return(&models.Series{ID: uuid.New(), Title: "Existing"}, nil)
+
+	result, err := suite.service.CreateSeries(req, authorID.String())
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "ARTICLE_IN_OTHER_SERIES", appErr.Code)
+}
+
+// GetSeries Tests
+
+func (suite *SeriesServiceTestSuite) TestGetSeries_HidesDraftsFromReaders() {
+	authorID := uuid.New()
+	published := publishedArticle(authorID, "Part One")
+	draft := &models.Article{ID: uuid.New(), Title: "Part Two", AuthorID: authorID, Status: models.StatusDraft}
+
+	series := &models.Series{
+		ID:       uuid.New(),
+		Slug:     "go-tutorial",
+		AuthorID: authorID,
+		Parts: []models.SeriesArticle{
+			{Position: 1, Article: published},
+			{Position: 2, Article: draft},
+		},
+	}
+	suite.seriesRepo.On("FindBySlug", "go-tutorial").Return(series, nil)
+
+	result, err := suite.service.GetSeries("go-tutorial", Viewer{UserID: uuid.New().String()})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Parts, 1)
+
+	result, err = suite.service.GetSeries("go-tutorial", Viewer{UserID: authorID.String()})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Parts, 2)
+}
+
+func (suite *SeriesServiceTestSuite) TestGetSeries_FollowersOnlyParts() {
+	authorID, follower, stranger := uuid.New(), uuid.New(), uuid.New()
+	public := publishedArticle(authorID, "Part One")
+	followersOnly := publishedArticle(authorID, "Part Two")
+	followersOnly.Visibility = models.VisibilityFollowers
+	unlisted := publishedArticle(authorID, "Part Three")
+	unlisted.Visibility = models.VisibilityUnlisted
+
+	series := &models.Series{
+		ID:       uuid.New(),
+		Slug:     "go-tutorial",
+		AuthorID: authorID,
+		Parts: []models.SeriesArticle{
+			{Position: 1, Article: public},
+			{Position: 2, Article: followersOnly},
+			{Position: 3, Article: unlisted},
+		},
+	}
+	suite.seriesRepo.On("FindBySlug", "go-tutorial").Return(series, nil)
+	suite.userRepo.On("IsFollowing", follower, authorID).Return(true, nil)
+	suite.userRepo.On("IsFollowing", stranger, authorID).Return(false, nil)
+
+	result, err := suite.service.GetSeries("go-tutorial", Viewer{})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Parts, 1)
+
+	result, err = suite.service.GetSeries("go-tutorial", Viewer{UserID: stranger.String()})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Parts, 1)
+
+	result, err = suite.service.GetSeries("go-tutorial", Viewer{UserID: follower.String()})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Parts, 2)
+	assert.Equal(suite.T(), followersOnly.ID.String(), result.Parts[1].ID)
+
+	result, err = suite.service.GetSeries("go-tutorial", Viewer{UserID: authorID.String()})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Parts, 3)
+}
+
+func (suite *SeriesServiceTestSuite) TestGetSeries_NotFound() {
+	suite.seriesRepo.On("FindBySlug", "missing").Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := suite.service.GetSeries("missing", Viewer{})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), utils.ErrNotFound, err)
+}
+
+// UpdateSeries / DeleteSeries Tests
+
+func (suite *SeriesServiceTestSuite) TestUpdateSeries_Forbidden() {
+	seriesID := uuid.New()
+	suite.seriesRepo.On("FindByID", seriesID).Return(&models.Series{ID: seriesID, AuthorID: uuid.New()}, nil)
+
+	title := "New Title"
+	result, err := suite.service.UpdateSeries(seriesID.String(), &dto.UpdateSeriesRequest{Title: &title}, uuid.New().String(), false)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), utils.ErrForbidden, err)
+}
+
+func (suite *SeriesServiceTestSuite) TestDeleteSeries_Success() {
+	authorID := uuid.New()
+	seriesID := uuid.New()
+	suite.seriesRepo.On("FindByID", seriesID).Return(&models.Series{ID: seriesID, AuthorID: authorID}, nil)
+	suite.seriesRepo.On("Delete", seriesID).Return(nil)
+
+	err := suite.service.DeleteSeries(seriesID.String(), authorID.String(), false)
+
+	assert.NoError(suite.T(), err)
+	suite.seriesRepo.AssertExpectations(suite.T())
+}
+
+// Navigation Tests
+
+func (suite *SeriesServiceTestSuite) TestToArticleSeriesResponse_PreviousAndNext() {
+	authorID := uuid.New()
+	part1 := publishedArticle(authorID, "Part One")
+	draft := &models.Article{ID: uuid.New(), Title: "Unfinished", AuthorID: authorID, Status: models.StatusDraft}
+	part2 := publishedArticle(authorID, "Part Two")
+	part3 := publishedArticle(authorID, "Part Three")
+
+	series := &models.Series{
+		ID:    uuid.New(),
+		Title: "Go Tutorial",
+		Parts: []models.SeriesArticle{
+			{Position: 1, Article: part1},
+			{Position: 2, Article: draft},
+			{Position: 3, Article: part2},
+			{Position: 4, Article: part3},
+		},
+	}
+
+	nav := toArticleSeriesResponse(series, part2.ID, seriesAudience{})
+
+	assert.Equal(suite.T(), 3, nav.TotalParts)
+	assert.Equal(suite.T(), 2, nav.Position)
+	assert.Equal(suite.T(), part1.ID.String(), nav.Previous.ID)
+	assert.Equal(suite.T(), part3.ID.String(), nav.Next.ID)
+
+	first := toArticleSeriesResponse(series, part1.ID, seriesAudience{})
+	assert.Nil(suite.T(), first.Previous)
+	assert.NotNil(suite.T(), first.Next)
+}
+
+func (suite *SeriesServiceTestSuite) TestToArticleSeriesResponse_SkipsHiddenParts() {
+	authorID := uuid.New()
+	part1 := publishedArticle(authorID, "Part One")
+	followersOnly := publishedArticle(authorID, "Part Two")
+	followersOnly.Visibility = models.VisibilityFollowers
+	unlisted := publishedArticle(authorID, "Part Three")
+	unlisted.Visibility = models.VisibilityUnlisted
+	part4 := publishedArticle(authorID, "Part Four")
+
+	series := &models.Series{
+		ID:    uuid.New(),
+		Title: "Go Tutorial",
+		Parts: []models.SeriesArticle{
+			{Position: 1, Article: part1},
+			{Position: 2, Article: followersOnly},
+			{Position: 3, Article: unlisted},
+			{Position: 4, Article: part4},
+		},
+	}
+
+	nav := toArticleSeriesResponse(series, part4.ID, seriesAudience{})
+	assert.Equal(suite.T(), 2, nav.TotalParts)
+	assert.Equal(suite.T(), part1.ID.String(), nav.Previous.ID)
+
+	// The unlisted article being read keeps its place in the navigation
+	nav = toArticleSeriesResponse(series, unlisted.ID, seriesAudience{followsAuthor: true})
+	assert.Equal(suite.T(), 3, nav.Position)
+	assert.Equal(suite.T(), followersOnly.ID.String(), nav.Previous.ID)
+	assert.Equal(suite.T(), part4.ID.String(), nav.Next.ID)
+}
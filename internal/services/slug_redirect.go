@@ -0,0 +1,54 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// slugRedirect resolves a slug that no longer matches any resource. It returns a
+// RedirectError with the resource's current slug when the slug was retired, and
+// ErrNotFound otherwise.
+func slugRedirect(
+	repo repositories.SlugHistoryRepository,
+	entityType models.SlugEntityType,
+	slug string,
+	currentSlug func(id uuid.UUID) (string, error),
+) error {
+	if repo == nil {
+		return utils.ErrNotFound
+	}
+
+	entry, err := repo.FindByOldSlug(entityType, slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrNotFound
+		}
+		return utils.WrapError(err, "failed to find slug history")
+	}
+
+	current, err := currentSlug(entry.EntityID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return utils.ErrNotFound
+		}
+		return utils.WrapError(err, "failed to resolve current slug")
+	}
+	if current == slug {
+		return utils.ErrNotFound
+	}
+
+	return utils.NewRedirectError(current)
+}
+
+// recordSlugChange stores the previous slug of a renamed resource
+func recordSlugChange(repo repositories.SlugHistoryRepository, entityType models.SlugEntityType, id uuid.UUID, oldSlug, newSlug string) error {
+	if repo == nil || oldSlug == newSlug {
+		return nil
+	}
+	return repo.Record(entityType, id, oldSlug)
+}
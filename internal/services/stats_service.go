@@ -0,0 +1,374 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/config"
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// statsRanges maps the accepted stats ranges to their length in days
+var statsRanges = map[string]int{"7d": 7, "30d": 30, "90d": 90, "1y": 365}
+
+const (
+	defaultStatsRange       = "30d"
+	defaultStatsGranularity = "day"
+	topArticlesLimit        = 10
+	topReferrersLimit       = 10
+	statsDateFormat         = "2006-01-02" // How days are written in stats responses
+)
+
+// ViewRecorder decides whether an article view counts and records it
+type ViewRecorder interface {
+	RecordView(articleID uuid.UUID, viewer Viewer) (bool, error)
+}
+
+// StatsService defines the interface for view counting and article statistics
+type StatsService interface {
+	ViewRecorder
+	RollupDailyStats(ctx context.Context) (int, error)
+	GetAuthorStats(userID string, query *dto.StatsQuery) (*dto.AuthorStatsResponse, error)
+	GetArticleStats(slug, userID string, isEditor bool, query *dto.StatsQuery) (*dto.ArticleStatsResponse, error)
+}
+
+type statsService struct {
+	statsRepo   repositories.StatsRepository
+	articleRepo repositories.ArticleRepository
+	cfg         config.StatsConfig
+	secret      string
+	siteHosts   map[string]bool
+}
+
+// NewStatsService creates a new stats service. The secret salts visitor keys
+// so that stored visits can't be traced back to an IP address. Visits referred
+// by the site's own origins are not counted as referrals.
+func NewStatsService(statsRepo repositories.StatsRepository, articleRepo repositories.ArticleRepository, cfg config.StatsConfig, secret string, siteOrigins []string) StatsService {
+	siteHosts := make(map[string]bool)
+	for _, origin := range siteOrigins {
+		if host := utils.ReferrerHost(origin); host != "" {
+			siteHosts[host] = true
+		}
+	}
+
+	return &statsService{
+		statsRepo:   statsRepo,
+		articleRepo: articleRepo,
+		cfg:         cfg,
+		secret:      secret,
+		siteHosts:   siteHosts,
+	}
+}
+
+// RecordView records a view and reports whether it counts. Bots never count;
+// other visitors count once per view window. Signed-in readers are told apart
+// by user ID, anonymous ones by IP address and user agent. Counted views from
+// other sites are added to the article's referrers.
+func (s *statsService) RecordView(articleID uuid.UUID, viewer Viewer) (bool, error) {
+	if utils.IsBot(viewer.UserAgent) {
+		return false, nil
+	}
+
+	identity := "user:" + viewer.UserID
+	if viewer.UserID == "" {
+		identity = "ip:" + viewer.ClientIP + "|" + viewer.UserAgent
+	}
+
+	now := time.Now()
+	counted, err := s.statsRepo.RecordVisit(articleID, s.visitorKey(now, identity), now, s.cfg.ViewWindow)
+	if err != nil {
+		return false, utils.WrapError(err, "failed to record view")
+	}
+
+	if source := utils.ReferrerHost(viewer.Referrer); counted && source != "" && !s.siteHosts[source] {
+		if err := s.statsRepo.RecordReferrer(articleID, source, now); err != nil {
+			utils.Warn("Failed to record referrer", zap.String("article_id", articleID.String()), zap.Error(err))
+		}
+	}
+	return counted, nil
+}
+
+// visitorKey hashes a visitor's identity with the secret and the day, so the
+// same visitor gets a new key every day
+func (s *statsService) visitorKey(now time.Time, identity string) string {
+	mac := hmac.New(sha256.New, []byte(s.secret))
+	mac.Write([]byte(now.UTC().Format("2006-01-02") + "|" + identity))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RollupDailyStats recomputes today's and yesterday's statistics, so that
+// activity late in the day is counted once the day is over, then deletes
+// visits past their retention. It returns how many article-days were written.
+func (s *statsService) RollupDailyStats(ctx context.Context) (int, error) {
+	now := time.Now()
+	total := 0
+	for _, day := range []time.Time{now.AddDate(0, 0, -1), now} {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		count, err := s.statsRepo.RollupDay(day)
+		if err != nil {
+			return total, utils.WrapError(err, "failed to roll up daily stats")
+		}
+		total += count
+	}
+
+	if s.cfg.VisitRetention > 0 {
+		if _, err := s.statsRepo.PruneVisits(now.Add(-s.cfg.VisitRetention)); err != nil {
+			return total, utils.WrapError(err, "failed to prune visits")
+		}
+	}
+	return total, nil
+}
+
+// statsPeriod is the range of days and the grouping a stats request covers
+type statsPeriod struct {
+	rangeName   string
+	granularity string
+	from, to    time.Time
+}
+
+// newStatsPeriod resolves a stats query to a range of days ending today
+func newStatsPeriod(query *dto.StatsQuery) statsPeriod {
+	period := statsPeriod{rangeName: query.Range, granularity: query.Granularity}
+	if _, ok := statsRanges[period.rangeName]; !ok {
+		period.rangeName = defaultStatsRange
+	}
+	if period.granularity == "" {
+		period.granularity = defaultStatsGranularity
+	}
+	period.to = models.StatsDay(time.Now())
+	period.from = period.to.AddDate(0, 0, 1-statsRanges[period.rangeName])
+	return period
+}
+
+// bucket returns the first day of the day, week (starting Monday) or month the day falls in
+func (p statsPeriod) bucket(day time.Time) time.Time {
+	day = models.StatsDay(day)
+	switch p.granularity {
+	case "week":
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case "month":
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day
+}
+
+// buckets returns the first day of every bucket in the period, oldest first
+func (p statsPeriod) buckets() []time.Time {
+	var buckets []time.Time
+	for day := p.bucket(p.from); !day.After(p.to); {
+		buckets = append(buckets, day)
+		switch p.granularity {
+		case "week":
+			day = day.AddDate(0, 0, 7)
+		case "month":
+			day = day.AddDate(0, 1, 0)
+		default:
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+	return buckets
+}
+
+// GetAuthorStats returns statistics across all of a user's articles, with their follower growth
+func (s *statsService) GetAuthorStats(userID string, query *dto.StatsQuery) (*dto.AuthorStatsResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	period := newStatsPeriod(query)
+	scope := repositories.StatsScope{AuthorID: &userUUID}
+
+	daily, err := s.statsRepo.FindDailyTotals(scope, period.from, period.to)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch stats")
+	}
+	series, totals := buildStatsSeries(period, daily)
+
+	top, err := s.statsRepo.FindTopArticles(userUUID, period.from, period.to, topArticlesLimit)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch top articles")
+	}
+	topArticles := make([]dto.TopArticleResponse, len(top))
+	for i, article := range top {
+		topArticles[i] = dto.TopArticleResponse{
+			ID:          article.ArticleID.String(),
+			Title:       article.Title,
+			Slug:        article.Slug,
+			StatsTotals: toStatsTotals(article.Views, article.UniqueVisitors, article.Reads, article.Likes, article.Comments, article.Bookmarks),
+		}
+	}
+
+	referrers, err := s.findTopReferrers(scope, period)
+	if err != nil {
+		return nil, err
+	}
+
+	growth, newFollowers, err := s.followerGrowth(userUUID, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthorStatsResponse{
+		Range:          period.rangeName,
+		Granularity:    period.granularity,
+		From:           period.from.Format(statsDateFormat),
+		To:             period.to.Format(statsDateFormat),
+		Totals:         totals,
+		NewFollowers:   newFollowers,
+		Series:         series,
+		FollowerGrowth: growth,
+		TopArticles:    topArticles,
+		TopReferrers:   referrers,
+	}, nil
+}
+
+// GetArticleStats returns an article's statistics. Only its author and editors may see them.
+func (s *statsService) GetArticleStats(slug, userID string, isEditor bool, query *dto.StatsQuery) (*dto.ArticleStatsResponse, error) {
+	article, err := s.articleRepo.FindBySlug(slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrNotFound
+		}
+		return nil, utils.WrapError(err, "failed to find article")
+	}
+	if article.AuthorID.String() != userID && !isEditor {
+		return nil, utils.ErrForbidden
+	}
+
+	period := newStatsPeriod(query)
+	scope := repositories.StatsScope{ArticleID: &article.ID}
+
+	daily, err := s.statsRepo.FindDailyTotals(scope, period.from, period.to)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch stats")
+	}
+	series, totals := buildStatsSeries(period, daily)
+
+	referrers, err := s.findTopReferrers(scope, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ArticleStatsResponse{
+		ArticleID:    article.ID.String(),
+		Title:        article.Title,
+		Slug:         article.Slug,
+		Range:        period.rangeName,
+		Granularity:  period.granularity,
+		From:         period.from.Format(statsDateFormat),
+		To:           period.to.Format(statsDateFormat),
+		Totals:       totals,
+		Series:       series,
+		TopReferrers: referrers,
+	}, nil
+}
+
+// findTopReferrers returns the sites that sent the most views in the period
+func (s *statsService) findTopReferrers(scope repositories.StatsScope, period statsPeriod) ([]dto.ReferrerResponse, error) {
+	referrers, err := s.statsRepo.FindTopReferrers(scope, period.from, period.to, topReferrersLimit)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to fetch referrers")
+	}
+
+	responses := make([]dto.ReferrerResponse, len(referrers))
+	for i, referrer := range referrers {
+		responses[i] = dto.ReferrerResponse{Source: referrer.Source, Views: referrer.Views}
+	}
+	return responses, nil
+}
+
+// followerGrowth returns the followers gained in each bucket of the period and
+// the running total. Followers who have since unfollowed are not included.
+func (s *statsService) followerGrowth(userID uuid.UUID, period statsPeriod) ([]dto.FollowerGrowthPoint, int, error) {
+	followers, err := s.statsRepo.CountFollowers(userID, period.from)
+	if err != nil {
+		return nil, 0, utils.WrapError(err, "failed to count followers")
+	}
+	dates, err := s.statsRepo.FindFollowDates(userID, period.from)
+	if err != nil {
+		return nil, 0, utils.WrapError(err, "failed to fetch followers")
+	}
+
+	gained := make(map[time.Time]int)
+	for _, date := range dates {
+		gained[period.bucket(date)]++
+	}
+
+	buckets := period.buckets()
+	growth := make([]dto.FollowerGrowthPoint, len(buckets))
+	for i, bucket := range buckets {
+		followers += int64(gained[bucket])
+		growth[i] = dto.FollowerGrowthPoint{
+			Date:         bucket.Format(statsDateFormat),
+			NewFollowers: gained[bucket],
+			Followers:    followers,
+		}
+	}
+	return growth, len(dates), nil
+}
+
+// buildStatsSeries groups daily totals into the period's buckets, including
+// buckets without activity, and sums them over the whole period
+func buildStatsSeries(period statsPeriod, daily []models.ArticleDailyStat) ([]dto.StatsPoint, dto.StatsTotals) {
+	grouped := make(map[time.Time]*models.ArticleDailyStat)
+	var sum models.ArticleDailyStat
+	for _, day := range daily {
+		bucket := period.bucket(day.Day)
+		if grouped[bucket] == nil {
+			grouped[bucket] = &models.ArticleDailyStat{}
+		}
+		addDailyStat(grouped[bucket], &day)
+		addDailyStat(&sum, &day)
+	}
+
+	buckets := period.buckets()
+	series := make([]dto.StatsPoint, len(buckets))
+	for i, bucket := range buckets {
+		point := dto.StatsPoint{Date: bucket.Format(statsDateFormat)}
+		if stat := grouped[bucket]; stat != nil {
+			point.StatsTotals = toStatsTotals(stat.Views, stat.UniqueVisitors, stat.Reads, stat.Likes, stat.Comments, stat.Bookmarks)
+		}
+		series[i] = point
+	}
+
+	return series, toStatsTotals(sum.Views, sum.UniqueVisitors, sum.Reads, sum.Likes, sum.Comments, sum.Bookmarks)
+}
+
+// addDailyStat adds one day's totals to a running sum
+func addDailyStat(sum, day *models.ArticleDailyStat) {
+	sum.Views += day.Views
+	sum.UniqueVisitors += day.UniqueVisitors
+	sum.Reads += day.Reads
+	sum.Likes += day.Likes
+	sum.Comments += day.Comments
+	sum.Bookmarks += day.Bookmarks
+}
+
+// toStatsTotals builds stats totals, working out the read ratio
+func toStatsTotals(views, uniqueVisitors, reads, likes, comments, bookmarks int) dto.StatsTotals {
+	totals := dto.StatsTotals{
+		Views:          views,
+		UniqueVisitors: uniqueVisitors,
+		Reads:          reads,
+		Likes:          likes,
+		Comments:       comments,
+		Bookmarks:      bookmarks,
+	}
+	if views > 0 {
+		totals.ReadRatio = toPercent(float64(reads) / float64(views))
+	}
+	return totals
+}
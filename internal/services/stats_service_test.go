@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/config"
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testBrowserUserAgent = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0 Safari/537.36"
+
+// helper to create stats service with mocks
+func newTestStatsService() (StatsService, *mocks.MockStatsRepository, *mocks.MockArticleRepository) {
+	statsRepo := new(mocks.MockStatsRepository)
+	articleRepo := new(mocks.MockArticleRepository)
+	cfg := config.StatsConfig{ViewWindow: 30 * time.Minute, VisitRetention: 7 * 24 * time.Hour}
+	service := NewStatsService(statsRepo, articleRepo, cfg, "test-secret", []string{"https://blog.example.com"})
+	return service, statsRepo, articleRepo
+}
+
+func TestRecordView_BotsNotCounted(t *testing.T) {
+	service, statsRepo, _ := newTestStatsService()
+
+	for _, userAgent := range []string{"", "Googlebot/2.1 (+http://www.google.com/bot.html)", "curl/8.5.0"} {
+		counted, err := service.RecordView(uuid.New(), Viewer{ClientIP: "203.0.113.7", UserAgent: userAgent})
+		assert.NoError(t, err)
+		assert.False(t, counted, userAgent)
+	}
+	statsRepo.AssertNotCalled(t, "RecordVisit", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRecordView_VisitorKeys(t *testing.T) {
+	service, statsRepo, _ := newTestStatsService()
+	articleID := uuid.New()
+
+	var keys []string
+	statsRepo.On("RecordVisit", articleID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), 30*time.Minute).
+		Run(func(args mock.Arguments) { keys = append(keys, args.String(1)) }).
+		Return(true, nil)
+
+	_, _ = service.RecordView(articleID, Viewer{ClientIP: "203.0.113.7", UserAgent: testBrowserUserAgent})
+	_, _ = service.RecordView(articleID, Viewer{ClientIP: "203.0.113.7", UserAgent: testBrowserUserAgent})
+	_, _ = service.RecordView(articleID, Viewer{ClientIP: "203.0.113.8", UserAgent: testBrowserUserAgent})
+	_, _ = service.RecordView(articleID, Viewer{UserID: "user-1", ClientIP: "203.0.113.7", UserAgent: testBrowserUserAgent})
+	_, _ = service.RecordView(articleID, Viewer{UserID: "user-1", ClientIP: "198.51.100.1", UserAgent: testBrowserUserAgent})
+
+	assert.Len(t, keys, 5)
+	assert.Equal(t, keys[0], keys[1], "same anonymous visitor gets the same key")
+	assert.NotEqual(t, keys[0], keys[2], "different IP is a different visitor")
+	assert.NotEqual(t, keys[0], keys[3], "signed-in reader is keyed by account")
+	assert.Equal(t, keys[3], keys[4], "signed-in reader keeps their key across IPs")
+	assert.NotContains(t, keys[0], "203.0.113.7")
+	assert.Len(t, keys[0], 64)
+}
+
+func TestRecordView_RepeatNotCounted(t *testing.T) {
+	service, statsRepo, _ := newTestStatsService()
+	articleID := uuid.New()
+	statsRepo.On("RecordVisit", articleID, mock.Anything, mock.Anything, 30*time.Minute).Return(false, nil)
+
+	counted, err := service.RecordView(articleID, Viewer{UserID: "user-1", UserAgent: testBrowserUserAgent})
+
+	assert.NoError(t, err)
+	assert.False(t, counted)
+}
+
+func TestRollupDailyStats_RollsUpTodayAndYesterdayAndPrunes(t *testing.T) {
+	service, statsRepo, _ := newTestStatsService()
+	statsRepo.On("RollupDay", mock.AnythingOfType("time.Time")).Return(2, nil).Twice()
+	statsRepo.On("PruneVisits", mock.AnythingOfType("time.Time")).Return(int64(5), nil)
+
+	count, err := service.RollupDailyStats(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+	statsRepo.AssertExpectations(t)
+
+	yesterday := statsRepo.Calls[0].Arguments.Get(0).(time.Time)
+	today := statsRepo.Calls[1].Arguments.Get(0).(time.Time)
+	assert.Equal(t, 24*time.Hour, today.Sub(yesterday).Round(time.Hour))
+}
+
+func TestRollupDailyStats_StopsOnError(t *testing.T) {
+	service, statsRepo, _ := newTestStatsService()
+	statsRepo.On("RollupDay", mock.AnythingOfType("time.Time")).Return(0, errors.New("db down")).Once()
+
+	_, err := service.RollupDailyStats(context.Background())
+
+	assert.Error(t, err)
+	statsRepo.AssertNotCalled(t, "PruneVisits", mock.Anything)
+}
+
+func TestRecordView_ExternalReferrerRecorded(t *testing.T) {
+	service, statsRepo, _ := newTestStatsService()
+	articleID := uuid.New()
+	statsRepo.On("RecordVisit", articleID, mock.Anything, mock.Anything, 30*time.Minute).Return(true, nil)
+	statsRepo.On("RecordReferrer", articleID, "news.ycombinator.com", mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	_, err := service.RecordView(articleID, Viewer{UserAgent: testBrowserUserAgent, Referrer: "https://news.ycombinator.com/item?id=1"})
+	assert.NoError(t, err)
+	_, err = service.RecordView(articleID, Viewer{UserAgent: testBrowserUserAgent, Referrer: "https://blog.example.com/articles/other"})
+	assert.NoError(t, err)
+
+	statsRepo.AssertExpectations(t)
+}
+
+func TestGetArticleStats_OnlyAuthorOrEditor(t *testing.T) {
+	service, _, articleRepo := newTestStatsService()
+	article := &models.Article{ID: uuid.New(), AuthorID: uuid.New(), Slug: "article"}
+	articleRepo.On("FindBySlug", "article").Return(article, nil)
+
+	result, err := service.GetArticleStats("article", uuid.New().String(), false, &dto.StatsQuery{})
+
+	assert.Nil(t, result)
+	assert.Equal(t, utils.ErrForbidden, err)
+}
+
+func TestGetArticleStats_SeriesFilledAndGrouped(t *testing.T) {
+	service, statsRepo, articleRepo := newTestStatsService()
+	authorID := uuid.New()
+	article := &models.Article{ID: uuid.New(), AuthorID: authorID, Title: "Article", Slug: "article"}
+	articleRepo.On("FindBySlug", "article").Return(article, nil)
+
+	today := models.StatsDay(time.Now())
+	statsRepo.On("FindDailyTotals", repositories.StatsScope{ArticleID: &article.ID}, today.AddDate(0, 0, -6), today).Return([]models.ArticleDailyStat{
+		{Day: today.AddDate(0, 0, -6), Views: 10, Reads: 4, Likes: 1},
+		{Day: today, Views: 10, Reads: 1, Bookmarks: 2},
+	}, nil)
+	statsRepo.On("FindTopReferrers", mock.Anything, mock.Anything, mock.Anything, topReferrersLimit).
+		Return([]repositories.ReferrerCount{{Source: "google.com", Views: 3}}, nil)
+
+	result, err := service.GetArticleStats("article", authorID.String(), false, &dto.StatsQuery{Range: "7d"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "7d", result.Range)
+	assert.Equal(t, "day", result.Granularity)
+	assert.Len(t, result.Series, 7)
+	assert.Equal(t, 10, result.Series[0].Views)
+	assert.Equal(t, 40.0, result.Series[0].ReadRatio)
+	assert.Zero(t, result.Series[3].Views)
+	assert.Equal(t, 20, result.Totals.Views)
+	assert.Equal(t, 25.0, result.Totals.ReadRatio)
+	assert.Equal(t, 2, result.Totals.Bookmarks)
+	assert.Equal(t, []dto.ReferrerResponse{{Source: "google.com", Views: 3}}, result.TopReferrers)
+}
+
+func TestGetAuthorStats_WeeklyWithFollowerGrowth(t *testing.T) {
+	service, statsRepo, _ := newTestStatsService()
+	authorID := uuid.New()
+	today := models.StatsDay(time.Now())
+	from := today.AddDate(0, 0, -29)
+
+	statsRepo.On("FindDailyTotals", repositories.StatsScope{AuthorID: &authorID}, from, today).Return([]models.ArticleDailyStat{
+		{Day: from, Views: 5},
+		{Day: today, Views: 7},
+	}, nil)
+	statsRepo.On("FindTopArticles", authorID, from, today, topArticlesLimit).Return([]repositories.ArticleStatsTotal{
+		{ArticleID: uuid.New(), Title: "Top", Slug: "top", Views: 12, Reads: 3},
+	}, nil)
+	statsRepo.On("FindTopReferrers", mock.Anything, from, today, topReferrersLimit).Return([]repositories.ReferrerCount{}, nil)
+	statsRepo.On("CountFollowers", authorID, from).Return(int64(10), nil)
+	statsRepo.On("FindFollowDates", authorID, from).Return([]time.Time{from.Add(time.Hour), today.Add(time.Hour), today.Add(2 * time.Hour)}, nil)
+
+	result, err := service.GetAuthorStats(authorID.String(), &dto.StatsQuery{Granularity: "week"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "30d", result.Range)
+	assert.Equal(t, from.Format("2006-01-02"), result.From)
+	assert.GreaterOrEqual(t, len(result.Series), 5)
+	for _, point := range result.Series {
+		date, _ := time.Parse("2006-01-02", point.Date)
+		assert.Equal(t, time.Monday, date.Weekday())
+	}
+	assert.Equal(t, 12, result.Totals.Views)
+	assert.Equal(t, 3, result.NewFollowers)
+	assert.Len(t, result.FollowerGrowth, len(result.Series))
+	assert.Equal(t, int64(11), result.FollowerGrowth[0].Followers)
+	assert.Equal(t, int64(13), result.FollowerGrowth[len(result.FollowerGrowth)-1].Followers)
+	assert.Equal(t, 25.0, result.TopArticles[0].ReadRatio)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/alfafaa/alfafaa-blog/internal/cache"
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/models"
 	"github.com/alfafaa/alfafaa-blog/internal/repositories"
@@ -24,16 +25,41 @@ type TagService interface {
 }
 
 type tagService struct {
+	db          *gorm.DB
 	tagRepo     repositories.TagRepository
 	articleRepo repositories.ArticleRepository
+	slugRepo    repositories.SlugHistoryRepository
+	readCache   *cache.ReadCache
 }
 
 // NewTagService creates a new tag service
-func NewTagService(tagRepo repositories.TagRepository, articleRepo repositories.ArticleRepository) TagService {
-	return &tagService{
+func NewTagService(db *gorm.DB, tagRepo repositories.TagRepository, articleRepo repositories.ArticleRepository, opts ...TagServiceOption) TagService {
+	svc := &tagService{
+		db:          db,
 		tagRepo:     tagRepo,
 		articleRepo: articleRepo,
 	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// TagServiceOption is a functional option for configuring the tag service
+type TagServiceOption func(*tagService)
+
+// WithTagSlugHistoryRepo sets the slug history repository on the tag service (for redirects)
+func WithTagSlugHistoryRepo(repo repositories.SlugHistoryRepository) TagServiceOption {
+	return func(s *tagService) {
+		s.slugRepo = repo
+	}
+}
+
+// WithTagReadCache caches popular tags, and drops them when tags change
+func WithTagReadCache(readCache *cache.ReadCache) TagServiceOption {
+	return func(s *tagService) {
+		s.readCache = readCache
+	}
 }
 
 // CreateTag creates a new tag
@@ -68,6 +94,7 @@ func (s *tagService) CreateTag(req *dto.CreateTagRequest) (*dto.TagResponse, err
 		return nil, utils.WrapError(err, "failed to create tag")
 	}
 
+	s.readCache.Invalidate(cache.TagChanged)
 	return s.toResponse(tag), nil
 }
 
@@ -76,7 +103,7 @@ func (s *tagService) GetTag(slug string) (*dto.TagDetailResponse, error) {
 	tag, err := s.tagRepo.FindBySlug(slug)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, utils.ErrNotFound
+			return nil, s.redirectFromSlug(slug)
 		}
 		return nil, utils.WrapError(err, "failed to find tag")
 	}
@@ -114,22 +141,24 @@ func (s *tagService) GetPopularTags(limit int) ([]dto.PopularTagResponse, error)
 		limit = 50
 	}
 
-	tags, err := s.tagRepo.FindPopular(limit)
-	if err != nil {
-		return nil, utils.WrapError(err, "failed to find popular tags")
-	}
-
-	responses := make([]dto.PopularTagResponse, len(tags))
-	for i, tag := range tags {
-		responses[i] = dto.PopularTagResponse{
-			ID:         tag.ID.String(),
-			Name:       tag.Name,
-			Slug:       tag.Slug,
-			UsageCount: tag.UsageCount,
+	key := fmt.Sprintf("%spopular:%d", cache.TagReads, limit)
+	return cache.Remember(s.readCache, key, func() ([]dto.PopularTagResponse, error) {
+		tags, err := s.tagRepo.FindPopular(limit)
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to find popular tags")
 		}
-	}
 
-	return responses, nil
+		responses := make([]dto.PopularTagResponse, len(tags))
+		for i, tag := range tags {
+			responses[i] = dto.PopularTagResponse{
+				ID:         tag.ID.String(),
+				Name:       tag.Name,
+				Slug:       tag.Slug,
+				UsageCount: tag.UsageCount,
+			}
+		}
+		return responses, nil
+	})
 }
 
 // UpdateTag updates a tag
@@ -147,6 +176,8 @@ func (s *tagService) UpdateTag(id string, req *dto.UpdateTagRequest) (*dto.TagRe
 		return nil, utils.WrapError(err, "failed to find tag")
 	}
 
+	oldSlug := tag.Slug
+
 	// Update fields
 	if req.Name != nil {
 		tag.Name = *req.Name
@@ -166,10 +197,31 @@ func (s *tagService) UpdateTag(id string, req *dto.UpdateTagRequest) (*dto.TagRe
 		tag.Description = *req.Description
 	}
 
-	if err := s.tagRepo.Update(tag); err != nil {
+	// The old slug must keep redirecting, so its history is saved with the rename
+	save := func(tagRepo repositories.TagRepository, slugRepo repositories.SlugHistoryRepository) error {
+		if err := tagRepo.Update(tag); err != nil {
+			return err
+		}
+		return recordSlugChange(slugRepo, models.SlugEntityTag, tag.ID, oldSlug, tag.Slug)
+	}
+
+	if s.db != nil {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			var slugRepo repositories.SlugHistoryRepository
+			if s.slugRepo != nil {
+				slugRepo = s.slugRepo.WithTx(tx)
+			}
+			return save(s.tagRepo.WithTx(tx), slugRepo)
+		})
+	} else {
+		// Fallback for unit tests without db - run without transaction
+		err = save(s.tagRepo, s.slugRepo)
+	}
+	if err != nil {
 		return nil, utils.WrapError(err, "failed to update tag")
 	}
 
+	s.readCache.Invalidate(cache.TagChanged)
 	return s.toResponse(tag), nil
 }
 
@@ -197,6 +249,7 @@ func (s *tagService) DeleteTag(id string) error {
 		return utils.WrapError(err, "failed to delete tag")
 	}
 
+	s.readCache.Invalidate(cache.TagChanged)
 	return nil
 }
 
@@ -205,7 +258,7 @@ func (s *tagService) GetTagArticles(slug string, query *dto.PaginationQuery) ([]
 	tag, err := s.tagRepo.FindBySlug(slug)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, 0, utils.ErrNotFound
+			return nil, 0, s.redirectFromSlug(slug)
 		}
 		return nil, 0, utils.WrapError(err, "failed to find tag")
 	}
@@ -255,3 +308,14 @@ func (s *tagService) toDetailResponse(tag *models.Tag) *dto.TagDetailResponse {
 		UpdatedAt:   tag.UpdatedAt,
 	}
 }
+
+// redirectFromSlug resolves a retired tag slug to a redirect
+func (s *tagService) redirectFromSlug(slug string) error {
+	return slugRedirect(s.slugRepo, models.SlugEntityTag, slug, func(id uuid.UUID) (string, error) {
+		tag, err := s.tagRepo.FindByID(id)
+		if err != nil {
+			return "", err
+		}
+		return tag.Slug, nil
+	})
+}
@@ -1,17 +1,21 @@
 package services
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/alfafaa/alfafaa-blog/internal/cache"
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/models"
 	"github.com/alfafaa/alfafaa-blog/internal/repositories"
 	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/alfafaa/alfafaa-blog/tests/helpers"
 	"github.com/alfafaa/alfafaa-blog/tests/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 )
@@ -26,7 +30,7 @@ type TagServiceTestSuite struct {
 func (suite *TagServiceTestSuite) SetupTest() {
 	suite.tagRepo = new(mocks.MockTagRepository)
 	suite.articleRepo = new(mocks.MockArticleRepository)
-	suite.service = NewTagService(suite.tagRepo, suite.articleRepo)
+	suite.service = NewTagService(nil, suite.tagRepo, suite.articleRepo)
 }
 
 func TestTagServiceTestSuite(t *testing.T) {
@@ -127,6 +131,62 @@ func (suite *TagServiceTestSuite) TestGetTag_NotFound() {
 	suite.tagRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TagServiceTestSuite) TestGetTag_RedirectsRetiredSlug() {
+	slugRepo := new(mocks.MockSlugHistoryRepository)
+	service := NewTagService(nil, suite.tagRepo, suite.articleRepo, WithTagSlugHistoryRepo(slugRepo))
+	tagID := uuid.New()
+
+	suite.tagRepo.On("FindBySlug", "go-programming").Return(nil, gorm.ErrRecordNotFound)
+	slugRepo.On("FindByOldSlug", models.SlugEntityTag, "go-programming").Return(&models.SlugHistory{EntityID: tagID}, nil)
+	suite.tagRepo.On("FindByID", tagID).Return(&models.Tag{ID: tagID, Slug: "golang"}, nil)
+
+	result, err := service.GetTag("go-programming")
+
+	assert.Nil(suite.T(), result)
+	redirect, ok := utils.IsRedirectError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "golang", redirect.Slug)
+}
+
+func (suite *TagServiceTestSuite) TestUpdateTag_RecordsOldSlug() {
+	slugRepo := new(mocks.MockSlugHistoryRepository)
+	service := NewTagService(nil, suite.tagRepo, suite.articleRepo, WithTagSlugHistoryRepo(slugRepo))
+	tagID := uuid.New()
+	newName := "Golang"
+
+	suite.tagRepo.On("FindByID", tagID).Return(&models.Tag{ID: tagID, Name: "Go Programming", Slug: "go-programming"}, nil)
+	suite.tagRepo.On("ExistsBySlug", "golang").Return(false, nil)
+	suite.tagRepo.On("Update", mock.AnythingOfType("*models.Tag")).Return(nil)
+	slugRepo.On("Record", models.SlugEntityTag, tagID, "go-programming").Return(nil)
+
+	result, err := service.UpdateTag(tagID.String(), &dto.UpdateTagRequest{Name: &newName})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "golang", result.Slug)
+	slugRepo.AssertExpectations(suite.T())
+}
+
+func TestUpdateTag_RenameRolledBackWithoutSlugHistory(t *testing.T) {
+	db := helpers.SetupTestDB()
+	helpers.CleanupTestDB(db)
+	tagRepo := repositories.NewTagRepository(db)
+	slugRepo := new(mocks.MockSlugHistoryRepository)
+	service := NewTagService(db, tagRepo, nil, WithTagSlugHistoryRepo(slugRepo))
+
+	tag := &models.Tag{Name: "Go Programming", Slug: "go-programming"}
+	require.NoError(t, db.Create(tag).Error)
+	slugRepo.On("Record", models.SlugEntityTag, tag.ID, "go-programming").Return(errors.New("insert failed"))
+	newName := "Golang"
+
+	_, err := service.UpdateTag(tag.ID.String(), &dto.UpdateTagRequest{Name: &newName})
+
+	assert.Error(t, err)
+	stored, err := tagRepo.FindByID(tag.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "go-programming", stored.Slug)
+	assert.Equal(t, "Go Programming", stored.Name)
+}
+
 // GetTags Tests
 
 func (suite *TagServiceTestSuite) TestGetTags_Success() {
@@ -270,6 +330,35 @@ func (suite *TagServiceTestSuite) TestGetPopularTags_NegativeLimit() {
 	suite.tagRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TagServiceTestSuite) TestGetPopularTags_CachedUntilTagChanges() {
+	service := NewTagService(nil, suite.tagRepo, suite.articleRepo,
+		WithTagReadCache(cache.NewReadCache(cache.NewMemoryCache(10), time.Minute)),
+	)
+	tagID := uuid.New()
+	tag := &models.Tag{ID: tagID, Name: "Go", Slug: "go", UsageCount: 5}
+	name := "Golang"
+
+	suite.tagRepo.On("FindPopular", 10).Return([]models.Tag{*tag}, nil).Twice()
+	suite.tagRepo.On("FindByID", tagID).Return(tag, nil)
+	suite.tagRepo.On("ExistsBySlug", "golang").Return(false, nil)
+	suite.tagRepo.On("Update", mock.AnythingOfType("*models.Tag")).Return(nil)
+
+	// The second read is served from the cache
+	_, err := service.GetPopularTags(10)
+	assert.NoError(suite.T(), err)
+	result, err := service.GetPopularTags(10)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "go", result[0].Slug)
+	suite.tagRepo.AssertNumberOfCalls(suite.T(), "FindPopular", 1)
+
+	// Updating a tag drops the cached read
+	_, err = service.UpdateTag(tagID.String(), &dto.UpdateTagRequest{Name: &name})
+	assert.NoError(suite.T(), err)
+	_, err = service.GetPopularTags(10)
+	assert.NoError(suite.T(), err)
+	suite.tagRepo.AssertNumberOfCalls(suite.T(), "FindPopular", 2)
+}
+
 // UpdateTag Tests
 
 func (suite *TagServiceTestSuite) TestUpdateTag_Success() {
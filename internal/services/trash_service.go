@@ -0,0 +1,427 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/cache"
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// trashPurgeBatchSize is the number of expired items loaded at a time by the retention job
+const trashPurgeBatchSize = 100
+
+// TrashService defines the interface for listing, restoring and purging
+// soft-deleted articles, comments and users
+type TrashService interface {
+	GetTrash(itemType string, query *dto.PaginationQuery) ([]dto.TrashItemResponse, int64, error)
+	Restore(itemType string, id string) (*dto.TrashRestoreResponse, error)
+	Purge(itemType string, id string) error
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+type trashService struct {
+	db          *gorm.DB
+	trashRepo   repositories.TrashRepository
+	articleRepo repositories.ArticleRepository
+	tagRepo     repositories.TagRepository
+	retention   time.Duration
+	readCache   *cache.ReadCache
+}
+
+// NewTrashService creates a new trash service. Items deleted longer than
+// retention ago are purged by PurgeExpired; a retention of 0 keeps them forever.
+func NewTrashService(
+	db *gorm.DB,
+	trashRepo repositories.TrashRepository,
+	articleRepo repositories.ArticleRepository,
+	tagRepo repositories.TagRepository,
+	retention time.Duration,
+	opts ...TrashServiceOption,
+) TrashService {
+	svc := &trashService{
+		db:          db,
+		trashRepo:   trashRepo,
+		articleRepo: articleRepo,
+		tagRepo:     tagRepo,
+		retention:   retention,
+	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// TrashServiceOption is a functional option for configuring the trash service
+type TrashServiceOption func(*trashService)
+
+// WithTrashReadCache drops cached article reads when an article is restored
+func WithTrashReadCache(readCache *cache.ReadCache) TrashServiceOption {
+	return func(s *trashService) {
+		s.readCache = readCache
+	}
+}
+
+// GetTrash lists soft-deleted items of one type, most recently deleted first
+func (s *trashService) GetTrash(itemType string, query *dto.PaginationQuery) ([]dto.TrashItemResponse, int64, error) {
+	trashType, err := parseTrashType(itemType)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var items []dto.TrashItemResponse
+	var total int64
+
+	switch trashType {
+	case repositories.TrashArticles:
+		var articles []models.Article
+		articles, total, err = s.trashRepo.FindArticles(query.GetPerPage(), query.GetOffset())
+		for i := range articles {
+			items = append(items, s.toArticleItem(&articles[i]))
+		}
+	case repositories.TrashComments:
+		var comments []models.Comment
+		comments, total, err = s.trashRepo.FindComments(query.GetPerPage(), query.GetOffset())
+		for i := range comments {
+			items = append(items, s.toCommentItem(&comments[i]))
+		}
+	case repositories.TrashUsers:
+		var users []models.User
+		users, total, err = s.trashRepo.FindUsers(query.GetPerPage(), query.GetOffset())
+		for i := range users {
+			items = append(items, s.toUserItem(&users[i]))
+		}
+	}
+	if err != nil {
+		return nil, 0, utils.WrapError(err, "failed to find deleted "+itemType)
+	}
+
+	if items == nil {
+		items = []dto.TrashItemResponse{}
+	}
+
+	return items, total, nil
+}
+
+// Restore undeletes an item. Articles get their tag usage back and keep their
+// slug if no live article has taken it since; comments and users are restored
+// as they were.
+func (s *trashService) Restore(itemType string, id string) (*dto.TrashRestoreResponse, error) {
+	trashType, err := parseTrashType(itemType)
+	if err != nil {
+		return nil, err
+	}
+
+	itemID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, utils.ErrBadRequest
+	}
+
+	switch trashType {
+	case repositories.TrashArticles:
+		return s.restoreArticle(itemID)
+	case repositories.TrashComments:
+		return s.restoreComment(itemID)
+	default:
+		if _, err := s.trashRepo.FindUser(itemID); err != nil {
+			return nil, notInTrash(err)
+		}
+		if err := s.trashRepo.Restore(repositories.TrashUsers, itemID); err != nil {
+			return nil, utils.WrapError(err, "failed to restore user")
+		}
+		return &dto.TrashRestoreResponse{ID: itemID.String(), Type: itemType}, nil
+	}
+}
+
+// restoreArticle undeletes an article and increments the usage of its tags
+func (s *trashService) restoreArticle(id uuid.UUID) (*dto.TrashRestoreResponse, error) {
+	article, err := s.trashRepo.FindArticle(id)
+	if err != nil {
+		return nil, notInTrash(err)
+	}
+
+	if article.Author != nil && article.Author.DeletedAt.Valid {
+		return nil, utils.NewAppError("AUTHOR_DELETED", "Restore the article's author first", 409)
+	}
+
+	// Another translation may have taken this article's locale in the meantime
+	translations, err := s.articleRepo.FindTranslations(article.TranslationGroupID)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to find translations")
+	}
+	for _, translation := range translations {
+		if translation.Locale == article.Locale {
+			return nil, utils.NewAppError("TRANSLATION_EXISTS", "The article's translation group already has a "+article.Locale+" translation", 409)
+		}
+	}
+
+	slug := article.Slug
+	exists, err := s.articleRepo.ExistsBySlug(slug)
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to check slug")
+	}
+	for i := 1; exists; i++ {
+		slug = utils.GenerateUniqueSlug(utils.TruncateSlug(article.Slug, 190), fmt.Sprintf("%d", i))
+		exists, err = s.articleRepo.ExistsBySlug(slug)
+		if err != nil {
+			return nil, utils.WrapError(err, "failed to check slug")
+		}
+	}
+
+	// Restore the article and its tag usage counts together
+	if s.db != nil {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			txTrashRepo := s.trashRepo.WithTx(tx)
+			txTagRepo := s.tagRepo.WithTx(tx)
+
+			if err := txTrashRepo.RestoreArticle(id, slug); err != nil {
+				return err
+			}
+
+			for _, tag := range article.Tags {
+				if err := txTagRepo.IncrementUsage(tag.ID); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	} else {
+		// Fallback for unit tests without db - run without transaction
+		if err := s.trashRepo.RestoreArticle(id, slug); err != nil {
+			return nil, utils.WrapError(err, "failed to restore article")
+		}
+		for _, tag := range article.Tags {
+			if err := s.tagRepo.IncrementUsage(tag.ID); err != nil {
+				return nil, utils.WrapError(err, "failed to increment tag usage")
+			}
+		}
+	}
+
+	if err != nil {
+		return nil, utils.WrapError(err, "failed to restore article")
+	}
+
+	s.readCache.Invalidate(cache.ArticleChanged)
+	return &dto.TrashRestoreResponse{
+		ID:          id.String(),
+		Type:        string(repositories.TrashArticles),
+		Slug:        slug,
+		SlugChanged: slug != article.Slug,
+	}, nil
+}
+
+// restoreComment undeletes a comment whose article, parent and writer are live
+func (s *trashService) restoreComment(id uuid.UUID) (*dto.TrashRestoreResponse, error) {
+	comment, err := s.trashRepo.FindComment(id)
+	if err != nil {
+		return nil, notInTrash(err)
+	}
+
+	if comment.Article == nil || comment.Article.DeletedAt.Valid {
+		return nil, utils.NewAppError("ARTICLE_DELETED", "Restore the comment's article first", 409)
+	}
+	if comment.Parent != nil && comment.Parent.DeletedAt.Valid {
+		return nil, utils.NewAppError("PARENT_DELETED", "Restore the comment this replies to first", 409)
+	}
+	if comment.User != nil && comment.User.DeletedAt.Valid {
+		return nil, utils.NewAppError("USER_DELETED", "Restore the comment's writer first", 409)
+	}
+
+	if err := s.trashRepo.Restore(repositories.TrashComments, id); err != nil {
+		return nil, utils.WrapError(err, "failed to restore comment")
+	}
+
+	return &dto.TrashRestoreResponse{ID: id.String(), Type: string(repositories.TrashComments)}, nil
+}
+
+// Purge permanently deletes an item that is in the trash
+func (s *trashService) Purge(itemType string, id string) error {
+	trashType, err := parseTrashType(itemType)
+	if err != nil {
+		return err
+	}
+
+	itemID, err := uuid.Parse(id)
+	if err != nil {
+		return utils.ErrBadRequest
+	}
+
+	switch trashType {
+	case repositories.TrashArticles:
+		_, err = s.trashRepo.FindArticle(itemID)
+	case repositories.TrashComments:
+		_, err = s.trashRepo.FindComment(itemID)
+	case repositories.TrashUsers:
+		_, err = s.trashRepo.FindUser(itemID)
+	}
+	if err != nil {
+		return notInTrash(err)
+	}
+
+	return s.purge(trashType, itemID)
+}
+
+// PurgeExpired permanently deletes items that have been in the trash longer
+// than the retention period and returns how many were purged. Items that
+// can't be purged yet, such as users who still own content, are skipped.
+func (s *trashService) PurgeExpired(ctx context.Context) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+
+	cutoff := time.Now().Add(-s.retention)
+	purged := 0
+
+	// Articles go first because purging one also purges its comments
+	for _, trashType := range []repositories.TrashType{repositories.TrashArticles, repositories.TrashComments, repositories.TrashUsers} {
+		skipped := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				return purged, err
+			}
+
+			// Purged items drop out of the results, so only skipped ones need to be paged past
+			ids, err := s.trashRepo.FindDeletedBefore(trashType, cutoff, trashPurgeBatchSize, skipped)
+			if err != nil {
+				return purged, utils.WrapError(err, "failed to find expired "+string(trashType))
+			}
+
+			for _, id := range ids {
+				if err := s.purge(trashType, id); err != nil {
+					utils.Warn("Trash: could not purge expired item", zap.String("type", string(trashType)), zap.String("id", id.String()), zap.Error(err))
+					skipped++
+					continue
+				}
+				purged++
+			}
+
+			if len(ids) < trashPurgeBatchSize {
+				break
+			}
+		}
+	}
+
+	return purged, nil
+}
+
+// purge permanently deletes an item in one transaction
+func (s *trashService) purge(trashType repositories.TrashType, id uuid.UUID) error {
+	purgeWith := func(repo repositories.TrashRepository) error {
+		switch trashType {
+		case repositories.TrashArticles:
+			return repo.PurgeArticle(id)
+		case repositories.TrashComments:
+			return repo.PurgeComment(id)
+		default:
+			return repo.PurgeUser(id)
+		}
+	}
+
+	var err error
+	if s.db != nil {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			return purgeWith(s.trashRepo.WithTx(tx))
+		})
+	} else {
+		// Fallback for unit tests without db - run without transaction
+		err = purgeWith(s.trashRepo)
+	}
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, repositories.ErrCommentHasReplies):
+		return utils.NewAppError("COMMENT_HAS_REPLIES", "The comment has replies that are not deleted", 409)
+	case errors.Is(err, repositories.ErrUserHasContent):
+		return utils.NewAppError("USER_HAS_CONTENT", "The user still has articles, comments, media, series or revisions", 409)
+	default:
+		return utils.WrapError(err, "failed to purge "+string(trashType))
+	}
+}
+
+// purgeAt returns when the retention job will purge an item, if ever
+func (s *trashService) purgeAt(deletedAt gorm.DeletedAt) *time.Time {
+	if s.retention <= 0 {
+		return nil
+	}
+	purgeAt := deletedAt.Time.Add(s.retention)
+	return &purgeAt
+}
+
+// toArticleItem converts a deleted article to a trash item
+func (s *trashService) toArticleItem(article *models.Article) dto.TrashItemResponse {
+	return dto.TrashItemResponse{
+		ID:        article.ID.String(),
+		Type:      string(repositories.TrashArticles),
+		Title:     article.Title,
+		Slug:      article.Slug,
+		Owner:     toTrashOwner(article.Author),
+		DeletedAt: article.DeletedAt.Time,
+		PurgeAt:   s.purgeAt(article.DeletedAt),
+	}
+}
+
+// toCommentItem converts a deleted comment to a trash item
+func (s *trashService) toCommentItem(comment *models.Comment) dto.TrashItemResponse {
+	return dto.TrashItemResponse{
+		ID:        comment.ID.String(),
+		Type:      string(repositories.TrashComments),
+		Title:     utils.TruncateString(comment.Content, 100),
+		ArticleID: comment.ArticleID.String(),
+		Owner:     toTrashOwner(comment.User),
+		DeletedAt: comment.DeletedAt.Time,
+		PurgeAt:   s.purgeAt(comment.DeletedAt),
+	}
+}
+
+// toUserItem converts a deleted user to a trash item
+func (s *trashService) toUserItem(user *models.User) dto.TrashItemResponse {
+	return dto.TrashItemResponse{
+		ID:        user.ID.String(),
+		Type:      string(repositories.TrashUsers),
+		Title:     user.Username,
+		DeletedAt: user.DeletedAt.Time,
+		PurgeAt:   s.purgeAt(user.DeletedAt),
+	}
+}
+
+// toTrashOwner converts the owner of a deleted item to a public user
+func toTrashOwner(user *models.User) *dto.PublicUserResponse {
+	if user == nil {
+		return nil
+	}
+	return &dto.PublicUserResponse{
+		ID:              user.ID.String(),
+		Username:        user.Username,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Bio:             user.Bio,
+		ProfileImageURL: user.ProfileImageURL,
+	}
+}
+
+// parseTrashType validates a trash type from a request
+func parseTrashType(itemType string) (repositories.TrashType, error) {
+	trashType := repositories.TrashType(itemType)
+	if !trashType.IsValid() {
+		return "", utils.NewAppError("INVALID_TRASH_TYPE", "Trash type must be articles, comments or users", 400)
+	}
+	return trashType, nil
+}
+
+// notInTrash maps a failed trash lookup to an error
+func notInTrash(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return utils.ErrNotFound
+	}
+	return utils.WrapError(err, "failed to find deleted item")
+}
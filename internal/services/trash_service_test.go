@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/dto"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TrashServiceTestSuite struct {
+	suite.Suite
+	trashRepo   *mocks.MockTrashRepository
+	articleRepo *mocks.MockArticleRepository
+	tagRepo     *mocks.MockTagRepository
+	service     TrashService
+}
+
+func (suite *TrashServiceTestSuite) SetupTest() {
+	suite.trashRepo = new(mocks.MockTrashRepository)
+	suite.articleRepo = new(mocks.MockArticleRepository)
+	suite.tagRepo = new(mocks.MockTagRepository)
+	suite.service = NewTrashService(nil, suite.trashRepo, suite.articleRepo, suite.tagRepo, 30*24*time.Hour)
+}
+
+func TestTrashServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TrashServiceTestSuite))
+}
+
+func trashedAt(t time.Time) gorm.DeletedAt {
+	return gorm.DeletedAt{Time: t, Valid: true}
+}
+
+func (suite *TrashServiceTestSuite) TestGetTrash_Articles() {
+	deleted := time.Now().Add(-time.Hour)
+	author := &models.User{ID: uuid.New(), Username: "jane"}
+	suite.trashRepo.On("FindArticles", 20, 0).Return([]models.Article{
+		{ID: uuid.New(), Title: "Gone", Slug: "gone", Author: author, DeletedAt: trashedAt(deleted)},
+	}, int64(1), nil)
+
+	items, total, err := suite.service.GetTrash("articles", &dto.PaginationQuery{})
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), total)
+	require.Len(suite.T(), items, 1)
+	assert.Equal(suite.T(), "gone", items[0].Slug)
+	assert.Equal(suite.T(), "jane", items[0].Owner.Username)
+	require.NotNil(suite.T(), items[0].PurgeAt)
+	assert.Equal(suite.T(), deleted.Add(30*24*time.Hour), *items[0].PurgeAt)
+}
+
+func (suite *TrashServiceTestSuite) TestGetTrash_InvalidType() {
+	_, _, err := suite.service.GetTrash("media", &dto.PaginationQuery{})
+
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "INVALID_TRASH_TYPE", appErr.Code)
+}
+
+func (suite *TrashServiceTestSuite) TestRestore_ArticleKeepsFreeSlug() {
+	tag := models.Tag{ID: uuid.New()}
+	article := &models.Article{ID: uuid.New(), Slug: "back", Locale: "en", TranslationGroupID: uuid.New(), Tags: []models.Tag{tag}, DeletedAt: trashedAt(time.Now())}
+
+	suite.trashRepo.On("FindArticle", article.ID).Return(article, nil)
+	suite.articleRepo.On("FindTranslations", article.TranslationGroupID).Return([]models.Article{}, nil)
+	suite.articleRepo.On("ExistsBySlug", "back").Return(false, nil)
+	suite.trashRepo.On("RestoreArticle", article.ID, "back").Return(nil)
+	suite.tagRepo.On("IncrementUsage", tag.ID).Return(nil)
+
+	restored, err := suite.service.Restore("articles", article.ID.String())
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "back", restored.Slug)
+	assert.False(suite.T(), restored.SlugChanged)
+	suite.tagRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TrashServiceTestSuite) TestRestore_ArticleSlugTaken() {
+	article := &models.Article{ID: uuid.New(), Slug: "back", Locale: "en", TranslationGroupID: uuid.New(), DeletedAt: trashedAt(time.Now())}
+
+	suite.trashRepo.On("FindArticle", article.ID).Return(article, nil)
+	suite.articleRepo.On("FindTranslations", article.TranslationGroupID).Return([]models.Article{}, nil)
+	suite.articleRepo.On("ExistsBySlug", "back").Return(true, nil)
+	suite.articleRepo.On("ExistsBySlug", "back-1").Return(false, nil)
+	suite.trashRepo.On("RestoreArticle", article.ID, "back-1").Return(nil)
+
+	restored, err := suite.service.Restore("articles", article.ID.String())
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "back-1", restored.Slug)
+	assert.True(suite.T(), restored.SlugChanged)
+}
+
+func (suite *TrashServiceTestSuite) TestRestore_ArticleTranslationTaken() {
+	groupID := uuid.New()
+	article := &models.Article{ID: uuid.New(), Slug: "back", Locale: "ur", TranslationGroupID: groupID}
+
+	suite.trashRepo.On("FindArticle", article.ID).Return(article, nil)
+	suite.articleRepo.On("FindTranslations", groupID).Return([]models.Article{{ID: uuid.New(), Locale: "ur"}}, nil)
+
+	_, err := suite.service.Restore("articles", article.ID.String())
+
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "TRANSLATION_EXISTS", appErr.Code)
+	suite.trashRepo.AssertNotCalled(suite.T(), "RestoreArticle", mock.Anything, mock.Anything)
+}
+
+func (suite *TrashServiceTestSuite) TestRestore_CommentOnDeletedArticle() {
+	comment := &models.Comment{ID: uuid.New(), Article: &models.Article{DeletedAt: trashedAt(time.Now())}}
+	suite.trashRepo.On("FindComment", comment.ID).Return(comment, nil)
+
+	_, err := suite.service.Restore("comments", comment.ID.String())
+
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "ARTICLE_DELETED", appErr.Code)
+}
+
+func (suite *TrashServiceTestSuite) TestRestore_NotInTrash() {
+	id := uuid.New()
+	suite.trashRepo.On("FindUser", id).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := suite.service.Restore("users", id.String())
+
+	assert.Equal(suite.T(), utils.ErrNotFound, err)
+}
+
+func (suite *TrashServiceTestSuite) TestPurge_UserWithContent() {
+	id := uuid.New()
+	suite.trashRepo.On("FindUser", id).Return(&models.User{ID: id}, nil)
+	suite.trashRepo.On("PurgeUser", id).Return(repositories.ErrUserHasContent)
+
+	err := suite.service.Purge("users", id.String())
+
+	appErr, ok := utils.IsAppError(err)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "USER_HAS_CONTENT", appErr.Code)
+}
+
+func (suite *TrashServiceTestSuite) TestPurgeExpired_SkipsItemsThatCannotBePurged() {
+	stuckID := uuid.New()
+	purgedID := uuid.New()
+
+	suite.trashRepo.On("FindDeletedBefore", repositories.TrashArticles, mock.AnythingOfType("time.Time"), trashPurgeBatchSize, 0).Return([]uuid.UUID{}, nil)
+	suite.trashRepo.On("FindDeletedBefore", repositories.TrashComments, mock.AnythingOfType("time.Time"), trashPurgeBatchSize, 0).Return([]uuid.UUID{}, nil)
+	suite.trashRepo.On("FindDeletedBefore", repositories.TrashUsers, mock.AnythingOfType("time.Time"), trashPurgeBatchSize, 0).Return([]uuid.UUID{stuckID, purgedID}, nil)
+	suite.trashRepo.On("PurgeUser", stuckID).Return(repositories.ErrUserHasContent)
+	suite.trashRepo.On("PurgeUser", purgedID).Return(nil)
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, purged)
+}
+
+func (suite *TrashServiceTestSuite) TestPurgeExpired_DisabledWithoutRetention() {
+	service := NewTrashService(nil, suite.trashRepo, suite.articleRepo, suite.tagRepo, 0)
+
+	purged, err := service.PurgeExpired(context.Background())
+
+	assert.NoError(suite.T(), err)
+	assert.Zero(suite.T(), purged)
+	suite.trashRepo.AssertNotCalled(suite.T(), "FindDeletedBefore", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
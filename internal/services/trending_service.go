@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/config"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/alfafaa/alfafaa-blog/internal/trending"
+	"github.com/alfafaa/alfafaa-blog/internal/utils"
+	"github.com/google/uuid"
+)
+
+// TrendingService defines the interface for computing trending scores
+type TrendingService interface {
+	RefreshTrending(ctx context.Context) (int, error)
+}
+
+type trendingService struct {
+	trendingRepo repositories.TrendingRepository
+	params       trending.Params
+}
+
+// NewTrendingService creates a new trending service
+func NewTrendingService(trendingRepo repositories.TrendingRepository, cfg config.TrendingConfig) TrendingService {
+	return &trendingService{
+		trendingRepo: trendingRepo,
+		params: trending.Params{
+			Gravity:        cfg.Gravity,
+			ViewWeight:     cfg.ViewWeight,
+			LikeWeight:     cfg.LikeWeight,
+			CommentWeight:  cfg.CommentWeight,
+			BookmarkWeight: cfg.BookmarkWeight,
+		},
+	}
+}
+
+// RefreshTrending scores every article with activity in each trending window
+// from its daily totals and replaces the stored scores. It returns how many
+// scores were stored.
+func (s *trendingService) RefreshTrending(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	var longest time.Duration
+	for _, window := range trending.Windows {
+		longest = max(longest, window)
+	}
+
+	stats, err := s.trendingRepo.FindActivity(now.Add(-longest))
+	if err != nil {
+		return 0, utils.WrapError(err, "failed to load article activity")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	activity := make(map[uuid.UUID][]trending.Activity)
+	for _, stat := range stats {
+		activity[stat.ArticleID] = append(activity[stat.ArticleID], trending.Activity{
+			Day:       stat.Day.UTC(),
+			Views:     stat.Views,
+			Likes:     stat.Likes,
+			Comments:  stat.Comments,
+			Bookmarks: stat.Bookmarks,
+		})
+	}
+
+	var scores []models.TrendingScore
+	for name, window := range trending.Windows {
+		since := models.StatsDay(now.Add(-window))
+		for articleID, days := range activity {
+			var inWindow []trending.Activity
+			for _, day := range days {
+				if !day.Day.Before(since) {
+					inWindow = append(inWindow, day)
+				}
+			}
+
+			score := trending.Score(inWindow, now, s.params)
+			if score <= 0 {
+				continue
+			}
+			scores = append(scores, models.TrendingScore{
+				ArticleID:  articleID,
+				Window:     name,
+				Score:      score,
+				ComputedAt: now,
+			})
+		}
+	}
+
+	if err := s.trendingRepo.ReplaceAll(scores); err != nil {
+		return 0, utils.WrapError(err, "failed to save trending scores")
+	}
+
+	return len(scores), nil
+}
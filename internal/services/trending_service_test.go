@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/config"
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// helper to create trending service with mocks
+func newTestTrendingService() (TrendingService, *mocks.MockTrendingRepository) {
+	trendingRepo := new(mocks.MockTrendingRepository)
+	cfg := config.TrendingConfig{Gravity: 1.8, ViewWeight: 1, LikeWeight: 5, CommentWeight: 8, BookmarkWeight: 10}
+	return NewTrendingService(trendingRepo, cfg), trendingRepo
+}
+
+func TestRefreshTrending_ScoresEachWindow(t *testing.T) {
+	service, trendingRepo := newTestTrendingService()
+	today := models.StatsDay(time.Now())
+	fresh := uuid.New()
+	older := uuid.New()
+
+	trendingRepo.On("FindActivity", mock.AnythingOfType("time.Time")).Return([]models.ArticleDailyStat{
+		{ArticleID: fresh, Day: today, Views: 20},
+		{ArticleID: older, Day: today.AddDate(0, 0, -10), Views: 5000, Likes: 40},
+		{ArticleID: older, Day: today.AddDate(0, 0, -3), Views: 1},
+	}, nil)
+
+	var saved []models.TrendingScore
+	trendingRepo.On("ReplaceAll", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).([]models.TrendingScore)
+	}).Return(nil)
+
+	count, err := service.RefreshTrending(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(saved), count)
+
+	scores := make(map[string]map[uuid.UUID]float64)
+	for _, score := range saved {
+		if scores[score.Window] == nil {
+			scores[score.Window] = make(map[uuid.UUID]float64)
+		}
+		scores[score.Window][score.ArticleID] = score.Score
+	}
+
+	assert.Len(t, scores["24h"], 1, "only today's activity counts in the last 24 hours")
+	assert.Contains(t, scores["24h"], fresh)
+	assert.Len(t, scores["7d"], 2)
+	assert.Greater(t, scores["30d"][older], scores["7d"][older], "older activity adds to the 30 day score")
+}
+
+func TestRefreshTrending_CanceledBeforeSaving(t *testing.T) {
+	service, trendingRepo := newTestTrendingService()
+	trendingRepo.On("FindActivity", mock.Anything).Return([]models.ArticleDailyStat{}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := service.RefreshTrending(ctx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	trendingRepo.AssertNotCalled(t, "ReplaceAll", mock.Anything)
+}
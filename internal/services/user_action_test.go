@@ -5,6 +5,7 @@ import (
 
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
 	"github.com/alfafaa/alfafaa-blog/tests/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -161,15 +162,15 @@ func TestGetFollowers_Success(t *testing.T) {
 	service := NewUserService(mockUserRepo, mockArticleRepo)
 
 	userID := uuid.New()
-	followers := []models.User{
-		{ID: uuid.New(), Username: "follower1", FirstName: "John", LastName: "Doe"},
-		{ID: uuid.New(), Username: "follower2", FirstName: "Jane", LastName: "Doe"},
+	followers := []models.UserFollow{
+		{FollowingID: userID, Follower: &models.User{ID: uuid.New(), Username: "follower1", FirstName: "John", LastName: "Doe"}},
+		{FollowingID: userID, Follower: &models.User{ID: uuid.New(), Username: "follower2", FirstName: "Jane", LastName: "Doe"}},
 	}
 
 	query := &dto.PaginationQuery{Page: 1, PerPage: 20}
 
 	// Setup expectations
-	mockUserRepo.On("GetFollowers", userID, 20, 0).Return(followers, int64(2), nil)
+	mockUserRepo.On("GetFollowers", userID, 20, 0, (*repositories.Keyset)(nil)).Return(followers, int64(2), nil)
 
 	// Execute
 	result, err := service.GetFollowers(userID.String(), query)
@@ -190,14 +191,14 @@ func TestGetFollowing_Success(t *testing.T) {
 	service := NewUserService(mockUserRepo, mockArticleRepo)
 
 	userID := uuid.New()
-	following := []models.User{
-		{ID: uuid.New(), Username: "following1", FirstName: "John", LastName: "Doe"},
+	following := []models.UserFollow{
+		{FollowerID: userID, Following: &models.User{ID: uuid.New(), Username: "following1", FirstName: "John", LastName: "Doe"}},
 	}
 
 	query := &dto.PaginationQuery{Page: 1, PerPage: 20}
 
 	// Setup expectations
-	mockUserRepo.On("GetFollowing", userID, 20, 0).Return(following, int64(1), nil)
+	mockUserRepo.On("GetFollowing", userID, 20, 0, (*repositories.Keyset)(nil)).Return(following, int64(1), nil)
 
 	// Execute
 	result, err := service.GetFollowing(userID.String(), query)
@@ -283,7 +284,7 @@ func TestGetPersonalizedFeed_Success(t *testing.T) {
 	followingID := uuid.New()
 	interestID := uuid.New()
 
-	query := &dto.PaginationQuery{Page: 1, PerPage: 20}
+	query := &dto.FeedQuery{PaginationQuery: dto.PaginationQuery{Page: 1, PerPage: 20}}
 
 	author := &models.User{
 		ID:        followingID,
@@ -322,6 +323,27 @@ func TestGetPersonalizedFeed_Success(t *testing.T) {
 	mockArticleRepo.AssertExpectations(t)
 }
 
+func TestGetPersonalizedFeed_ExcludeRead(t *testing.T) {
+	mockUserRepo := new(mocks.MockUserRepository)
+	mockArticleRepo := new(mocks.MockArticleRepository)
+
+	service := NewUserService(mockUserRepo, mockArticleRepo)
+
+	userID := uuid.New()
+	query := &dto.FeedQuery{ExcludeRead: true}
+
+	mockUserRepo.On("GetFollowingIDs", userID).Return([]uuid.UUID{}, nil)
+	mockUserRepo.On("GetInterestIDs", userID).Return([]uuid.UUID{}, nil)
+	mockArticleRepo.On("FindForUser", userID, []uuid.UUID{}, []uuid.UUID{}, mock.MatchedBy(func(f repositories.ArticleFilters) bool {
+		return f.ExcludeReadBy != nil && *f.ExcludeReadBy == userID
+	})).Return([]models.Article{}, int64(0), nil)
+
+	_, _, err := service.GetPersonalizedFeed(userID.String(), query)
+
+	assert.NoError(t, err)
+	mockArticleRepo.AssertExpectations(t)
+}
+
 func TestGetStaffPicks_Success(t *testing.T) {
 	mockUserRepo := new(mocks.MockUserRepository)
 	mockArticleRepo := new(mocks.MockArticleRepository)
@@ -390,8 +412,8 @@ func TestGetUserProfile_Success(t *testing.T) {
 	// Setup expectations
 	mockUserRepo.On("FindByIDWithRelations", userID).Return(user, nil)
 	mockArticleRepo.On("FindByAuthor", userID, mock.AnythingOfType("repositories.ArticleFilters")).Return([]models.Article{}, int64(5), nil)
-	mockUserRepo.On("GetFollowers", userID, 0, 0).Return([]models.User{}, int64(10), nil)
-	mockUserRepo.On("GetFollowing", userID, 0, 0).Return([]models.User{}, int64(20), nil)
+	mockUserRepo.On("GetFollowers", userID, 0, 0, (*repositories.Keyset)(nil)).Return([]models.UserFollow{}, int64(10), nil)
+	mockUserRepo.On("GetFollowing", userID, 0, 0, (*repositories.Keyset)(nil)).Return([]models.UserFollow{}, int64(20), nil)
 	mockUserRepo.On("IsFollowing", currentUserID, userID).Return(true, nil)
 
 	// Execute
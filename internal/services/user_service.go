@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/alfafaa/alfafaa-blog/internal/cache"
 	"github.com/alfafaa/alfafaa-blog/internal/dto"
 	"github.com/alfafaa/alfafaa-blog/internal/models"
 	"github.com/alfafaa/alfafaa-blog/internal/repositories"
@@ -30,7 +31,7 @@ type UserService interface {
 	SetInterests(userID string, req *dto.SetInterestsRequest) ([]dto.CategoryResponse, error)
 	GetInterests(userID string) ([]dto.CategoryResponse, error)
 	// Feed methods
-	GetPersonalizedFeed(userID string, query *dto.PaginationQuery) ([]dto.ArticleListItemResponse, int64, error)
+	GetPersonalizedFeed(userID string, query *dto.FeedQuery) ([]dto.ArticleListItemResponse, int64, error)
 	GetStaffPicks(query *dto.PaginationQuery) ([]dto.ArticleListItemResponse, int64, error)
 }
 
@@ -38,20 +39,38 @@ type userService struct {
 	userRepo       repositories.UserRepository
 	articleRepo    repositories.ArticleRepository
 	engagementRepo repositories.EngagementRepository
+	readCache      *cache.ReadCache
 }
 
 // NewUserService creates a new user service
-func NewUserService(userRepo repositories.UserRepository, articleRepo repositories.ArticleRepository, engagementRepo ...repositories.EngagementRepository) UserService {
+func NewUserService(userRepo repositories.UserRepository, articleRepo repositories.ArticleRepository, opts ...UserServiceOption) UserService {
 	svc := &userService{
 		userRepo:    userRepo,
 		articleRepo: articleRepo,
 	}
-	if len(engagementRepo) > 0 {
-		svc.engagementRepo = engagementRepo[0]
+	for _, opt := range opts {
+		opt(svc)
 	}
 	return svc
 }
 
+// UserServiceOption is a functional option for configuring the user service
+type UserServiceOption func(*userService)
+
+// WithUserEngagementRepo sets the engagement repository on the user service (for feed signals)
+func WithUserEngagementRepo(repo repositories.EngagementRepository) UserServiceOption {
+	return func(s *userService) {
+		s.engagementRepo = repo
+	}
+}
+
+// WithUserReadCache caches staff picks, and drops them when articles change
+func WithUserReadCache(readCache *cache.ReadCache) UserServiceOption {
+	return func(s *userService) {
+		s.readCache = readCache
+	}
+}
+
 // GetUser retrieves a user by ID
 func (s *userService) GetUser(id string) (*dto.UserDetailResponse, error) {
 	userID, err := uuid.Parse(id)
@@ -295,12 +314,19 @@ func toArticleListItemResponse(article *models.Article) dto.ArticleListItemRespo
 		Excerpt:            article.Excerpt,
 		FeaturedImageURL:   article.FeaturedImageURL,
 		Status:             string(article.Status),
+		Visibility:         string(article.Visibility),
 		PublishedAt:        article.PublishedAt,
 		ViewCount:          article.ViewCount,
+		ReadCount:          article.ReadCount,
 		ReadingTimeMinutes: article.ReadingTimeMinutes,
+		Locale:             article.Locale,
 		CreatedAt:          article.CreatedAt,
 	}
 
+	if article.StaffPick != nil {
+		response.StaffPickNote = article.StaffPick.Note
+	}
+
 	if article.Author != nil {
 		response.Author = dto.PublicUserResponse{
 			ID:              article.Author.ID.String(),
@@ -352,8 +378,8 @@ func (s *userService) GetUserProfile(id string, currentUserID string) (*dto.User
 	})
 
 	// Get follower/following counts
-	_, followerCount, _ := s.userRepo.GetFollowers(userID, 0, 0)
-	_, followingCount, _ := s.userRepo.GetFollowing(userID, 0, 0)
+	_, followerCount, _ := s.userRepo.GetFollowers(userID, 0, 0, nil)
+	_, followingCount, _ := s.userRepo.GetFollowing(userID, 0, 0, nil)
 
 	// Check if current user is following this user
 	var isFollowing bool
@@ -485,26 +511,39 @@ func (s *userService) GetFollowers(userID string, query *dto.PaginationQuery) (*
 		return nil, utils.ErrBadRequest
 	}
 
-	users, total, err := s.userRepo.GetFollowers(userUUID, query.GetPerPage(), query.GetOffset())
+	cursor, err := queryKeyset(query, "")
+	if err != nil {
+		return nil, err
+	}
+
+	follows, total, err := s.userRepo.GetFollowers(userUUID, query.GetPerPage(), query.GetOffset(), cursor)
 	if err != nil {
 		return nil, utils.WrapError(err, "failed to get followers")
 	}
 
-	userResponses := make([]dto.PublicUserResponse, len(users))
-	for i, user := range users {
-		userResponses[i] = dto.PublicUserResponse{
-			ID:              user.ID.String(),
-			Username:        user.Username,
-			FirstName:       user.FirstName,
-			LastName:        user.LastName,
-			Bio:             user.Bio,
-			ProfileImageURL: user.ProfileImageURL,
+	userResponses := make([]dto.PublicUserResponse, 0, len(follows))
+	for _, follow := range follows {
+		if user := follow.Follower; user != nil {
+			userResponses = append(userResponses, dto.PublicUserResponse{
+				ID:              user.ID.String(),
+				Username:        user.Username,
+				FirstName:       user.FirstName,
+				LastName:        user.LastName,
+				Bio:             user.Bio,
+				ProfileImageURL: user.ProfileImageURL,
+			})
 		}
 	}
 
+	next := nextCursor(query, len(follows), func() *repositories.Keyset {
+		last := follows[len(follows)-1]
+		return repositories.NewTimeKeyset(last.CreatedAt, last.FollowerID)
+	})
+
 	return &dto.FollowListResponse{
-		Users: userResponses,
-		Total: total,
+		Users:      userResponses,
+		Total:      total,
+		NextCursor: next,
 	}, nil
 }
 
@@ -515,26 +554,39 @@ func (s *userService) GetFollowing(userID string, query *dto.PaginationQuery) (*
 		return nil, utils.ErrBadRequest
 	}
 
-	users, total, err := s.userRepo.GetFollowing(userUUID, query.GetPerPage(), query.GetOffset())
+	cursor, err := queryKeyset(query, "")
+	if err != nil {
+		return nil, err
+	}
+
+	follows, total, err := s.userRepo.GetFollowing(userUUID, query.GetPerPage(), query.GetOffset(), cursor)
 	if err != nil {
 		return nil, utils.WrapError(err, "failed to get following")
 	}
 
-	userResponses := make([]dto.PublicUserResponse, len(users))
-	for i, user := range users {
-		userResponses[i] = dto.PublicUserResponse{
-			ID:              user.ID.String(),
-			Username:        user.Username,
-			FirstName:       user.FirstName,
-			LastName:        user.LastName,
-			Bio:             user.Bio,
-			ProfileImageURL: user.ProfileImageURL,
+	userResponses := make([]dto.PublicUserResponse, 0, len(follows))
+	for _, follow := range follows {
+		if user := follow.Following; user != nil {
+			userResponses = append(userResponses, dto.PublicUserResponse{
+				ID:              user.ID.String(),
+				Username:        user.Username,
+				FirstName:       user.FirstName,
+				LastName:        user.LastName,
+				Bio:             user.Bio,
+				ProfileImageURL: user.ProfileImageURL,
+			})
 		}
 	}
 
+	next := nextCursor(query, len(follows), func() *repositories.Keyset {
+		last := follows[len(follows)-1]
+		return repositories.NewTimeKeyset(last.CreatedAt, last.FollowingID)
+	})
+
 	return &dto.FollowListResponse{
-		Users: userResponses,
-		Total: total,
+		Users:      userResponses,
+		Total:      total,
+		NextCursor: next,
 	}, nil
 }
 
@@ -588,8 +640,9 @@ func (s *userService) GetInterests(userID string) ([]dto.CategoryResponse, error
 	return responses, nil
 }
 
-// GetPersonalizedFeed returns articles personalized for the user
-func (s *userService) GetPersonalizedFeed(userID string, query *dto.PaginationQuery) ([]dto.ArticleListItemResponse, int64, error) {
+// GetPersonalizedFeed returns articles personalized for the user, optionally
+// leaving out articles they have finished reading
+func (s *userService) GetPersonalizedFeed(userID string, query *dto.FeedQuery) ([]dto.ArticleListItemResponse, int64, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, 0, utils.ErrBadRequest
@@ -606,11 +659,16 @@ func (s *userService) GetPersonalizedFeed(userID string, query *dto.PaginationQu
 		return nil, 0, utils.WrapError(err, "failed to get interests")
 	}
 
+	// Followers-only articles by followed authors belong in the feed
 	filters := repositories.ArticleFilters{
-		Status: string(models.StatusPublished),
-		Limit:  query.GetPerPage(),
-		Offset: query.GetOffset(),
-		Sort:   query.GetSort(),
+		Status:   string(models.StatusPublished),
+		ViewerID: &userUUID,
+		Limit:    query.GetPerPage(),
+		Offset:   query.GetOffset(),
+		Sort:     query.GetSort(),
+	}
+	if query.ExcludeRead {
+		filters.ExcludeReadBy = &userUUID
 	}
 
 	articles, total, err := s.articleRepo.FindForUser(userUUID, followingIDs, interestIDs, filters)
@@ -626,24 +684,37 @@ func (s *userService) GetPersonalizedFeed(userID string, query *dto.PaginationQu
 	return responses, total, nil
 }
 
-// GetStaffPicks returns articles marked as staff picks
+// GetStaffPicks returns the staff picks currently on display, in curated order
 func (s *userService) GetStaffPicks(query *dto.PaginationQuery) ([]dto.ArticleListItemResponse, int64, error) {
+	// Picks have their own order, so the sort parameter doesn't apply
 	filters := repositories.ArticleFilters{
 		Status: string(models.StatusPublished),
 		Limit:  query.GetPerPage(),
 		Offset: query.GetOffset(),
-		Sort:   query.GetSort(),
 	}
 
-	articles, total, err := s.articleRepo.FindStaffPicks(filters)
+	key := fmt.Sprintf("%sstaff-picks:%d:%d", cache.ArticleReads, filters.Limit, filters.Offset)
+	page, err := cache.Remember(s.readCache, key, func() (staffPicksPage, error) {
+		articles, total, err := s.articleRepo.FindStaffPicks(filters)
+		if err != nil {
+			return staffPicksPage{}, utils.WrapError(err, "failed to get staff picks")
+		}
+
+		responses := make([]dto.ArticleListItemResponse, len(articles))
+		for i, article := range articles {
+			responses[i] = toArticleListItemResponse(&article)
+		}
+		return staffPicksPage{Items: responses, Total: total}, nil
+	})
 	if err != nil {
-		return nil, 0, utils.WrapError(err, "failed to get staff picks")
+		return nil, 0, err
 	}
 
-	responses := make([]dto.ArticleListItemResponse, len(articles))
-	for i, article := range articles {
-		responses[i] = toArticleListItemResponse(&article)
-	}
+	return page.Items, page.Total, nil
+}
 
-	return responses, total, nil
+// staffPicksPage is the cached form of a page of staff picks
+type staffPicksPage struct {
+	Items []dto.ArticleListItemResponse `json:"items"`
+	Total int64                         `json:"total"`
 }
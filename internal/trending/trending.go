@@ -0,0 +1,66 @@
+// Package trending scores how much attention published articles are getting.
+// An article's score adds up its weighted engagement day by day, and each
+// day's engagement is divided by its age raised to a gravity, as on Hacker
+// News, so that activity fades quickly and old articles drop out unless
+// readers keep coming back to them.
+package trending
+
+import (
+	"math"
+	"time"
+)
+
+// Windows are the periods trending is computed over, by name
+var Windows = map[string]time.Duration{
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+}
+
+// DefaultWindow is the window used when none is asked for
+const DefaultWindow = "7d"
+
+// ageOffset is added to the age in hours, so that brand new activity doesn't
+// outweigh everything else
+const ageOffset = 2
+
+// Params tune the score
+type Params struct {
+	Gravity        float64 // How fast engagement fades; higher favours the most recent activity
+	ViewWeight     float64
+	LikeWeight     float64
+	CommentWeight  float64
+	BookmarkWeight float64
+}
+
+// Activity is an article's engagement on one day (UTC)
+type Activity struct {
+	Day       time.Time
+	Views     int
+	Likes     int
+	Comments  int
+	Bookmarks int
+}
+
+// Score returns the trending score of an article's activity at the given time.
+// Each day's activity is dated to the middle of the day, or to now for today.
+func Score(activity []Activity, now time.Time, params Params) float64 {
+	var score float64
+	for _, day := range activity {
+		points := float64(day.Views)*params.ViewWeight +
+			float64(day.Likes)*params.LikeWeight +
+			float64(day.Comments)*params.CommentWeight +
+			float64(day.Bookmarks)*params.BookmarkWeight
+		if points <= 0 {
+			continue
+		}
+
+		at := day.Day.Add(12 * time.Hour)
+		if at.After(now) {
+			at = now
+		}
+		age := now.Sub(at).Hours()
+		score += points / math.Pow(age+ageOffset, params.Gravity)
+	}
+	return score
+}
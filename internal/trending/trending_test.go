@@ -0,0 +1,59 @@
+package trending
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testParams = Params{Gravity: 1.8, ViewWeight: 1, LikeWeight: 5, CommentWeight: 8, BookmarkWeight: 10}
+
+func day(now time.Time, daysAgo int) time.Time {
+	year, month, d := now.AddDate(0, 0, -daysAgo).Date()
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestScore_RecentActivityOutweighsOld(t *testing.T) {
+	now := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+
+	recent := Score([]Activity{{Day: day(now, 0), Views: 100}}, now, testParams)
+	old := Score([]Activity{{Day: day(now, 5), Views: 1000}}, now, testParams)
+
+	assert.Greater(t, recent, old)
+}
+
+func TestScore_EngagementWeighted(t *testing.T) {
+	now := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+
+	views := Score([]Activity{{Day: day(now, 1), Views: 10}}, now, testParams)
+	bookmarks := Score([]Activity{{Day: day(now, 1), Bookmarks: 1}}, now, testParams)
+	assert.InDelta(t, views, bookmarks, 1e-9)
+
+	both := Score([]Activity{{Day: day(now, 1), Views: 10, Likes: 2}}, now, testParams)
+	assert.Greater(t, both, views)
+}
+
+func TestScore_SumsDaysAndIgnoresEmpty(t *testing.T) {
+	now := time.Date(2026, 3, 10, 6, 0, 0, 0, time.UTC)
+	today := Score([]Activity{{Day: day(now, 0), Views: 10}}, now, testParams)
+	yesterday := Score([]Activity{{Day: day(now, 1), Views: 10}}, now, testParams)
+
+	total := Score([]Activity{
+		{Day: day(now, 0), Views: 10},
+		{Day: day(now, 1), Views: 10},
+		{Day: day(now, 2)},
+	}, now, testParams)
+
+	assert.InDelta(t, today+yesterday, total, 1e-9)
+	assert.Zero(t, Score(nil, now, testParams))
+}
+
+func TestScore_HigherGravityFavoursRecent(t *testing.T) {
+	now := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+	activity := []Activity{{Day: day(now, 3), Views: 100}}
+
+	low := testParams
+	low.Gravity = 1.2
+	assert.Greater(t, Score(activity, now, low), Score(activity, now, testParams))
+}
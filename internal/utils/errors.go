@@ -19,19 +19,19 @@ func (e *AppError) Error() string {
 
 // Common application errors
 var (
-	ErrNotFound         = &AppError{Code: "NOT_FOUND", Message: "Resource not found", Status: 404}
-	ErrUnauthorized     = &AppError{Code: "UNAUTHORIZED", Message: "Unauthorized access", Status: 401}
-	ErrForbidden        = &AppError{Code: "FORBIDDEN", Message: "Access forbidden", Status: 403}
-	ErrValidation       = &AppError{Code: "VALIDATION_ERROR", Message: "Validation failed", Status: 400}
-	ErrBadRequest       = &AppError{Code: "BAD_REQUEST", Message: "Bad request", Status: 400}
-	ErrInternal         = &AppError{Code: "INTERNAL_ERROR", Message: "Internal server error", Status: 500}
-	ErrConflict         = &AppError{Code: "CONFLICT", Message: "Resource already exists", Status: 409}
-	ErrInvalidToken     = &AppError{Code: "INVALID_TOKEN", Message: "Invalid or expired token", Status: 401}
+	ErrNotFound           = &AppError{Code: "NOT_FOUND", Message: "Resource not found", Status: 404}
+	ErrUnauthorized       = &AppError{Code: "UNAUTHORIZED", Message: "Unauthorized access", Status: 401}
+	ErrForbidden          = &AppError{Code: "FORBIDDEN", Message: "Access forbidden", Status: 403}
+	ErrValidation         = &AppError{Code: "VALIDATION_ERROR", Message: "Validation failed", Status: 400}
+	ErrBadRequest         = &AppError{Code: "BAD_REQUEST", Message: "Bad request", Status: 400}
+	ErrInternal           = &AppError{Code: "INTERNAL_ERROR", Message: "Internal server error", Status: 500}
+	ErrConflict           = &AppError{Code: "CONFLICT", Message: "Resource already exists", Status: 409}
+	ErrInvalidToken       = &AppError{Code: "INVALID_TOKEN", Message: "Invalid or expired token", Status: 401}
 	ErrInvalidCredentials = &AppError{Code: "INVALID_CREDENTIALS", Message: "Invalid email or password", Status: 401}
-	ErrEmailExists      = &AppError{Code: "EMAIL_EXISTS", Message: "Email already registered", Status: 409}
-	ErrUsernameExists   = &AppError{Code: "USERNAME_EXISTS", Message: "Username already taken", Status: 409}
-	ErrFileTooLarge     = &AppError{Code: "FILE_TOO_LARGE", Message: "File size exceeds limit", Status: 400}
-	ErrInvalidFileType  = &AppError{Code: "INVALID_FILE_TYPE", Message: "Invalid file type", Status: 400}
+	ErrEmailExists        = &AppError{Code: "EMAIL_EXISTS", Message: "Email already registered", Status: 409}
+	ErrUsernameExists     = &AppError{Code: "USERNAME_EXISTS", Message: "Username already taken", Status: 409}
+	ErrFileTooLarge       = &AppError{Code: "FILE_TOO_LARGE", Message: "File size exceeds limit", Status: 400}
+	ErrInvalidFileType    = &AppError{Code: "INVALID_FILE_TYPE", Message: "Invalid file type", Status: 400}
 )
 
 // NewAppError creates a new application error with a custom message
@@ -64,3 +64,46 @@ func GetStatusCode(err error) int {
 	}
 	return 500
 }
+
+// RedirectError signals that a resource has moved permanently to a new slug
+type RedirectError struct {
+	Slug string
+}
+
+// Error implements the error interface
+func (e *RedirectError) Error() string {
+	return "resource moved to " + e.Slug
+}
+
+// NewRedirectError creates a redirect to the resource's current slug
+func NewRedirectError(slug string) *RedirectError {
+	return &RedirectError{Slug: slug}
+}
+
+// IsRedirectError checks if an error is a RedirectError
+func IsRedirectError(err error) (*RedirectError, bool) {
+	var redirectErr *RedirectError
+	if errors.As(err, &redirectErr) {
+		return redirectErr, true
+	}
+	return nil, false
+}
+
+// VersionConflictError signals that a resource changed since the client loaded it
+type VersionConflictError struct {
+	CurrentVersion int
+}
+
+// Error implements the error interface
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("resource was modified; current version is %d", e.CurrentVersion)
+}
+
+// IsVersionConflictError checks if an error is a VersionConflictError
+func IsVersionConflictError(err error) (*VersionConflictError, bool) {
+	var conflictErr *VersionConflictError
+	if errors.As(err, &conflictErr) {
+		return conflictErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// VersionETag formats a resource version as a strong ETag, e.g. "v3"
+func VersionETag(version int) string {
+	return `"v` + strconv.Itoa(version) + `"`
+}
+
+// ParseVersionETag extracts the version from an If-Match header value.
+// It accepts "v3", v3 and bare numbers, and content ETags that start with a
+// version, such as "v3-9f86d081". Wildcards and lists are rejected, because
+// they do not name a single version.
+func ParseVersionETag(value string) (int, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "*" || strings.Contains(value, ",") {
+		return 0, false
+	}
+
+	value = strings.TrimPrefix(value, "W/")
+	value = strings.Trim(value, `"`)
+	value = strings.TrimPrefix(value, "v")
+	if i := strings.IndexByte(value, '-'); i >= 0 {
+		value = value[:i]
+	}
+
+	version, err := strconv.Atoi(value)
+	if err != nil || version < 1 {
+		return 0, false
+	}
+	return version, true
+}
+
+// ContentETag formats a strong ETag from a response body. An ETag the
+// handler already set, such as a version ETag, is kept as its prefix, e.g.
+// "v3-9f86d081884c7d65".
+func ContentETag(current string, body []byte) string {
+	sum := sha256.Sum256(body)
+	tag := hex.EncodeToString(sum[:8])
+
+	current = strings.Trim(strings.TrimPrefix(current, "W/"), `"`)
+	if current != "" {
+		tag = current + "-" + tag
+	}
+	return `"` + tag + `"`
+}
+
+// ETagMatches reports whether an If-None-Match header value names the ETag.
+// It accepts lists and the * wildcard, and compares weakly, as RFC 9110 asks.
+func ETagMatches(header, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
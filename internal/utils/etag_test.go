@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionETag(t *testing.T) {
+	assert.Equal(t, `"v3"`, VersionETag(3))
+}
+
+func TestParseVersionETag(t *testing.T) {
+	tests := []struct {
+		input   string
+		version int
+		ok      bool
+	}{
+		{`"v3"`, 3, true},
+		{`v12`, 12, true},
+		{`7`, 7, true},
+		{`W/"v2"`, 2, true},
+		{`"v4-9f86d081884c7d65"`, 4, true},
+		{`*`, 0, false},
+		{`"v1", "v2"`, 0, false},
+		{`"abc"`, 0, false},
+		{`"v0"`, 0, false},
+		{``, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			version, ok := ParseVersionETag(tt.input)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.version, version)
+		})
+	}
+}
+
+func TestContentETag(t *testing.T) {
+	etag := ContentETag("", []byte("body"))
+	assert.Regexp(t, `^"[0-9a-f]{16}"$`, etag)
+	assert.Equal(t, etag, ContentETag("", []byte("body")))
+	assert.NotEqual(t, etag, ContentETag("", []byte("other body")))
+
+	versioned := ContentETag(VersionETag(3), []byte("body"))
+	assert.Regexp(t, `^"v3-[0-9a-f]{16}"$`, versioned)
+	version, ok := ParseVersionETag(versioned)
+	assert.True(t, ok)
+	assert.Equal(t, 3, version)
+}
+
+func TestETagMatches(t *testing.T) {
+	assert.True(t, ETagMatches(`"abc"`, `"abc"`))
+	assert.True(t, ETagMatches(`W/"abc"`, `"abc"`))
+	assert.True(t, ETagMatches(`"x", "abc"`, `"abc"`))
+	assert.True(t, ETagMatches(`*`, `"abc"`))
+	assert.False(t, ETagMatches(`"abd"`, `"abc"`))
+	assert.False(t, ETagMatches(``, `"abc"`))
+}
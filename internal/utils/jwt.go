@@ -14,6 +14,7 @@ type TokenType string
 const (
 	AccessToken  TokenType = "access"
 	RefreshToken TokenType = "refresh"
+	PreviewToken TokenType = "preview"
 )
 
 // JWTClaims represents the claims in a JWT token
@@ -126,3 +127,53 @@ func ValidateRefreshToken(tokenString, secret string) (*JWTClaims, error) {
 
 	return claims, nil
 }
+
+// PreviewClaims represents the claims in an article preview token.
+// The registered ID claim holds the preview link ID so links can be revoked.
+type PreviewClaims struct {
+	ArticleID string    `json:"article_id"`
+	TokenType TokenType `json:"token_type"`
+	jwt.RegisteredClaims
+}
+
+// GeneratePreviewToken generates a signed token for an article preview link
+func GeneratePreviewToken(previewID, articleID uuid.UUID, secret string, expiresAt time.Time) (string, error) {
+	claims := PreviewClaims{
+		ArticleID: articleID.String(),
+		TokenType: PreviewToken,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        previewID.String(),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    "alfafaa-blog",
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(secret))
+}
+
+// ValidatePreviewToken validates an article preview token and returns the claims
+func ValidatePreviewToken(tokenString, secret string) (*PreviewClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &PreviewClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(secret), nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*PreviewClaims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token claims")
+	}
+
+	if claims.TokenType != PreviewToken {
+		return nil, errors.New("invalid token type")
+	}
+
+	return claims, nil
+}
@@ -207,3 +207,47 @@ func TestJWTClaims_Subject(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, userID.String(), claims.Subject)
 }
+
+func TestValidatePreviewToken_Success(t *testing.T) {
+	previewID := uuid.New()
+	articleID := uuid.New()
+
+	token, err := GeneratePreviewToken(previewID, articleID, testSecret, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	claims, err := ValidatePreviewToken(token, testSecret)
+
+	assert.NoError(t, err)
+	assert.Equal(t, previewID.String(), claims.ID)
+	assert.Equal(t, articleID.String(), claims.ArticleID)
+}
+
+func TestValidatePreviewToken_Expired(t *testing.T) {
+	token, err := GeneratePreviewToken(uuid.New(), uuid.New(), testSecret, time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+
+	claims, err := ValidatePreviewToken(token, testSecret)
+
+	assert.Error(t, err)
+	assert.Nil(t, claims)
+}
+
+func TestValidatePreviewToken_WithAccessToken(t *testing.T) {
+	token, _, err := GenerateToken(uuid.New(), "test@example.com", "reader", testSecret, time.Hour, AccessToken)
+	assert.NoError(t, err)
+
+	claims, err := ValidatePreviewToken(token, testSecret)
+
+	assert.Error(t, err)
+	assert.Nil(t, claims)
+}
+
+func TestValidateAccessToken_WithPreviewToken(t *testing.T) {
+	token, err := GeneratePreviewToken(uuid.New(), uuid.New(), testSecret, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	claims, err := ValidateAccessToken(token, testSecret)
+
+	assert.Error(t, err)
+	assert.Nil(t, claims)
+}
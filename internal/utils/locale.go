@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is the locale of articles that don't specify one
+const DefaultLocale = "en"
+
+// NormalizeLocale parses a BCP 47 language tag and returns its canonical form
+// (e.g. "pt_br" becomes "pt-BR"). An empty string yields DefaultLocale.
+func NormalizeLocale(locale string) (string, error) {
+	if locale == "" {
+		return DefaultLocale, nil
+	}
+	tag, err := language.Parse(strings.ReplaceAll(locale, "_", "-"))
+	if err != nil {
+		return "", err
+	}
+	return tag.String(), nil
+}
+
+// BaseLanguage returns the language subtag of a locale (e.g. "pt" for "pt-BR")
+func BaseLanguage(locale string) string {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return ""
+	}
+	base, _ := tag.Base()
+	return base.String()
+}
+
+// ParseLocalePreferences returns the reader's locale preferences, most preferred first.
+// An explicit lang value wins over the Accept-Language header. Invalid input is ignored.
+func ParseLocalePreferences(lang, acceptLanguage string) []language.Tag {
+	if lang != "" {
+		if tag, err := language.Parse(strings.ReplaceAll(lang, "_", "-")); err == nil {
+			return []language.Tag{tag}
+		}
+	}
+	if acceptLanguage != "" {
+		if tags, _, err := language.ParseAcceptLanguage(acceptLanguage); err == nil {
+			return tags
+		}
+	}
+	return nil
+}
+
+// MatchLocale picks the available locale that best fits the preferences and returns
+// its index. available[0] is the fallback when nothing matches, so callers should
+// put the locale they would serve anyway first. Weak matches, such as guessing
+// English for a reader in Pakistan, count as no match.
+func MatchLocale(preferences []language.Tag, available []string) int {
+	if len(preferences) == 0 || len(available) < 2 {
+		return 0
+	}
+
+	tags := make([]language.Tag, len(available))
+	for i, locale := range available {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			tag = language.Und
+		}
+		tags[i] = tag
+	}
+
+	_, index, confidence := language.NewMatcher(tags).Match(preferences...)
+	if confidence < language.High {
+		return 0
+	}
+	return index
+}
+
+// AcceptsLocale checks if a locale satisfies any of the preferences, e.g. an "en"
+// article for a reader who prefers "en-GB"
+func AcceptsLocale(preferences []language.Tag, locale string) bool {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return false
+	}
+	_, _, confidence := language.NewMatcher([]language.Tag{tag}).Match(preferences...)
+	return confidence >= language.High
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "empty uses default", input: "", expected: "en"},
+		{name: "language only", input: "ur", expected: "ur"},
+		{name: "lowercase region", input: "pt-br", expected: "pt-BR"},
+		{name: "underscore separator", input: "en_GB", expected: "en-GB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NormalizeLocale(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNormalizeLocale_Invalid(t *testing.T) {
+	_, err := NormalizeLocale("not a locale")
+	assert.Error(t, err)
+}
+
+func TestBaseLanguage(t *testing.T) {
+	assert.Equal(t, "pt", BaseLanguage("pt-BR"))
+	assert.Equal(t, "ur", BaseLanguage("ur"))
+	assert.Equal(t, "", BaseLanguage("???"))
+}
+
+func TestParseLocalePreferences_LangWins(t *testing.T) {
+	prefs := ParseLocalePreferences("ar", "en-US,en;q=0.9")
+
+	assert.Len(t, prefs, 1)
+	assert.Equal(t, "ar", prefs[0].String())
+}
+
+func TestParseLocalePreferences_AcceptLanguage(t *testing.T) {
+	prefs := ParseLocalePreferences("", "fr;q=0.5, ur-PK")
+
+	assert.Len(t, prefs, 2)
+	assert.Equal(t, "ur-PK", prefs[0].String())
+	assert.Equal(t, "fr", prefs[1].String())
+}
+
+func TestParseLocalePreferences_Invalid(t *testing.T) {
+	assert.Empty(t, ParseLocalePreferences("", ""))
+	assert.Empty(t, ParseLocalePreferences("", ";;;q=x"))
+}
+
+func TestMatchLocale(t *testing.T) {
+	available := []string{"en", "ur", "pt-BR"}
+
+	tests := []struct {
+		name     string
+		lang     string
+		header   string
+		expected int
+	}{
+		{name: "no preference keeps fallback", expected: 0},
+		{name: "exact match", lang: "ur", expected: 1},
+		{name: "regional variant matches base", header: "ur-PK", expected: 1},
+		{name: "base matches regional variant", lang: "pt", expected: 2},
+		{name: "second choice", header: "de, pt-BR;q=0.8", expected: 2},
+		{name: "no match falls back", lang: "ja", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MatchLocale(ParseLocalePreferences(tt.lang, tt.header), available)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestAcceptsLocale(t *testing.T) {
+	prefs := ParseLocalePreferences("", "en-GB, ur;q=0.8")
+
+	assert.True(t, AcceptsLocale(prefs, "en"))
+	assert.True(t, AcceptsLocale(prefs, "ur"))
+	assert.False(t, AcceptsLocale(prefs, "ar"))
+	assert.False(t, AcceptsLocale(nil, "en"))
+	// A region alone is not enough to accept a language spoken there
+	assert.False(t, AcceptsLocale(ParseLocalePreferences("", "ur-PK"), "en"))
+}
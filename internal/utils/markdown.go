@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+)
+
+var (
+	// markdown renders CommonMark with GFM extensions (tables, strikethrough, autolinks, task lists).
+	// Raw HTML is passed through and removed later by the sanitizer.
+	markdown = goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+		goldmark.WithRendererOptions(html.WithUnsafe()),
+	)
+
+	// markdownPolicy is the UGC policy plus language classes on fenced code blocks
+	markdownPolicy = newMarkdownPolicy()
+)
+
+func newMarkdownPolicy() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[a-zA-Z0-9_+\-]+$`)).OnElements("code")
+	// The UGC policy drops ids without ASCII letters or digits, such as anchors of Urdu headings
+	policy.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{M}\p{N}_-]+$`)).
+		OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	return policy
+}
+
+// headingIDs generates heading anchors that keep letters of any script,
+// where goldmark's default drops everything but ASCII. Headings without
+// letters or digits are numbered section-N by their position.
+type headingIDs struct {
+	used     map[string]bool
+	headings int
+}
+
+func newHeadingIDs() *headingIDs {
+	return &headingIDs{used: map[string]bool{}}
+}
+
+// Generate implements parser.IDs
+func (h *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	if kind == ast.KindHeading {
+		h.headings++
+	}
+
+	var sb strings.Builder
+	for _, r := range strings.TrimSpace(string(value)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r):
+			sb.WriteRune(unicode.ToLower(r))
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			sb.WriteByte('-')
+		}
+	}
+	id := strings.Trim(sb.String(), "-")
+	if id == "" {
+		id = fmt.Sprintf("section-%d", h.headings)
+	}
+
+	unique := id
+	for i := 1; h.used[unique]; i++ {
+		unique = fmt.Sprintf("%s-%d", id, i)
+	}
+	h.used[unique] = true
+	return []byte(unique)
+}
+
+// Put implements parser.IDs
+func (h *headingIDs) Put(value []byte) {
+	h.used[string(value)] = true
+}
+
+// TOCEntry is a heading in the table of contents built by RenderMarkdown
+type TOCEntry struct {
+	Level  int    `json:"level"`
+	Text   string `json:"text"`
+	Anchor string `json:"anchor"`
+}
+
+// RenderMarkdown converts Markdown to sanitized HTML and builds a table of contents
+// from its headings. Heading anchors match the id attributes in the rendered HTML.
+func RenderMarkdown(source string) (string, []TOCEntry, error) {
+	src := []byte(source)
+	ctx := parser.NewContext(parser.WithIDs(newHeadingIDs()))
+	doc := markdown.Parser().Parse(text.NewReader(src), parser.WithContext(ctx))
+
+	toc := []TOCEntry{}
+	err := ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		heading, ok := node.(*ast.Heading)
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+		anchor, _ := heading.AttributeString("id")
+		id, _ := anchor.([]byte)
+		toc = append(toc, TOCEntry{
+			Level:  heading.Level,
+			Text:   strings.TrimSpace(nodeText(heading, src)),
+			Anchor: string(id),
+		})
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := markdown.Renderer().Render(&buf, src, doc); err != nil {
+		return "", nil, err
+	}
+
+	return strings.TrimSpace(markdownPolicy.Sanitize(buf.String())), toc, nil
+}
+
+// nodeText collects the plain text of an inline node tree
+func nodeText(node ast.Node, source []byte) string {
+	var sb strings.Builder
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		switch n := child.(type) {
+		case *ast.Text:
+			sb.Write(n.Value(source))
+			if n.SoftLineBreak() || n.HardLineBreak() {
+				sb.WriteByte(' ')
+			}
+		case *ast.String:
+			sb.Write(n.Value)
+		default:
+			sb.WriteString(nodeText(child, source))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderMarkdown_BasicFormatting(t *testing.T) {
+	html, _, err := RenderMarkdown("Some **bold** and *italic* text with a [link](https://example.com).")
+
+	assert.NoError(t, err)
+	assert.Contains(t, html, "<strong>bold</strong>")
+	assert.Contains(t, html, "<em>italic</em>")
+	assert.Contains(t, html, `href="https://example.com"`)
+}
+
+func TestRenderMarkdown_GFMTable(t *testing.T) {
+	source := "| Name | Value |\n|:-----|------:|\n| a | 1 |\n"
+
+	html, _, err := RenderMarkdown(source)
+
+	assert.NoError(t, err)
+	assert.Contains(t, html, "<table>")
+	assert.Contains(t, html, "<th")
+	assert.Contains(t, html, "<td")
+}
+
+func TestRenderMarkdown_FencedCode(t *testing.T) {
+	source := "```go\nfmt.Println(\"hi\")\n```\n"
+
+	html, _, err := RenderMarkdown(source)
+
+	assert.NoError(t, err)
+	assert.Contains(t, html, `<pre><code class="language-go">`)
+}
+
+func TestRenderMarkdown_StripsUnsafeHTML(t *testing.T) {
+	source := "Hello <script>alert('xss')</script>\n\n<a href=\"javascript:alert(1)\" onclick=\"x()\">click</a>"
+
+	html, _, err := RenderMarkdown(source)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, html, "<script>")
+	assert.NotContains(t, html, "javascript:")
+	assert.NotContains(t, html, "onclick")
+}
+
+func TestRenderMarkdown_TableOfContents(t *testing.T) {
+	source := "# Getting Started\n\nIntro\n\n## Install `go`\n\nSteps\n\n## Getting Started\n"
+
+	html, toc, err := RenderMarkdown(source)
+
+	assert.NoError(t, err)
+	assert.Len(t, toc, 3)
+
+	assert.Equal(t, 1, toc[0].Level)
+	assert.Equal(t, "Getting Started", toc[0].Text)
+	assert.Equal(t, "getting-started", toc[0].Anchor)
+
+	assert.Equal(t, 2, toc[1].Level)
+	assert.Equal(t, "Install go", toc[1].Text)
+
+	// Duplicate headings get unique anchors
+	assert.NotEqual(t, toc[0].Anchor, toc[2].Anchor)
+
+	// Anchors survive sanitization
+	for _, entry := range toc {
+		assert.Contains(t, html, `id="`+entry.Anchor+`"`)
+	}
+}
+
+func TestRenderMarkdown_UnicodeHeadingAnchors(t *testing.T) {
+	source := "## تعارف اور پس منظر\n\n## Café déjà vu\n\n## ***\n\n## 🎉\n\n## Café déjà vu\n"
+
+	html, toc, err := RenderMarkdown(source)
+
+	assert.NoError(t, err)
+	assert.Len(t, toc, 5)
+	assert.Equal(t, "تعارف-اور-پس-منظر", toc[0].Anchor)
+	assert.Equal(t, "café-déjà-vu", toc[1].Anchor)
+	assert.Equal(t, "section-4", toc[3].Anchor, "headings without letters are numbered")
+	assert.Equal(t, "café-déjà-vu-1", toc[4].Anchor)
+
+	// Anchors survive sanitization
+	for _, entry := range toc {
+		assert.Contains(t, html, `id="`+entry.Anchor+`"`)
+	}
+}
+
+func TestRenderMarkdown_Empty(t *testing.T) {
+	html, toc, err := RenderMarkdown("")
+
+	assert.NoError(t, err)
+	assert.Empty(t, html)
+	assert.Empty(t, toc)
+}
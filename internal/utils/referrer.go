@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ReferrerHost returns the host name of a Referer URL, lowercased and without
+// port or "www." prefix, so that the same site is always reported the same way.
+// It returns an empty string for anything other than an http(s) URL.
+func ReferrerHost(referer string) string {
+	u, err := url.Parse(strings.TrimSpace(referer))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	host := strings.ToLower(u.Hostname())
+	host = strings.TrimPrefix(host, "www.")
+	if len(host) > 255 {
+		return ""
+	}
+	return host
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReferrerHost(t *testing.T) {
+	tests := []struct {
+		referer string
+		host    string
+	}{
+		{"https://news.ycombinator.com/item?id=1", "news.ycombinator.com"},
+		{"https://www.Google.com/", "google.com"},
+		{"http://localhost:3000/articles/hello", "localhost"},
+		{"android-app://com.slack/", ""},
+		{"not a url", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.host, ReferrerHost(tt.referer), tt.referer)
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -41,12 +42,14 @@ type ValidationError struct {
 	Message string `json:"message"`
 }
 
-// Meta contains pagination metadata
+// Meta contains pagination metadata. Pages fetched by cursor leave the page
+// and totals at zero, because they aren't counted.
 type Meta struct {
-	Page       int   `json:"page"`
-	PerPage    int   `json:"per_page"`
-	Total      int64 `json:"total"`
-	TotalPages int   `json:"total_pages"`
+	Page       int    `json:"page"`
+	PerPage    int    `json:"per_page"`
+	Total      int64  `json:"total"`
+	TotalPages int    `json:"total_pages"`
+	NextCursor string `json:"next_cursor,omitempty"` // Pass as ?cursor= to fetch the next page
 }
 
 // SuccessResponse sends a success response
@@ -82,6 +85,14 @@ func ErrorResponseJSON(c *gin.Context, status int, code, message string, details
 
 // HandleError handles an error and sends appropriate response
 func HandleError(c *gin.Context, err error) {
+	if redirectErr, ok := IsRedirectError(err); ok {
+		RedirectResponse(c, redirectErr.Slug)
+		return
+	}
+	if conflictErr, ok := IsVersionConflictError(err); ok {
+		VersionConflictResponseJSON(c, conflictErr.CurrentVersion)
+		return
+	}
 	if appErr, ok := IsAppError(err); ok {
 		ErrorResponseJSON(c, appErr.Status, appErr.Code, appErr.Message, nil)
 		return
@@ -90,6 +101,71 @@ func HandleError(c *gin.Context, err error) {
 	ErrorResponseJSON(c, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred", nil)
 }
 
+// VersionConflictResponse represents a 409 response for a stale update
+type VersionConflictResponse struct {
+	Success        bool         `json:"success"`
+	Error          ErrorDetails `json:"error"`
+	CurrentVersion int          `json:"current_version"`
+}
+
+// VersionConflictResponseJSON sends a 409 carrying the current version, both in
+// the body and as the ETag, so the client can reload and retry
+func VersionConflictResponseJSON(c *gin.Context, currentVersion int) {
+	c.Header("ETag", VersionETag(currentVersion))
+	c.JSON(http.StatusConflict, VersionConflictResponse{
+		Success: false,
+		Error: ErrorDetails{
+			Code:    "VERSION_CONFLICT",
+			Message: "The resource was modified by someone else; reload and try again",
+		},
+		CurrentVersion: currentVersion,
+	})
+}
+
+// RedirectDetails describes where a moved resource can now be found
+type RedirectDetails struct {
+	Slug     string `json:"slug"`
+	Location string `json:"location"`
+}
+
+// RedirectResponse sends a 301 pointing to the same route with the slug path
+// segment replaced by the resource's current slug
+func RedirectResponse(c *gin.Context, slug string) {
+	location := redirectLocation(c, slug)
+	c.Header("Location", location)
+	c.JSON(http.StatusMovedPermanently, Response{
+		Success: true,
+		Message: "Resource has moved permanently",
+		Data: RedirectDetails{
+			Slug:     slug,
+			Location: location,
+		},
+	})
+}
+
+// redirectLocation builds the redirect target from the current request URL
+func redirectLocation(c *gin.Context, slug string) string {
+	if c.Request == nil {
+		return slug
+	}
+
+	segments := strings.Split(c.Request.URL.Path, "/")
+	if oldSlug := c.Param("slug"); oldSlug != "" {
+		for i := len(segments) - 1; i >= 0; i-- {
+			if segments[i] == oldSlug {
+				segments[i] = slug
+				break
+			}
+		}
+	}
+
+	location := strings.Join(segments, "/")
+	if c.Request.URL.RawQuery != "" {
+		location += "?" + c.Request.URL.RawQuery
+	}
+	return location
+}
+
 // HandleValidationError handles validation errors
 func HandleValidationError(c *gin.Context, errors []ValidationError) {
 	ErrorResponseJSON(c, http.StatusBadRequest, "VALIDATION_ERROR", "Validation failed", errors)
@@ -116,3 +192,11 @@ func NewMeta(page, perPage int, total int64) *Meta {
 		TotalPages: CalculateTotalPages(total, perPage),
 	}
 }
+
+// NewCursorMeta creates a Meta object for a page fetched by cursor
+func NewCursorMeta(perPage int, nextCursor string) *Meta {
+	return &Meta{
+		PerPage:    perPage,
+		NextCursor: nextCursor,
+	}
+}
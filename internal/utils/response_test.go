@@ -202,6 +202,35 @@ func TestHandleError_GenericError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
 
+func TestHandleError_Redirect(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/articles/old-title/related?limit=3", nil)
+	c.Params = gin.Params{{Key: "slug", Value: "old-title"}}
+
+	HandleError(c, WrapError(NewRedirectError("new-title"), "article moved"))
+
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/api/v1/articles/new-title/related?limit=3", w.Header().Get("Location"))
+	assert.Contains(t, w.Body.String(), `"slug":"new-title"`)
+}
+
+func TestHandleError_VersionConflict(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	HandleError(c, &VersionConflictError{CurrentVersion: 4})
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, `"v4"`, w.Header().Get("ETag"))
+
+	var response VersionConflictResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "VERSION_CONFLICT", response.Error.Code)
+	assert.Equal(t, 4, response.CurrentVersion)
+}
+
 func TestHandleValidationError(t *testing.T) {
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
@@ -3,8 +3,11 @@ package utils
 import (
 	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/microcosm-cc/bluemonday"
+	"golang.org/x/net/html"
 )
 
 var (
@@ -149,3 +152,65 @@ func TruncateString(input string, maxLen int) string {
 	}
 	return input[:maxLen-3] + "..."
 }
+
+// voidElements are HTML elements that never have a closing tag
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true,
+	"img": true, "input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+}
+
+// TruncateHTML shortens sanitized HTML to about maxText characters of text,
+// cutting at a word boundary and closing any tags left open. It reports
+// whether the input was cut; input that is already short enough is returned as is.
+func TruncateHTML(input string, maxText int) (string, bool) {
+	tokenizer := html.NewTokenizer(strings.NewReader(input))
+	var sb strings.Builder
+	var open []string
+	textLen := 0
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			return input, false
+		}
+		raw := string(tokenizer.Raw())
+
+		switch tokenType {
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			if !voidElements[string(name)] {
+				open = append(open, string(name))
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == string(name) {
+					open = open[:i]
+					break
+				}
+			}
+		case html.TextToken:
+			text := string(tokenizer.Text())
+			n := utf8.RuneCountInString(text)
+			if textLen+n > maxText {
+				runes := []rune(text)
+				kept := string(runes[:maxText-textLen])
+				// Drop a partial last word
+				if !unicode.IsSpace(runes[maxText-textLen]) {
+					if i := strings.LastIndexFunc(kept, unicode.IsSpace); i > 0 {
+						kept = kept[:i]
+					}
+				}
+				sb.WriteString(html.EscapeString(strings.TrimSpace(kept)))
+				sb.WriteString("…")
+				for i := len(open) - 1; i >= 0; i-- {
+					sb.WriteString("</" + open[i] + ">")
+				}
+				return sb.String(), true
+			}
+			textLen += n
+		}
+
+		sb.WriteString(raw)
+	}
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateHTML_ShortInputUnchanged(t *testing.T) {
+	input := "<p>Short <em>text</em></p>"
+
+	output, truncated := TruncateHTML(input, 100)
+
+	assert.False(t, truncated)
+	assert.Equal(t, input, output)
+}
+
+func TestTruncateHTML_CutsAtWordAndClosesTags(t *testing.T) {
+	input := "<p>First paragraph.</p><p>Second <strong>paragraph with more words</strong></p><p>Third</p>"
+
+	output, truncated := TruncateHTML(input, 35)
+
+	assert.True(t, truncated)
+	assert.Equal(t, "<p>First paragraph.</p><p>Second <strong>paragraph…</strong></p>", output)
+}
+
+func TestTruncateHTML_KeepsVoidElements(t *testing.T) {
+	input := "<p>Line one<br>line two is long enough</p>"
+
+	output, truncated := TruncateHTML(input, 15)
+
+	assert.True(t, truncated)
+	assert.Equal(t, "<p>Line one<br>line…</p>", output)
+}
+
+func TestTruncateHTML_EscapesCutText(t *testing.T) {
+	output, truncated := TruncateHTML("<p>Fish &amp; chips and more</p>", 12)
+
+	assert.True(t, truncated)
+	assert.Equal(t, "<p>Fish &amp; chips…</p>", output)
+}
@@ -0,0 +1,28 @@
+package utils
+
+import "strings"
+
+// botUserAgentMarkers are substrings found in the user agents of crawlers,
+// link previewers, monitors and HTTP libraries
+var botUserAgentMarkers = []string{
+	"bot", "crawl", "spider", "slurp", "archiver", "scrapy",
+	"facebookexternalhit", "embedly", "whatsapp", "feedfetcher", "mediapartners",
+	"headless", "lighthouse", "pingdom", "uptime",
+	"curl/", "wget/", "httpie/", "python-requests", "python-urllib", "aiohttp",
+	"go-http-client", "java/", "okhttp", "axios/", "node-fetch", "libwww-perl",
+}
+
+// IsBot reports whether a user agent belongs to a crawler or a script rather
+// than a reader's browser. Requests without a user agent count as bots.
+func IsBot(userAgent string) bool {
+	ua := strings.ToLower(strings.TrimSpace(userAgent))
+	if ua == "" {
+		return true
+	}
+	for _, marker := range botUserAgentMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
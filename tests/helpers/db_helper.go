@@ -96,18 +96,30 @@ func createTables(db *gorm.DB) error {
 		CREATE TABLE IF NOT EXISTS articles (
 			id TEXT PRIMARY KEY,
 			title TEXT NOT NULL,
-			slug TEXT UNIQUE NOT NULL,
+			slug TEXT NOT NULL,
 			excerpt TEXT,
 			content TEXT NOT NULL,
+			content_format TEXT DEFAULT 'html',
+			content_html TEXT,
+			table_of_contents TEXT,
 			featured_image_url TEXT,
 			author_id TEXT NOT NULL,
 			status TEXT DEFAULT 'draft',
 			view_count INTEGER DEFAULT 0,
+			read_count INTEGER DEFAULT 0,
 			reading_time_minutes INTEGER DEFAULT 1,
 			is_staff_pick INTEGER DEFAULT 0,
 			meta_title TEXT,
 			meta_description TEXT,
 			meta_keywords TEXT,
+			version INTEGER DEFAULT 1,
+			locale TEXT DEFAULT 'en',
+			translation_group_id TEXT,
+			visibility TEXT DEFAULT 'public',
+			comments_disabled INTEGER DEFAULT 0,
+			comments_locked INTEGER DEFAULT 0,
+			comments_close_after_days INTEGER,
+			comment_audience TEXT DEFAULT 'everyone',
 			published_at DATETIME,
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
@@ -118,6 +130,14 @@ func createTables(db *gorm.DB) error {
 		return err
 	}
 
+	if err := db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_articles_live_slug ON articles(slug) WHERE deleted_at IS NULL`).Error; err != nil {
+		return err
+	}
+
+	if err := db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_articles_translation_locale ON articles(translation_group_id, locale) WHERE deleted_at IS NULL`).Error; err != nil {
+		return err
+	}
+
 	// Article-Categories join table
 	if err := db.Exec(`
 		CREATE TABLE IF NOT EXISTS article_categories (
@@ -173,6 +193,7 @@ func createTables(db *gorm.DB) error {
 			user_id TEXT NOT NULL,
 			parent_id TEXT,
 			is_approved INTEGER DEFAULT 0,
+			likes_count INTEGER DEFAULT 0,
 			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			deleted_at DATETIME,
@@ -212,6 +233,321 @@ func createTables(db *gorm.DB) error {
 		return err
 	}
 
+	// Likes table (engagement)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS likes (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			article_id TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (user_id, article_id)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Bookmarks table (engagement)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS bookmarks (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			article_id TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (user_id, article_id)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Notifications table (engagement)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS notifications (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			actor_id TEXT NOT NULL,
+			type TEXT NOT NULL,
+			message TEXT NOT NULL,
+			article_id TEXT,
+			read INTEGER DEFAULT 0,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Series table
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS series (
+			id TEXT PRIMARY KEY,
+			title TEXT NOT NULL,
+			slug TEXT UNIQUE NOT NULL,
+			description TEXT,
+			cover_image_url TEXT,
+			author_id TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			deleted_at DATETIME
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Slug history table (retired slugs for redirects)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS slug_history (
+			id TEXT PRIMARY KEY,
+			entity_type TEXT NOT NULL,
+			entity_id TEXT NOT NULL,
+			old_slug TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (entity_type, old_slug)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Staff picks table (curation details)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS staff_picks (
+			article_id TEXT PRIMARY KEY,
+			curator_id TEXT,
+			note TEXT DEFAULT '',
+			position INTEGER,
+			starts_at DATETIME,
+			ends_at DATETIME,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Article edit locks table (advisory locks)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS article_edit_locks (
+			article_id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			expires_at DATETIME NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Article revisions table (pending edits to published articles)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS article_revisions (
+			id TEXT PRIMARY KEY,
+			article_id TEXT NOT NULL,
+			editor_id TEXT NOT NULL,
+			changes TEXT NOT NULL,
+			base_version INTEGER NOT NULL,
+			status TEXT DEFAULT 'pending',
+			reviewed_by TEXT,
+			reviewed_at DATETIME,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error; err != nil {
+		return err
+	}
+	if err := db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_article_revisions_pending ON article_revisions(article_id) WHERE status = 'pending'`).Error; err != nil {
+		return err
+	}
+
+	// Article previews table (signed draft links)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS article_previews (
+			id TEXT PRIMARY KEY,
+			article_id TEXT NOT NULL,
+			created_by TEXT NOT NULL,
+			expires_at DATETIME NOT NULL,
+			revoked_at DATETIME,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Highlights table (reader highlights and notes)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS highlights (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			article_id TEXT NOT NULL,
+			quote TEXT NOT NULL,
+			prefix TEXT DEFAULT '',
+			suffix TEXT DEFAULT '',
+			note TEXT DEFAULT '',
+			is_public INTEGER DEFAULT 0,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Related articles table (precomputed suggestions)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS related_articles (
+			article_id TEXT NOT NULL,
+			related_id TEXT NOT NULL,
+			rank INTEGER NOT NULL,
+			score REAL NOT NULL,
+			computed_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (article_id, related_id)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Reading progress table (reading history)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS reading_progress (
+			id TEXT PRIMARY KEY,
+			user_id TEXT NOT NULL,
+			article_id TEXT NOT NULL,
+			progress INTEGER NOT NULL DEFAULT 0,
+			max_progress INTEGER NOT NULL DEFAULT 0,
+			time_spent_seconds INTEGER NOT NULL DEFAULT 0,
+			completed_at DATETIME,
+			last_read_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (user_id, article_id)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Article reads table (reads counted in read_count)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS article_reads (
+			user_id TEXT NOT NULL,
+			article_id TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (user_id, article_id)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Article visits table (deduplicated views)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS article_visits (
+			article_id TEXT NOT NULL,
+			visitor_key TEXT NOT NULL,
+			day DATETIME NOT NULL,
+			views INTEGER NOT NULL DEFAULT 1,
+			last_viewed_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (article_id, visitor_key, day)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Article daily stats table (daily totals)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS article_daily_stats (
+			article_id TEXT NOT NULL,
+			day DATETIME NOT NULL,
+			views INTEGER NOT NULL DEFAULT 0,
+			unique_visitors INTEGER NOT NULL DEFAULT 0,
+			reads INTEGER NOT NULL DEFAULT 0,
+			likes INTEGER NOT NULL DEFAULT 0,
+			comments INTEGER NOT NULL DEFAULT 0,
+			bookmarks INTEGER NOT NULL DEFAULT 0,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (article_id, day)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Article referrers table (daily views by referring site)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS article_referrers (
+			article_id TEXT NOT NULL,
+			day DATETIME NOT NULL,
+			source TEXT NOT NULL,
+			views INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY (article_id, day, source)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Article trending table (precomputed scores per window)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS article_trending (
+			article_id TEXT NOT NULL,
+			period TEXT NOT NULL,
+			score REAL NOT NULL,
+			computed_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (article_id, period)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Article fingerprints table (duplicate detection)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS article_fingerprints (
+			article_id TEXT PRIMARY KEY,
+			signature BLOB NOT NULL,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Fingerprint bands table (duplicate candidate lookup)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS fingerprint_bands (
+			article_id TEXT NOT NULL,
+			band INTEGER NOT NULL,
+			hash INTEGER NOT NULL,
+			PRIMARY KEY (article_id, band)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Duplicate flags table (editor review queue)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS duplicate_flags (
+			id TEXT PRIMARY KEY,
+			article_id TEXT NOT NULL,
+			matched_article_id TEXT NOT NULL,
+			similarity REAL NOT NULL,
+			overlap REAL NOT NULL,
+			passages TEXT DEFAULT '[]',
+			status TEXT DEFAULT 'pending',
+			reviewed_by TEXT,
+			reviewed_at DATETIME,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (article_id, matched_article_id)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
+	// Import records table (idempotent imports)
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS import_records (
+			id TEXT PRIMARY KEY,
+			entity_type TEXT NOT NULL,
+			source_key TEXT NOT NULL,
+			entity_id TEXT NOT NULL,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			UNIQUE (entity_type, source_key)
+		)
+	`).Error; err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -223,6 +559,27 @@ func CleanupTestDB(db *gorm.DB) {
 	tables := []string{
 		"user_follows",
 		"user_interests",
+		"likes",
+		"bookmarks",
+		"notifications",
+		"series",
+		"slug_history",
+		"article_edit_locks",
+		"staff_picks",
+		"article_revisions",
+		"article_previews",
+		"highlights",
+		"related_articles",
+		"reading_progress",
+		"article_reads",
+		"article_visits",
+		"article_daily_stats",
+		"article_referrers",
+		"article_trending",
+		"article_fingerprints",
+		"fingerprint_bands",
+		"duplicate_flags",
+		"import_records",
 		"article_categories",
 		"article_tags",
 		"comments",
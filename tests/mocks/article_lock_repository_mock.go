@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockArticleLockRepository is a mock implementation of ArticleLockRepository
+type MockArticleLockRepository struct {
+	mock.Mock
+}
+
+// Ensure MockArticleLockRepository implements ArticleLockRepository
+var _ repositories.ArticleLockRepository = (*MockArticleLockRepository)(nil)
+
+// Acquire mocks the Acquire method
+func (m *MockArticleLockRepository) Acquire(articleID, userID uuid.UUID, expiresAt time.Time) (*models.ArticleEditLock, error) {
+	args := m.Called(articleID, userID, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ArticleEditLock), args.Error(1)
+}
+
+// Find mocks the Find method
+func (m *MockArticleLockRepository) Find(articleID uuid.UUID) (*models.ArticleEditLock, error) {
+	args := m.Called(articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ArticleEditLock), args.Error(1)
+}
+
+// Release mocks the Release method
+func (m *MockArticleLockRepository) Release(articleID uuid.UUID) error {
+	args := m.Called(articleID)
+	return args.Error(0)
+}
+
+// WithTx mocks the WithTx method
+func (m *MockArticleLockRepository) WithTx(tx *gorm.DB) repositories.ArticleLockRepository {
+	return m
+}
@@ -0,0 +1,52 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockArticlePreviewRepository is a mock implementation of ArticlePreviewRepository
+type MockArticlePreviewRepository struct {
+	mock.Mock
+}
+
+// Ensure MockArticlePreviewRepository implements ArticlePreviewRepository
+var _ repositories.ArticlePreviewRepository = (*MockArticlePreviewRepository)(nil)
+
+// Create mocks the Create method
+func (m *MockArticlePreviewRepository) Create(preview *models.ArticlePreview) error {
+	args := m.Called(preview)
+	return args.Error(0)
+}
+
+// FindByID mocks the FindByID method
+func (m *MockArticlePreviewRepository) FindByID(id uuid.UUID) (*models.ArticlePreview, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ArticlePreview), args.Error(1)
+}
+
+// FindByArticle mocks the FindByArticle method
+func (m *MockArticlePreviewRepository) FindByArticle(articleID uuid.UUID) ([]models.ArticlePreview, error) {
+	args := m.Called(articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ArticlePreview), args.Error(1)
+}
+
+// Revoke mocks the Revoke method
+func (m *MockArticlePreviewRepository) Revoke(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// WithTx mocks the WithTx method
+func (m *MockArticlePreviewRepository) WithTx(tx *gorm.DB) repositories.ArticlePreviewRepository {
+	return m
+}
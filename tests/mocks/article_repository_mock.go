@@ -65,8 +65,8 @@ func (m *MockArticleRepository) FindByTag(tagID uuid.UUID, filters repositories.
 }
 
 // FindTrending mocks the FindTrending method
-func (m *MockArticleRepository) FindTrending(limit int) ([]models.Article, error) {
-	args := m.Called(limit)
+func (m *MockArticleRepository) FindTrending(filters repositories.TrendingFilters, limit int) ([]models.Article, error) {
+	args := m.Called(filters, limit)
 	return args.Get(0).([]models.Article), args.Error(1)
 }
 
@@ -77,8 +77,14 @@ func (m *MockArticleRepository) FindRecent(limit int) ([]models.Article, error)
 }
 
 // FindRelated mocks the FindRelated method
-func (m *MockArticleRepository) FindRelated(articleID uuid.UUID, categoryIDs, tagIDs []uuid.UUID, limit int) ([]models.Article, error) {
-	args := m.Called(articleID, categoryIDs, tagIDs, limit)
+func (m *MockArticleRepository) FindRelated(articleID uuid.UUID, categoryIDs, tagIDs []uuid.UUID, limit int, language string) ([]models.Article, error) {
+	args := m.Called(articleID, categoryIDs, tagIDs, limit, language)
+	return args.Get(0).([]models.Article), args.Error(1)
+}
+
+// FindTranslations mocks the FindTranslations method
+func (m *MockArticleRepository) FindTranslations(groupID uuid.UUID) ([]models.Article, error) {
+	args := m.Called(groupID)
 	return args.Get(0).([]models.Article), args.Error(1)
 }
 
@@ -147,3 +153,15 @@ func (m *MockArticleRepository) SetStaffPick(id uuid.UUID, isStaffPick bool) err
 	args := m.Called(id, isStaffPick)
 	return args.Error(0)
 }
+
+// SaveStaffPick mocks the SaveStaffPick method
+func (m *MockArticleRepository) SaveStaffPick(pick *models.StaffPick) error {
+	args := m.Called(pick)
+	return args.Error(0)
+}
+
+// UpdateCommentSettings mocks the UpdateCommentSettings method
+func (m *MockArticleRepository) UpdateCommentSettings(article *models.Article) error {
+	args := m.Called(article)
+	return args.Error(0)
+}
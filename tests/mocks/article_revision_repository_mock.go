@@ -0,0 +1,52 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockArticleRevisionRepository is a mock implementation of ArticleRevisionRepository
+type MockArticleRevisionRepository struct {
+	mock.Mock
+}
+
+// Ensure MockArticleRevisionRepository implements ArticleRevisionRepository
+var _ repositories.ArticleRevisionRepository = (*MockArticleRevisionRepository)(nil)
+
+// Create mocks the Create method
+func (m *MockArticleRevisionRepository) Create(revision *models.ArticleRevision) error {
+	args := m.Called(revision)
+	return args.Error(0)
+}
+
+// FindPending mocks the FindPending method
+func (m *MockArticleRevisionRepository) FindPending(articleID uuid.UUID) (*models.ArticleRevision, error) {
+	args := m.Called(articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ArticleRevision), args.Error(1)
+}
+
+// FindAllPending mocks the FindAllPending method
+func (m *MockArticleRevisionRepository) FindAllPending(limit, offset int) ([]models.ArticleRevision, int64, error) {
+	args := m.Called(limit, offset)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.ArticleRevision), args.Get(1).(int64), args.Error(2)
+}
+
+// Update mocks the Update method
+func (m *MockArticleRevisionRepository) Update(revision *models.ArticleRevision) error {
+	args := m.Called(revision)
+	return args.Error(0)
+}
+
+// WithTx mocks the WithTx method
+func (m *MockArticleRevisionRepository) WithTx(tx *gorm.DB) repositories.ArticleRevisionRepository {
+	return m
+}
@@ -0,0 +1,82 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDuplicateRepository is a mock implementation of DuplicateRepository
+type MockDuplicateRepository struct {
+	mock.Mock
+}
+
+// Ensure MockDuplicateRepository implements DuplicateRepository
+var _ repositories.DuplicateRepository = (*MockDuplicateRepository)(nil)
+
+// SaveFingerprint mocks the SaveFingerprint method
+func (m *MockDuplicateRepository) SaveFingerprint(fingerprint *models.ArticleFingerprint, bands []models.FingerprintBand) error {
+	args := m.Called(fingerprint, bands)
+	return args.Error(0)
+}
+
+// DeleteFingerprint mocks the DeleteFingerprint method
+func (m *MockDuplicateRepository) DeleteFingerprint(articleID uuid.UUID) error {
+	args := m.Called(articleID)
+	return args.Error(0)
+}
+
+// FindCandidates mocks the FindCandidates method
+func (m *MockDuplicateRepository) FindCandidates(articleID uuid.UUID, bands []models.FingerprintBand) ([]models.ArticleFingerprint, error) {
+	args := m.Called(articleID, bands)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ArticleFingerprint), args.Error(1)
+}
+
+// SaveFlag mocks the SaveFlag method
+func (m *MockDuplicateRepository) SaveFlag(flag *models.DuplicateFlag) error {
+	args := m.Called(flag)
+	return args.Error(0)
+}
+
+// FindFlagsForArticle mocks the FindFlagsForArticle method
+func (m *MockDuplicateRepository) FindFlagsForArticle(articleID uuid.UUID) ([]models.DuplicateFlag, error) {
+	args := m.Called(articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.DuplicateFlag), args.Error(1)
+}
+
+// DeleteFlag mocks the DeleteFlag method
+func (m *MockDuplicateRepository) DeleteFlag(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// FindFlags mocks the FindFlags method
+func (m *MockDuplicateRepository) FindFlags(status models.DuplicateStatus, limit, offset int) ([]models.DuplicateFlag, int64, error) {
+	args := m.Called(status, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.DuplicateFlag), args.Get(1).(int64), args.Error(2)
+}
+
+// FindFlagByID mocks the FindFlagByID method
+func (m *MockDuplicateRepository) FindFlagByID(id uuid.UUID) (*models.DuplicateFlag, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DuplicateFlag), args.Error(1)
+}
+
+// UpdateFlag mocks the UpdateFlag method
+func (m *MockDuplicateRepository) UpdateFlag(flag *models.DuplicateFlag) error {
+	args := m.Called(flag)
+	return args.Error(0)
+}
@@ -54,9 +54,9 @@ func (m *MockEngagementRepository) HasBookmarked(userID, articleID uuid.UUID) (b
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockEngagementRepository) GetBookmarkedArticles(userID uuid.UUID, limit, offset int) ([]models.Article, int64, error) {
-	args := m.Called(userID, limit, offset)
-	return args.Get(0).([]models.Article), args.Get(1).(int64), args.Error(2)
+func (m *MockEngagementRepository) GetBookmarks(userID uuid.UUID, limit, offset int, after *repositories.Keyset) ([]models.Bookmark, int64, error) {
+	args := m.Called(userID, limit, offset, after)
+	return args.Get(0).([]models.Bookmark), args.Get(1).(int64), args.Error(2)
 }
 
 // --- Notifications ---
@@ -66,8 +66,8 @@ func (m *MockEngagementRepository) CreateNotification(notification *models.Notif
 	return args.Error(0)
 }
 
-func (m *MockEngagementRepository) GetNotifications(userID uuid.UUID, limit, offset int) ([]models.Notification, int64, error) {
-	args := m.Called(userID, limit, offset)
+func (m *MockEngagementRepository) GetNotifications(userID uuid.UUID, limit, offset int, after *repositories.Keyset) ([]models.Notification, int64, error) {
+	args := m.Called(userID, limit, offset, after)
 	return args.Get(0).([]models.Notification), args.Get(1).(int64), args.Error(2)
 }
 
@@ -92,3 +92,13 @@ func (m *MockEngagementRepository) GetCommentsCount(articleID uuid.UUID) (int64,
 	args := m.Called(articleID)
 	return args.Get(0).(int64), args.Error(1)
 }
+
+// --- Lists ---
+
+func (m *MockEngagementRepository) GetArticleEngagements(articleIDs []uuid.UUID, userID *uuid.UUID) (map[uuid.UUID]repositories.ArticleEngagement, error) {
+	args := m.Called(articleIDs, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]repositories.ArticleEngagement), args.Error(1)
+}
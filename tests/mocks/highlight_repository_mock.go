@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockHighlightRepository is a mock implementation of HighlightRepository
+type MockHighlightRepository struct {
+	mock.Mock
+}
+
+// Ensure MockHighlightRepository implements HighlightRepository
+var _ repositories.HighlightRepository = (*MockHighlightRepository)(nil)
+
+// Create mocks the Create method
+func (m *MockHighlightRepository) Create(highlight *models.Highlight) error {
+	args := m.Called(highlight)
+	return args.Error(0)
+}
+
+// FindByID mocks the FindByID method
+func (m *MockHighlightRepository) FindByID(id uuid.UUID) (*models.Highlight, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Highlight), args.Error(1)
+}
+
+// Delete mocks the Delete method
+func (m *MockHighlightRepository) Delete(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// FindByUser mocks the FindByUser method
+func (m *MockHighlightRepository) FindByUser(userID uuid.UUID, articleID *uuid.UUID, limit, offset int) ([]models.Highlight, int64, error) {
+	args := m.Called(userID, articleID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.Highlight), args.Get(1).(int64), args.Error(2)
+}
+
+// FindPopular mocks the FindPopular method
+func (m *MockHighlightRepository) FindPopular(articleID uuid.UUID, limit int) ([]repositories.PopularHighlight, error) {
+	args := m.Called(articleID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repositories.PopularHighlight), args.Error(1)
+}
+
+// CountPublicReaders mocks the CountPublicReaders method
+func (m *MockHighlightRepository) CountPublicReaders(articleID uuid.UUID, quote string) (int64, error) {
+	args := m.Called(articleID, quote)
+	return args.Get(0).(int64), args.Error(1)
+}
@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockImportRecordRepository is a mock implementation of ImportRecordRepository
+type MockImportRecordRepository struct {
+	mock.Mock
+}
+
+// Ensure MockImportRecordRepository implements ImportRecordRepository
+var _ repositories.ImportRecordRepository = (*MockImportRecordRepository)(nil)
+
+// Create mocks the Create method
+func (m *MockImportRecordRepository) Create(entityType models.ImportEntityType, sourceKey string, entityID uuid.UUID) error {
+	args := m.Called(entityType, sourceKey, entityID)
+	return args.Error(0)
+}
+
+// Find mocks the Find method
+func (m *MockImportRecordRepository) Find(entityType models.ImportEntityType, sourceKey string) (*models.ImportRecord, error) {
+	args := m.Called(entityType, sourceKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ImportRecord), args.Error(1)
+}
+
+// WithTx mocks the WithTx method
+func (m *MockImportRecordRepository) WithTx(tx *gorm.DB) repositories.ImportRecordRepository {
+	return m
+}
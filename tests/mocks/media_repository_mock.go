@@ -0,0 +1,61 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockMediaRepository is a mock implementation of MediaRepository
+type MockMediaRepository struct {
+	mock.Mock
+}
+
+// Ensure MockMediaRepository implements MediaRepository
+var _ repositories.MediaRepository = (*MockMediaRepository)(nil)
+
+// Create mocks the Create method
+func (m *MockMediaRepository) Create(media *models.Media) error {
+	args := m.Called(media)
+	return args.Error(0)
+}
+
+// FindByID mocks the FindByID method
+func (m *MockMediaRepository) FindByID(id uuid.UUID) (*models.Media, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Media), args.Error(1)
+}
+
+// FindAll mocks the FindAll method
+func (m *MockMediaRepository) FindAll(filters repositories.MediaFilters) ([]models.Media, int64, error) {
+	args := m.Called(filters)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.Media), args.Get(1).(int64), args.Error(2)
+}
+
+// FindByUploader mocks the FindByUploader method
+func (m *MockMediaRepository) FindByUploader(uploaderID uuid.UUID, filters repositories.MediaFilters) ([]models.Media, int64, error) {
+	args := m.Called(uploaderID, filters)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.Media), args.Get(1).(int64), args.Error(2)
+}
+
+// Delete mocks the Delete method
+func (m *MockMediaRepository) Delete(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// WithTx mocks the WithTx method
+func (m *MockMediaRepository) WithTx(tx *gorm.DB) repositories.MediaRepository {
+	return m
+}
@@ -0,0 +1,58 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockReadingRepository is a mock implementation of ReadingRepository
+type MockReadingRepository struct {
+	mock.Mock
+}
+
+// Ensure MockReadingRepository implements ReadingRepository
+var _ repositories.ReadingRepository = (*MockReadingRepository)(nil)
+
+// FindProgress mocks the FindProgress method
+func (m *MockReadingRepository) FindProgress(userID, articleID uuid.UUID) (*models.ReadingProgress, error) {
+	args := m.Called(userID, articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ReadingProgress), args.Error(1)
+}
+
+// SaveProgress mocks the SaveProgress method
+func (m *MockReadingRepository) SaveProgress(progress *models.ReadingProgress) error {
+	args := m.Called(progress)
+	return args.Error(0)
+}
+
+// FindHistory mocks the FindHistory method
+func (m *MockReadingRepository) FindHistory(userID uuid.UUID, completed *bool, limit, offset int) ([]models.ReadingProgress, int64, error) {
+	args := m.Called(userID, completed, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.ReadingProgress), args.Get(1).(int64), args.Error(2)
+}
+
+// CountRead mocks the CountRead method
+func (m *MockReadingRepository) CountRead(userID, articleID uuid.UUID) (bool, error) {
+	args := m.Called(userID, articleID)
+	return args.Bool(0), args.Error(1)
+}
+
+// DeleteEntry mocks the DeleteEntry method
+func (m *MockReadingRepository) DeleteEntry(userID, id uuid.UUID) (bool, error) {
+	args := m.Called(userID, id)
+	return args.Bool(0), args.Error(1)
+}
+
+// DeleteHistory mocks the DeleteHistory method
+func (m *MockReadingRepository) DeleteHistory(userID uuid.UUID) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
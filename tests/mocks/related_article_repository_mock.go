@@ -0,0 +1,49 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRelatedArticleRepository is a mock implementation of RelatedArticleRepository
+type MockRelatedArticleRepository struct {
+	mock.Mock
+}
+
+// Ensure MockRelatedArticleRepository implements RelatedArticleRepository
+var _ repositories.RelatedArticleRepository = (*MockRelatedArticleRepository)(nil)
+
+// FindRankingSources mocks the FindRankingSources method
+func (m *MockRelatedArticleRepository) FindRankingSources() ([]models.Article, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Article), args.Error(1)
+}
+
+// CountLikes mocks the CountLikes method
+func (m *MockRelatedArticleRepository) CountLikes() (map[uuid.UUID]int, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]int), args.Error(1)
+}
+
+// ReplaceAll mocks the ReplaceAll method
+func (m *MockRelatedArticleRepository) ReplaceAll(related []models.RelatedArticle) error {
+	args := m.Called(related)
+	return args.Error(0)
+}
+
+// FindRelated mocks the FindRelated method
+func (m *MockRelatedArticleRepository) FindRelated(articleID uuid.UUID, limit int) ([]models.Article, error) {
+	args := m.Called(articleID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Article), args.Error(1)
+}
@@ -0,0 +1,86 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockSeriesRepository is a mock implementation of SeriesRepository
+type MockSeriesRepository struct {
+	mock.Mock
+}
+
+// Ensure MockSeriesRepository implements SeriesRepository
+var _ repositories.SeriesRepository = (*MockSeriesRepository)(nil)
+
+// Create mocks the Create method
+func (m *MockSeriesRepository) Create(series *models.Series) error {
+	args := m.Called(series)
+	return args.Error(0)
+}
+
+// FindByID mocks the FindByID method
+func (m *MockSeriesRepository) FindByID(id uuid.UUID) (*models.Series, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Series), args.Error(1)
+}
+
+// FindBySlug mocks the FindBySlug method
+func (m *MockSeriesRepository) FindBySlug(slug string) (*models.Series, error) {
+	args := m.Called(slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Series), args.Error(1)
+}
+
+// FindByArticle mocks the FindByArticle method
+func (m *MockSeriesRepository) FindByArticle(articleID uuid.UUID) (*models.Series, error) {
+	args := m.Called(articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Series), args.Error(1)
+}
+
+// FindAll mocks the FindAll method
+func (m *MockSeriesRepository) FindAll(filters repositories.SeriesFilters) ([]models.Series, int64, error) {
+	args := m.Called(filters)
+	return args.Get(0).([]models.Series), args.Get(1).(int64), args.Error(2)
+}
+
+// Update mocks the Update method
+func (m *MockSeriesRepository) Update(series *models.Series) error {
+	args := m.Called(series)
+	return args.Error(0)
+}
+
+// Delete mocks the Delete method
+func (m *MockSeriesRepository) Delete(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// ExistsBySlug mocks the ExistsBySlug method
+func (m *MockSeriesRepository) ExistsBySlug(slug string) (bool, error) {
+	args := m.Called(slug)
+	return args.Bool(0), args.Error(1)
+}
+
+// SetArticles mocks the SetArticles method
+func (m *MockSeriesRepository) SetArticles(seriesID uuid.UUID, articleIDs []uuid.UUID) error {
+	args := m.Called(seriesID, articleIDs)
+	return args.Error(0)
+}
+
+// WithTx mocks the WithTx method - returns itself for testing
+func (m *MockSeriesRepository) WithTx(tx *gorm.DB) repositories.SeriesRepository {
+	m.Called(tx)
+	return m // Return self to allow chaining in tests
+}
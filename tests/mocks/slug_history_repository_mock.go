@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockSlugHistoryRepository is a mock implementation of SlugHistoryRepository
+type MockSlugHistoryRepository struct {
+	mock.Mock
+}
+
+// Ensure MockSlugHistoryRepository implements SlugHistoryRepository
+var _ repositories.SlugHistoryRepository = (*MockSlugHistoryRepository)(nil)
+
+// Record mocks the Record method
+func (m *MockSlugHistoryRepository) Record(entityType models.SlugEntityType, entityID uuid.UUID, oldSlug string) error {
+	args := m.Called(entityType, entityID, oldSlug)
+	return args.Error(0)
+}
+
+// FindByOldSlug mocks the FindByOldSlug method
+func (m *MockSlugHistoryRepository) FindByOldSlug(entityType models.SlugEntityType, slug string) (*models.SlugHistory, error) {
+	args := m.Called(entityType, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SlugHistory), args.Error(1)
+}
+
+// WithTx mocks the WithTx method
+func (m *MockSlugHistoryRepository) WithTx(tx *gorm.DB) repositories.SlugHistoryRepository {
+	return m
+}
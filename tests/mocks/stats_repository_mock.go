@@ -0,0 +1,84 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockStatsRepository is a mock implementation of StatsRepository
+type MockStatsRepository struct {
+	mock.Mock
+}
+
+// Ensure MockStatsRepository implements StatsRepository
+var _ repositories.StatsRepository = (*MockStatsRepository)(nil)
+
+// RecordVisit mocks the RecordVisit method
+func (m *MockStatsRepository) RecordVisit(articleID uuid.UUID, visitorKey string, now time.Time, window time.Duration) (bool, error) {
+	args := m.Called(articleID, visitorKey, now, window)
+	return args.Bool(0), args.Error(1)
+}
+
+// RollupDay mocks the RollupDay method
+func (m *MockStatsRepository) RollupDay(day time.Time) (int, error) {
+	args := m.Called(day)
+	return args.Int(0), args.Error(1)
+}
+
+// PruneVisits mocks the PruneVisits method
+func (m *MockStatsRepository) PruneVisits(before time.Time) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// RecordReferrer mocks the RecordReferrer method
+func (m *MockStatsRepository) RecordReferrer(articleID uuid.UUID, source string, now time.Time) error {
+	args := m.Called(articleID, source, now)
+	return args.Error(0)
+}
+
+// FindDailyTotals mocks the FindDailyTotals method
+func (m *MockStatsRepository) FindDailyTotals(scope repositories.StatsScope, from, to time.Time) ([]models.ArticleDailyStat, error) {
+	args := m.Called(scope, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ArticleDailyStat), args.Error(1)
+}
+
+// FindTopArticles mocks the FindTopArticles method
+func (m *MockStatsRepository) FindTopArticles(authorID uuid.UUID, from, to time.Time, limit int) ([]repositories.ArticleStatsTotal, error) {
+	args := m.Called(authorID, from, to, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repositories.ArticleStatsTotal), args.Error(1)
+}
+
+// FindTopReferrers mocks the FindTopReferrers method
+func (m *MockStatsRepository) FindTopReferrers(scope repositories.StatsScope, from, to time.Time, limit int) ([]repositories.ReferrerCount, error) {
+	args := m.Called(scope, from, to, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repositories.ReferrerCount), args.Error(1)
+}
+
+// CountFollowers mocks the CountFollowers method
+func (m *MockStatsRepository) CountFollowers(userID uuid.UUID, before time.Time) (int64, error) {
+	args := m.Called(userID, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// FindFollowDates mocks the FindFollowDates method
+func (m *MockStatsRepository) FindFollowDates(userID uuid.UUID, from time.Time) ([]time.Time, error) {
+	args := m.Called(userID, from)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]time.Time), args.Error(1)
+}
@@ -0,0 +1,117 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockTrashRepository is a mock implementation of TrashRepository
+type MockTrashRepository struct {
+	mock.Mock
+}
+
+// Ensure MockTrashRepository implements TrashRepository
+var _ repositories.TrashRepository = (*MockTrashRepository)(nil)
+
+// FindArticles mocks the FindArticles method
+func (m *MockTrashRepository) FindArticles(limit, offset int) ([]models.Article, int64, error) {
+	args := m.Called(limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.Article), args.Get(1).(int64), args.Error(2)
+}
+
+// FindComments mocks the FindComments method
+func (m *MockTrashRepository) FindComments(limit, offset int) ([]models.Comment, int64, error) {
+	args := m.Called(limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.Comment), args.Get(1).(int64), args.Error(2)
+}
+
+// FindUsers mocks the FindUsers method
+func (m *MockTrashRepository) FindUsers(limit, offset int) ([]models.User, int64, error) {
+	args := m.Called(limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]models.User), args.Get(1).(int64), args.Error(2)
+}
+
+// FindArticle mocks the FindArticle method
+func (m *MockTrashRepository) FindArticle(id uuid.UUID) (*models.Article, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Article), args.Error(1)
+}
+
+// FindComment mocks the FindComment method
+func (m *MockTrashRepository) FindComment(id uuid.UUID) (*models.Comment, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Comment), args.Error(1)
+}
+
+// FindUser mocks the FindUser method
+func (m *MockTrashRepository) FindUser(id uuid.UUID) (*models.User, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+// FindDeletedBefore mocks the FindDeletedBefore method
+func (m *MockTrashRepository) FindDeletedBefore(trashType repositories.TrashType, before time.Time, limit, offset int) ([]uuid.UUID, error) {
+	args := m.Called(trashType, before, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+// RestoreArticle mocks the RestoreArticle method
+func (m *MockTrashRepository) RestoreArticle(id uuid.UUID, slug string) error {
+	args := m.Called(id, slug)
+	return args.Error(0)
+}
+
+// Restore mocks the Restore method
+func (m *MockTrashRepository) Restore(trashType repositories.TrashType, id uuid.UUID) error {
+	args := m.Called(trashType, id)
+	return args.Error(0)
+}
+
+// PurgeArticle mocks the PurgeArticle method
+func (m *MockTrashRepository) PurgeArticle(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// PurgeComment mocks the PurgeComment method
+func (m *MockTrashRepository) PurgeComment(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// PurgeUser mocks the PurgeUser method
+func (m *MockTrashRepository) PurgeUser(id uuid.UUID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// WithTx mocks the WithTx method
+func (m *MockTrashRepository) WithTx(tx *gorm.DB) repositories.TrashRepository {
+	return m
+}
@@ -0,0 +1,32 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/alfafaa/alfafaa-blog/internal/models"
+	"github.com/alfafaa/alfafaa-blog/internal/repositories"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTrendingRepository is a mock implementation of TrendingRepository
+type MockTrendingRepository struct {
+	mock.Mock
+}
+
+// Ensure MockTrendingRepository implements TrendingRepository
+var _ repositories.TrendingRepository = (*MockTrendingRepository)(nil)
+
+// FindActivity mocks the FindActivity method
+func (m *MockTrendingRepository) FindActivity(since time.Time) ([]models.ArticleDailyStat, error) {
+	args := m.Called(since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ArticleDailyStat), args.Error(1)
+}
+
+// ReplaceAll mocks the ReplaceAll method
+func (m *MockTrendingRepository) ReplaceAll(scores []models.TrendingScore) error {
+	args := m.Called(scores)
+	return args.Error(0)
+}
@@ -121,15 +121,15 @@ func (m *MockUserRepository) IsFollowing(followerID, followingID uuid.UUID) (boo
 }
 
 // GetFollowers mocks the GetFollowers method
-func (m *MockUserRepository) GetFollowers(userID uuid.UUID, limit, offset int) ([]models.User, int64, error) {
-	args := m.Called(userID, limit, offset)
-	return args.Get(0).([]models.User), args.Get(1).(int64), args.Error(2)
+func (m *MockUserRepository) GetFollowers(userID uuid.UUID, limit, offset int, after *repositories.Keyset) ([]models.UserFollow, int64, error) {
+	args := m.Called(userID, limit, offset, after)
+	return args.Get(0).([]models.UserFollow), args.Get(1).(int64), args.Error(2)
 }
 
 // GetFollowing mocks the GetFollowing method
-func (m *MockUserRepository) GetFollowing(userID uuid.UUID, limit, offset int) ([]models.User, int64, error) {
-	args := m.Called(userID, limit, offset)
-	return args.Get(0).([]models.User), args.Get(1).(int64), args.Error(2)
+func (m *MockUserRepository) GetFollowing(userID uuid.UUID, limit, offset int, after *repositories.Keyset) ([]models.UserFollow, int64, error) {
+	args := m.Called(userID, limit, offset, after)
+	return args.Get(0).([]models.UserFollow), args.Get(1).(int64), args.Error(2)
 }
 
 // GetFollowingIDs mocks the GetFollowingIDs method